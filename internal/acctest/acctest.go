@@ -265,6 +265,8 @@ func ProtoV5FactoriesMultipleRegions(ctx context.Context, t *testing.T, n int) m
 func PreCheck(ctx context.Context, t *testing.T) {
 	t.Helper()
 
+	startEmulator(t)
+
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
@@ -1469,7 +1471,7 @@ func RegionProviderFunc(region string, providers *[]*schema.Provider) ProviderFu
 				continue
 			}
 
-			clientRegion := client.Region
+			clientRegion := client.Region(context.Background())
 			log.Printf("[DEBUG] Checking AWS provider region %q against %q", clientRegion, region)
 			if clientRegion == region {
 				log.Printf("[DEBUG] Found AWS provider with region: %s", region)
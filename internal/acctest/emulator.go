@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/emulator"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const (
+	envVarEndpointURL         = "AWS_ENDPOINT_URL"
+	envVarEC2MetadataDisabled = "AWS_EC2_METADATA_DISABLED"
+)
+
+var (
+	// emulatorServer is the in-process AWS emulator shared by all tests.
+	// It is started on first use and runs for the lifetime of the test binary.
+	emulatorServer *emulator.Server
+	emulatorStart  sync.Once
+)
+
+func isEmulatorEnabled() bool {
+	return os.Getenv(envvar.Emulator) != ""
+}
+
+// startEmulator starts the in-process AWS emulator, if enabled, and points the provider at it.
+//
+// The emulator's endpoint is configured via the AWS_ENDPOINT_URL environment variable, which applies
+// to all provider configurations, and the emulator's credentials replace any configured credentials.
+func startEmulator(t *testing.T) {
+	t.Helper()
+
+	if !isEmulatorEnabled() {
+		return
+	}
+
+	emulatorStart.Do(func() {
+		emulatorServer = emulator.NewServer()
+
+		for k, v := range map[string]string{
+			envVarEC2MetadataDisabled: "true",
+			envVarEndpointURL:         emulatorServer.URL(),
+			envvar.AccessKeyId:        emulator.AccessKeyID,
+			envvar.SecretAccessKey:    emulator.SecretAccessKey,
+		} {
+			os.Setenv(k, v)
+		}
+		for _, k := range []string{envvar.Profile, envvar.ContainerCredentialsFullURI, "AWS_SESSION_TOKEN"} {
+			os.Unsetenv(k)
+		}
+
+		t.Logf("using AWS emulator at %s", emulatorServer.URL())
+	})
+}
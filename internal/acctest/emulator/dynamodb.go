@@ -0,0 +1,617 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	errCodeDynamoDBResourceInUse    = "ResourceInUseException"
+	errCodeDynamoDBResourceNotFound = "ResourceNotFoundException"
+)
+
+// dynamoDBService emulates Amazon DynamoDB.
+type dynamoDBService struct {
+	operations map[string]jsonOperation
+	tables     *store[*dynamoDBTable]
+}
+
+type dynamoDBTable struct {
+	// description is the table's TableDescription.
+	description       map[string]any
+	items             map[string]map[string]any
+	keyAttributeNames []string
+	pointInTime       bool
+	tags              tags
+	ttl               map[string]any
+}
+
+func newDynamoDB() *dynamoDBService {
+	s := &dynamoDBService{
+		tables: newStore[*dynamoDBTable](),
+	}
+	s.operations = map[string]jsonOperation{
+		"CreateTable":                         jsonOp(s.createTable),
+		"DeleteItem":                          jsonOp(s.deleteItem),
+		"DeleteTable":                         jsonOp(s.deleteTable),
+		"DescribeContinuousBackups":           jsonOp(s.describeContinuousBackups),
+		"DescribeKinesisStreamingDestination": jsonOp(s.describeKinesisStreamingDestination),
+		"DescribeTable":                       jsonOp(s.describeTable),
+		"DescribeTimeToLive":                  jsonOp(s.describeTimeToLive),
+		"GetItem":                             jsonOp(s.getItem),
+		"ListTables":                          jsonOp(s.listTables),
+		"ListTagsOfResource":                  jsonOp(s.listTagsOfResource),
+		"PutItem":                             jsonOp(s.putItem),
+		"Scan":                                jsonOp(s.scan),
+		"TagResource":                         jsonOp(s.tagResource),
+		"UntagResource":                       jsonOp(s.untagResource),
+		"UpdateContinuousBackups":             jsonOp(s.updateContinuousBackups),
+		"UpdateTable":                         jsonOp(s.updateTable),
+		"UpdateTimeToLive":                    jsonOp(s.updateTimeToLive),
+	}
+
+	return s
+}
+
+func (s *dynamoDBService) serve(w http.ResponseWriter, r *request) {
+	serveJSON(w, r, "DynamoDB_20120810", s.operations)
+}
+
+func newDynamoDBTableNotFoundError(name string) *apiError {
+	return newError(http.StatusBadRequest, errCodeDynamoDBResourceNotFound, "Requested resource not found: Table: %s not found", name)
+}
+
+// findTable returns the table with the specified name or ARN.
+// The store's mutex must be held.
+func (s *dynamoDBService) findTable(r *request, name string) (*dynamoDBTable, error) {
+	if _, after, ok := strings.Cut(name, ":table/"); ok {
+		name = after
+	}
+
+	table, ok := s.tables.region(r.region)[name]
+	if !ok {
+		return nil, newDynamoDBTableNotFoundError(name)
+	}
+
+	return table, nil
+}
+
+// itemKey returns the key of the specified item in the table's item map.
+func (t *dynamoDBTable) itemKey(item map[string]any) (string, error) {
+	key := make(map[string]any, len(t.keyAttributeNames))
+	for _, name := range t.keyAttributeNames {
+		v, ok := item[name]
+		if !ok {
+			return "", newValidationError("One of the required keys was not given a value")
+		}
+		key[name] = v
+	}
+
+	b, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func (s *dynamoDBService) createTable(r *request, input *map[string]any) (any, error) {
+	in := *input
+	name, _ := in["TableName"].(string)
+	if name == "" {
+		return nil, newValidationError("TableName is required")
+	}
+
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	tables := s.tables.region(r.region)
+	if _, ok := tables[name]; ok {
+		return nil, newError(http.StatusBadRequest, errCodeDynamoDBResourceInUse, "Table already exists: %s", name)
+	}
+
+	arn := r.arn("table/" + name)
+	now := epochSeconds(time.Now())
+	billingMode := "PROVISIONED"
+	if v, ok := in["BillingMode"].(string); ok && v != "" {
+		billingMode = v
+	}
+
+	description := map[string]any{
+		"AttributeDefinitions": in["AttributeDefinitions"],
+		"BillingModeSummary": map[string]any{
+			"BillingMode":                       billingMode,
+			"LastUpdateToPayPerRequestDateTime": now,
+		},
+		"CreationDateTime":          now,
+		"DeletionProtectionEnabled": in["DeletionProtectionEnabled"] == true,
+		"ItemCount":                 0,
+		"KeySchema":                 in["KeySchema"],
+		"ProvisionedThroughput":     provisionedThroughputDescription(in["ProvisionedThroughput"]),
+		"TableArn":                  arn,
+		"TableId":                   newUUID(),
+		"TableName":                 name,
+		"TableSizeBytes":            0,
+		"TableStatus":               "ACTIVE",
+	}
+	if v, ok := in["TableClass"].(string); ok && v != "" {
+		description["TableClassSummary"] = map[string]any{"TableClass": v}
+	}
+	if v, ok := in["StreamSpecification"].(map[string]any); ok && v["StreamEnabled"] == true {
+		label := time.Now().UTC().Format("2006-01-02T15:04:05.000")
+		description["StreamSpecification"] = v
+		description["LatestStreamArn"] = arn + "/stream/" + label
+		description["LatestStreamLabel"] = label
+	}
+	if v, ok := in["SSESpecification"].(map[string]any); ok && v["Enabled"] == true {
+		sse := map[string]any{
+			"SSEType": "KMS",
+			"Status":  "ENABLED",
+		}
+		if v, ok := v["KMSMasterKeyId"].(string); ok && v != "" {
+			sse["KMSMasterKeyArn"] = v
+		} else {
+			sse["KMSMasterKeyArn"] = r.arn("key/" + newUUID())
+		}
+		description["SSEDescription"] = sse
+	}
+	for _, k := range []string{"GlobalSecondaryIndexes", "LocalSecondaryIndexes"} {
+		indexes, ok := in[k].([]any)
+		if !ok {
+			continue
+		}
+
+		for _, v := range indexes {
+			index, ok := v.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			index["IndexArn"] = arn + "/index/" + index["IndexName"].(string)
+			index["IndexSizeBytes"] = 0
+			index["ItemCount"] = 0
+			if k == "GlobalSecondaryIndexes" {
+				index["IndexStatus"] = "ACTIVE"
+				index["ProvisionedThroughput"] = provisionedThroughputDescription(index["ProvisionedThroughput"])
+			}
+		}
+		description[k] = indexes
+	}
+
+	var keyAttributeNames []string
+	if keySchema, ok := in["KeySchema"].([]any); ok {
+		for _, v := range keySchema {
+			if v, ok := v.(map[string]any); ok {
+				keyAttributeNames = append(keyAttributeNames, v["AttributeName"].(string))
+			}
+		}
+	}
+
+	table := &dynamoDBTable{
+		description:       description,
+		items:             make(map[string]map[string]any),
+		keyAttributeNames: keyAttributeNames,
+		tags:              make(tags),
+		ttl: map[string]any{
+			"TimeToLiveStatus": "DISABLED",
+		},
+	}
+	if v, ok := in["Tags"].([]any); ok {
+		table.tags.set(dynamoDBTags(v))
+	}
+	tables[name] = table
+
+	return map[string]any{
+		"TableDescription": description,
+	}, nil
+}
+
+// provisionedThroughputDescription returns a ProvisionedThroughputDescription for the specified ProvisionedThroughput.
+func provisionedThroughputDescription(v any) map[string]any {
+	description := map[string]any{
+		"NumberOfDecreasesToday": 0,
+		"ReadCapacityUnits":      0,
+		"WriteCapacityUnits":     0,
+	}
+
+	if v, ok := v.(map[string]any); ok {
+		for _, k := range []string{"ReadCapacityUnits", "WriteCapacityUnits"} {
+			if v, ok := v[k]; ok {
+				description[k] = v
+			}
+		}
+	}
+
+	return description
+}
+
+func dynamoDBTags(v []any) []tag {
+	var list []tag
+
+	for _, v := range v {
+		if v, ok := v.(map[string]any); ok {
+			key, _ := v["Key"].(string)
+			value, _ := v["Value"].(string)
+			list = append(list, tag{Key: key, Value: value})
+		}
+	}
+
+	return list
+}
+
+type dynamoDBTableNameInput struct {
+	TableName string `json:"TableName"`
+}
+
+func (s *dynamoDBService) deleteTable(r *request, input *dynamoDBTableNameInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	table, err := s.findTable(r, input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	if table.description["DeletionProtectionEnabled"] == true {
+		return nil, newValidationError("Resource cannot be deleted as it is currently protected against deletion. Disable deletion protection first.")
+	}
+
+	delete(s.tables.region(r.region), table.description["TableName"].(string))
+
+	description := maps.Clone(table.description)
+	description["TableStatus"] = "DELETING"
+
+	return map[string]any{
+		"TableDescription": description,
+	}, nil
+}
+
+func (s *dynamoDBService) describeTable(r *request, input *dynamoDBTableNameInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	table, err := s.findTable(r, input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	description := maps.Clone(table.description)
+	description["ItemCount"] = len(table.items)
+
+	return map[string]any{
+		"Table": description,
+	}, nil
+}
+
+func (s *dynamoDBService) updateTable(r *request, input *map[string]any) (any, error) {
+	in := *input
+	name, _ := in["TableName"].(string)
+
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	table, err := s.findTable(r, name)
+	if err != nil {
+		return nil, err
+	}
+
+	description := table.description
+	if v, ok := in["BillingMode"].(string); ok && v != "" {
+		description["BillingModeSummary"] = map[string]any{
+			"BillingMode":                       v,
+			"LastUpdateToPayPerRequestDateTime": epochSeconds(time.Now()),
+		}
+	}
+	if v, ok := in["ProvisionedThroughput"]; ok {
+		description["ProvisionedThroughput"] = provisionedThroughputDescription(v)
+	}
+	if v, ok := in["DeletionProtectionEnabled"].(bool); ok {
+		description["DeletionProtectionEnabled"] = v
+	}
+	if v, ok := in["TableClass"].(string); ok && v != "" {
+		description["TableClassSummary"] = map[string]any{"TableClass": v}
+	}
+	if v, ok := in["StreamSpecification"].(map[string]any); ok {
+		if v["StreamEnabled"] == true {
+			label := time.Now().UTC().Format("2006-01-02T15:04:05.000")
+			description["StreamSpecification"] = v
+			description["LatestStreamArn"] = description["TableArn"].(string) + "/stream/" + label
+			description["LatestStreamLabel"] = label
+		} else {
+			delete(description, "StreamSpecification")
+		}
+	}
+	if v, ok := in["AttributeDefinitions"]; ok {
+		description["AttributeDefinitions"] = v
+	}
+	if v, ok := in["GlobalSecondaryIndexUpdates"]; ok && v != nil {
+		return nil, newNotImplementedError(r.service, "UpdateTable with GlobalSecondaryIndexUpdates")
+	}
+
+	return map[string]any{
+		"TableDescription": maps.Clone(description),
+	}, nil
+}
+
+type dynamoDBListTablesInput struct {
+	ExclusiveStartTableName string `json:"ExclusiveStartTableName"`
+}
+
+func (s *dynamoDBService) listTables(r *request, input *dynamoDBListTablesInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	var names []string
+	for name := range s.tables.region(r.region) {
+		if name > input.ExclusiveStartTableName {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	return map[string]any{
+		"TableNames": names,
+	}, nil
+}
+
+func (s *dynamoDBService) describeContinuousBackups(r *request, input *dynamoDBTableNameInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	table, err := s.findTable(r, input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"ContinuousBackupsDescription": continuousBackupsDescription(table),
+	}, nil
+}
+
+func continuousBackupsDescription(table *dynamoDBTable) map[string]any {
+	status := "DISABLED"
+	if table.pointInTime {
+		status = "ENABLED"
+	}
+
+	return map[string]any{
+		"ContinuousBackupsStatus": "ENABLED",
+		"PointInTimeRecoveryDescription": map[string]any{
+			"PointInTimeRecoveryStatus": status,
+		},
+	}
+}
+
+type dynamoDBUpdateContinuousBackupsInput struct {
+	PointInTimeRecoverySpecification struct {
+		PointInTimeRecoveryEnabled bool `json:"PointInTimeRecoveryEnabled"`
+	} `json:"PointInTimeRecoverySpecification"`
+	TableName string `json:"TableName"`
+}
+
+func (s *dynamoDBService) updateContinuousBackups(r *request, input *dynamoDBUpdateContinuousBackupsInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	table, err := s.findTable(r, input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	table.pointInTime = input.PointInTimeRecoverySpecification.PointInTimeRecoveryEnabled
+
+	return map[string]any{
+		"ContinuousBackupsDescription": continuousBackupsDescription(table),
+	}, nil
+}
+
+func (s *dynamoDBService) describeKinesisStreamingDestination(r *request, input *dynamoDBTableNameInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	if _, err := s.findTable(r, input.TableName); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"KinesisDataStreamDestinations": []any{},
+		"TableName":                     input.TableName,
+	}, nil
+}
+
+func (s *dynamoDBService) describeTimeToLive(r *request, input *dynamoDBTableNameInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	table, err := s.findTable(r, input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"TimeToLiveDescription": table.ttl,
+	}, nil
+}
+
+type dynamoDBUpdateTimeToLiveInput struct {
+	TableName               string `json:"TableName"`
+	TimeToLiveSpecification struct {
+		AttributeName string `json:"AttributeName"`
+		Enabled       bool   `json:"Enabled"`
+	} `json:"TimeToLiveSpecification"`
+}
+
+func (s *dynamoDBService) updateTimeToLive(r *request, input *dynamoDBUpdateTimeToLiveInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	table, err := s.findTable(r, input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.TimeToLiveSpecification.Enabled {
+		table.ttl = map[string]any{
+			"AttributeName":    input.TimeToLiveSpecification.AttributeName,
+			"TimeToLiveStatus": "ENABLED",
+		}
+	} else {
+		table.ttl = map[string]any{
+			"TimeToLiveStatus": "DISABLED",
+		}
+	}
+
+	return map[string]any{
+		"TimeToLiveSpecification": input.TimeToLiveSpecification,
+	}, nil
+}
+
+type dynamoDBResourceARNInput struct {
+	ResourceARN string   `json:"ResourceArn"`
+	TagKeys     []string `json:"TagKeys"`
+	Tags        []tag    `json:"Tags"`
+}
+
+func (s *dynamoDBService) listTagsOfResource(r *request, input *dynamoDBResourceARNInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	table, err := s.findTable(r, input.ResourceARN)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"Tags": table.tags.list(),
+	}, nil
+}
+
+func (s *dynamoDBService) tagResource(r *request, input *dynamoDBResourceARNInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	table, err := s.findTable(r, input.ResourceARN)
+	if err != nil {
+		return nil, err
+	}
+
+	table.tags.set(input.Tags)
+
+	return nil, nil
+}
+
+func (s *dynamoDBService) untagResource(r *request, input *dynamoDBResourceARNInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	table, err := s.findTable(r, input.ResourceARN)
+	if err != nil {
+		return nil, err
+	}
+
+	table.tags.remove(input.TagKeys)
+
+	return nil, nil
+}
+
+type dynamoDBItemInput struct {
+	Item      map[string]any `json:"Item"`
+	Key       map[string]any `json:"Key"`
+	TableName string         `json:"TableName"`
+}
+
+func (s *dynamoDBService) putItem(r *request, input *dynamoDBItemInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	table, err := s.findTable(r, input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.itemKey(input.Item)
+	if err != nil {
+		return nil, err
+	}
+
+	table.items[key] = input.Item
+
+	return nil, nil
+}
+
+func (s *dynamoDBService) getItem(r *request, input *dynamoDBItemInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	table, err := s.findTable(r, input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.itemKey(input.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	item, ok := table.items[key]
+	if !ok {
+		return nil, nil
+	}
+
+	return map[string]any{
+		"Item": item,
+	}, nil
+}
+
+func (s *dynamoDBService) deleteItem(r *request, input *dynamoDBItemInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	table, err := s.findTable(r, input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.itemKey(input.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(table.items, key)
+
+	return nil, nil
+}
+
+func (s *dynamoDBService) scan(r *request, input *dynamoDBTableNameInput) (any, error) {
+	s.tables.mu.Lock()
+	defer s.tables.mu.Unlock()
+
+	table, err := s.findTable(r, input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(table.items))
+	for k := range table.items {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	items := make([]map[string]any, 0, len(keys))
+	for _, k := range keys {
+		items = append(items, table.items[k])
+	}
+
+	return map[string]any{
+		"Count":        len(items),
+		"Items":        items,
+		"ScannedCount": len(items),
+	}, nil
+}
@@ -0,0 +1,492 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/emulator"
+)
+
+func testAWSConfig(t *testing.T) aws.Config {
+	t.Helper()
+
+	server := emulator.NewServer()
+	t.Cleanup(server.Close)
+
+	return aws.Config{
+		BaseEndpoint: aws.String(server.URL()),
+		Credentials:  credentials.NewStaticCredentialsProvider(emulator.AccessKeyID, emulator.SecretAccessKey, ""),
+		Region:       "us-west-2", //lintignore:AWSAT003
+	}
+}
+
+// testRequest sends an unsigned request with a Signature Version 4 credential scope for the specified service to the emulator.
+func testRequest(t *testing.T, server *emulator.Server, service, method, path, contentType, body string, header map[string]string) (int, string) {
+	t.Helper()
+
+	request, err := http.NewRequestWithContext(context.Background(), method, server.URL()+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+emulator.AccessKeyID+"/20240101/us-west-2/"+service+"/aws4_request, SignedHeaders=host, Signature=0") //lintignore:AWSAT003
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+	for k, v := range header {
+		request.Header.Set(k, v)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	b, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return response.StatusCode, string(b)
+}
+
+func TestSTS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := sts.NewFromConfig(testAWSConfig(t))
+
+	output, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatalf("GetCallerIdentity: %s", err)
+	}
+
+	if got, want := aws.ToString(output.Account), emulator.AccountID; got != want {
+		t.Errorf("Account = %q, want %q", got, want)
+	}
+}
+
+func TestSQS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := sqs.NewFromConfig(testAWSConfig(t))
+
+	createOutput, err := conn.CreateQueue(ctx, &sqs.CreateQueueInput{
+		Attributes: map[string]string{
+			string(sqstypes.QueueAttributeNameDelaySeconds): "90",
+		},
+		QueueName: aws.String("test"),
+		Tags: map[string]string{
+			"Key1": "Value1",
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateQueue: %s", err)
+	}
+
+	queueURL := aws.ToString(createOutput.QueueUrl)
+
+	attributesOutput, err := conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameAll},
+		QueueUrl:       aws.String(queueURL),
+	})
+	if err != nil {
+		t.Fatalf("GetQueueAttributes: %s", err)
+	}
+
+	if got, want := attributesOutput.Attributes[string(sqstypes.QueueAttributeNameDelaySeconds)], "90"; got != want {
+		t.Errorf("DelaySeconds = %q, want %q", got, want)
+	}
+	if got, want := attributesOutput.Attributes[string(sqstypes.QueueAttributeNameQueueArn)], "arn:aws:sqs:us-west-2:123456789012:test"; got != want { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("QueueArn = %q, want %q", got, want)
+	}
+
+	tagsOutput, err := conn.ListQueueTags(ctx, &sqs.ListQueueTagsInput{
+		QueueUrl: aws.String(queueURL),
+	})
+	if err != nil {
+		t.Fatalf("ListQueueTags: %s", err)
+	}
+
+	if got, want := tagsOutput.Tags["Key1"], "Value1"; got != want {
+		t.Errorf("tag Key1 = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteQueue(ctx, &sqs.DeleteQueueInput{
+		QueueUrl: aws.String(queueURL),
+	}); err != nil {
+		t.Fatalf("DeleteQueue: %s", err)
+	}
+
+	_, err = conn.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
+		QueueName: aws.String("test"),
+	})
+
+	// The provider checks for the legacy AWS Query protocol error code.
+	var apiErr interface{ ErrorCode() string }
+	if !errors.As(err, &apiErr) || apiErr.ErrorCode() != "AWS.SimpleQueueService.NonExistentQueue" {
+		t.Errorf("GetQueueUrl error = %v, want AWS.SimpleQueueService.NonExistentQueue", err)
+	}
+}
+
+func TestDynamoDB(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := dynamodb.NewFromConfig(testAWSConfig(t))
+
+	if _, err := conn.CreateTable(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: []dynamodbtypes.AttributeDefinition{{
+			AttributeName: aws.String("pk"),
+			AttributeType: dynamodbtypes.ScalarAttributeTypeS,
+		}},
+		BillingMode: dynamodbtypes.BillingModePayPerRequest,
+		KeySchema: []dynamodbtypes.KeySchemaElement{{
+			AttributeName: aws.String("pk"),
+			KeyType:       dynamodbtypes.KeyTypeHash,
+		}},
+		TableName: aws.String("test"),
+	}); err != nil {
+		t.Fatalf("CreateTable: %s", err)
+	}
+
+	if _, err := conn.PutItem(ctx, &dynamodb.PutItemInput{
+		Item: map[string]dynamodbtypes.AttributeValue{
+			"pk":    &dynamodbtypes.AttributeValueMemberS{Value: "k1"},
+			"value": &dynamodbtypes.AttributeValueMemberS{Value: "v1"},
+		},
+		TableName: aws.String("test"),
+	}); err != nil {
+		t.Fatalf("PutItem: %s", err)
+	}
+
+	getOutput, err := conn.GetItem(ctx, &dynamodb.GetItemInput{
+		Key: map[string]dynamodbtypes.AttributeValue{
+			"pk": &dynamodbtypes.AttributeValueMemberS{Value: "k1"},
+		},
+		TableName: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("GetItem: %s", err)
+	}
+
+	if v, ok := getOutput.Item["value"].(*dynamodbtypes.AttributeValueMemberS); !ok || v.Value != "v1" {
+		t.Errorf("GetItem value = %#v, want v1", getOutput.Item["value"])
+	}
+
+	describeOutput, err := conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+		TableName: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("DescribeTable: %s", err)
+	}
+
+	if got, want := describeOutput.Table.TableStatus, dynamodbtypes.TableStatusActive; got != want {
+		t.Errorf("TableStatus = %q, want %q", got, want)
+	}
+	if got, want := describeOutput.Table.BillingModeSummary.BillingMode, dynamodbtypes.BillingModePayPerRequest; got != want {
+		t.Errorf("BillingMode = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteTable(ctx, &dynamodb.DeleteTableInput{
+		TableName: aws.String("test"),
+	}); err != nil {
+		t.Fatalf("DeleteTable: %s", err)
+	}
+
+	_, err = conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+		TableName: aws.String("test"),
+	})
+
+	var nfe *dynamodbtypes.ResourceNotFoundException
+	if !errors.As(err, &nfe) {
+		t.Errorf("DescribeTable error = %v, want ResourceNotFoundException", err)
+	}
+}
+
+func TestQueryProtocolServices(t *testing.T) {
+	t.Parallel()
+
+	server := emulator.NewServer()
+	t.Cleanup(server.Close)
+
+	const contentType = "application/x-www-form-urlencoded"
+
+	testCases := map[string]struct {
+		service    string
+		body       url.Values
+		wantStatus int
+		wantBody   string
+	}{
+		"IAM CreateRole": {
+			service: "iam",
+			body: url.Values{
+				"Action":                   {"CreateRole"},
+				"AssumeRolePolicyDocument": {`{"Version":"2012-10-17","Statement":[]}`},
+				"RoleName":                 {"test"},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "<Arn>arn:aws:iam::123456789012:role/test</Arn>",
+		},
+		"IAM GetRole not found": {
+			service: "iam",
+			body: url.Values{
+				"Action":   {"GetRole"},
+				"RoleName": {"missing"},
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   "<Code>NoSuchEntity</Code>",
+		},
+		"SNS CreateTopic": {
+			service: "sns",
+			body: url.Values{
+				"Action": {"CreateTopic"},
+				"Name":   {"test"},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "<TopicArn>arn:aws:sns:us-west-2:123456789012:test</TopicArn>", //lintignore:AWSAT003,AWSAT005
+		},
+		"not emulated": {
+			service: "sns",
+			body: url.Values{
+				"Action": {"Publish"},
+			},
+			wantStatus: http.StatusNotImplemented,
+			wantBody:   "<Code>NotImplemented</Code>",
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // Test cases share an emulator and run in order.
+		t.Run(name, func(t *testing.T) {
+			status, body := testRequest(t, server, testCase.service, http.MethodPost, "/", contentType, testCase.body.Encode(), nil)
+
+			if status != testCase.wantStatus {
+				t.Errorf("status = %d, want %d: %s", status, testCase.wantStatus, body)
+			}
+			if !strings.Contains(body, testCase.wantBody) {
+				t.Errorf("body = %s, want it to contain %s", body, testCase.wantBody)
+			}
+		})
+	}
+}
+
+func TestJSONProtocolServices(t *testing.T) {
+	t.Parallel()
+
+	server := emulator.NewServer()
+	t.Cleanup(server.Close)
+
+	const contentType = "application/x-amz-json-1.1"
+
+	testCases := []struct {
+		name       string
+		service    string
+		target     string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "SSM PutParameter",
+			service:    "ssm",
+			target:     "AmazonSSM.PutParameter",
+			body:       `{"Name":"/test","Type":"SecureString","Value":"secret"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `"Version":1`,
+		},
+		{
+			name:       "SSM GetParameter",
+			service:    "ssm",
+			target:     "AmazonSSM.GetParameter",
+			body:       `{"Name":"/test","WithDecryption":true}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `"Value":"secret"`,
+		},
+		{
+			name:       "SSM GetParameter not found",
+			service:    "ssm",
+			target:     "AmazonSSM.GetParameter",
+			body:       `{"Name":"/missing"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `"__type":"ParameterNotFound"`,
+		},
+		{
+			name:       "Secrets Manager CreateSecret",
+			service:    "secretsmanager",
+			target:     "secretsmanager.CreateSecret",
+			body:       `{"Name":"test","SecretString":"secret"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `"Name":"test"`,
+		},
+		{
+			name:       "Secrets Manager GetSecretValue",
+			service:    "secretsmanager",
+			target:     "secretsmanager.GetSecretValue",
+			body:       `{"SecretId":"test"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `"SecretString":"secret"`,
+		},
+		{
+			name:       "KMS CreateKey",
+			service:    "kms",
+			target:     "TrentService.CreateKey",
+			body:       `{"Description":"test"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `"KeyState":"Enabled"`,
+		},
+		{
+			name:       "KMS DescribeKey not found",
+			service:    "kms",
+			target:     "TrentService.DescribeKey",
+			body:       `{"KeyId":"alias/missing"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `"__type":"NotFoundException"`,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:paralleltest // Test cases share an emulator and run in order.
+		t.Run(testCase.name, func(t *testing.T) {
+			status, body := testRequest(t, server, testCase.service, http.MethodPost, "/", contentType, testCase.body, map[string]string{
+				"X-Amz-Target": testCase.target,
+			})
+
+			if status != testCase.wantStatus {
+				t.Errorf("status = %d, want %d: %s", status, testCase.wantStatus, body)
+			}
+			if !strings.Contains(body, testCase.wantBody) {
+				t.Errorf("body = %s, want it to contain %s", body, testCase.wantBody)
+			}
+		})
+	}
+}
+
+func TestS3(t *testing.T) {
+	t.Parallel()
+
+	server := emulator.NewServer()
+	t.Cleanup(server.Close)
+
+	testCases := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		header     map[string]string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "CreateBucket",
+			method:     http.MethodPut,
+			path:       "/test-bucket",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "GetBucketPolicy not found",
+			method:     http.MethodGet,
+			path:       "/test-bucket?policy=",
+			wantStatus: http.StatusNotFound,
+			wantBody:   "<Code>NoSuchBucketPolicy</Code>",
+		},
+		{
+			name:       "GetBucketEncryption default",
+			method:     http.MethodGet,
+			path:       "/test-bucket?encryption=",
+			wantStatus: http.StatusOK,
+			wantBody:   "<SSEAlgorithm>AES256</SSEAlgorithm>",
+		},
+		{
+			name:       "PutBucketTagging",
+			method:     http.MethodPut,
+			path:       "/test-bucket?tagging=",
+			body:       `<Tagging><TagSet><Tag><Key>k</Key><Value>v</Value></Tag></TagSet></Tagging>`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "GetBucketTagging",
+			method:     http.MethodGet,
+			path:       "/test-bucket?tagging=",
+			wantStatus: http.StatusOK,
+			wantBody:   "<Key>k</Key>",
+		},
+		{
+			name:   "PutObject aws-chunked",
+			method: http.MethodPut,
+			path:   "/test-bucket/dir/key",
+			body:   "5\r\nhello\r\n0\r\nx-amz-checksum-crc32:NhCmhg==\r\n\r\n",
+			header: map[string]string{
+				"Content-Encoding": "aws-chunked",
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "GetObject",
+			method:     http.MethodGet,
+			path:       "/test-bucket/dir/key",
+			wantStatus: http.StatusOK,
+			wantBody:   "hello",
+		},
+		{
+			name:       "ListObjectsV2",
+			method:     http.MethodGet,
+			path:       "/test-bucket?list-type=2&prefix=dir%2F",
+			wantStatus: http.StatusOK,
+			wantBody:   "<Key>dir/key</Key>",
+		},
+		{
+			name:       "DeleteBucket not empty",
+			method:     http.MethodDelete,
+			path:       "/test-bucket",
+			wantStatus: http.StatusConflict,
+			wantBody:   "<Code>BucketNotEmpty</Code>",
+		},
+		{
+			name:       "DeleteObject",
+			method:     http.MethodDelete,
+			path:       "/test-bucket/dir/key",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "DeleteBucket",
+			method:     http.MethodDelete,
+			path:       "/test-bucket",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "HeadBucket not found",
+			method:     http.MethodHead,
+			path:       "/test-bucket",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:paralleltest // Test cases share an emulator and run in order.
+		t.Run(testCase.name, func(t *testing.T) {
+			status, body := testRequest(t, server, "s3", testCase.method, testCase.path, "", testCase.body, testCase.header)
+
+			if status != testCase.wantStatus {
+				t.Errorf("status = %d, want %d: %s", status, testCase.wantStatus, body)
+			}
+			if !strings.Contains(body, testCase.wantBody) {
+				t.Errorf("body = %s, want it to contain %s", body, testCase.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,1083 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	errCodeIAMDeleteConflict = "DeleteConflict"
+	errCodeIAMEntityExists   = "EntityAlreadyExists"
+	errCodeIAMNoSuchEntity   = "NoSuchEntity"
+)
+
+// iamService emulates AWS Identity and Access Management (IAM).
+// IAM is a global service; resources are not partitioned by AWS Region.
+type iamService struct {
+	mu         sync.Mutex
+	operations map[string]queryOperation
+	policies   map[string]*iamPolicy
+	roles      map[string]*iamRole
+	users      map[string]*iamUser
+}
+
+type iamRole struct {
+	arn                      string
+	assumeRolePolicyDocument string
+	attachedPolicyARNs       []string
+	createDate               time.Time
+	description              string
+	inlinePolicies           map[string]string
+	maxSessionDuration       int
+	name                     string
+	path                     string
+	permissionsBoundary      string
+	roleID                   string
+	tags                     tags
+}
+
+type iamPolicy struct {
+	arn              string
+	createDate       time.Time
+	defaultVersionID string
+	description      string
+	name             string
+	nextVersion      int
+	path             string
+	policyID         string
+	tags             tags
+	updateDate       time.Time
+	versions         map[string]*iamPolicyVersion
+}
+
+type iamPolicyVersion struct {
+	createDate time.Time
+	document   string
+	versionID  string
+}
+
+type iamUser struct {
+	arn        string
+	createDate time.Time
+	name       string
+	path       string
+	tags       tags
+	userID     string
+}
+
+func newIAM() *iamService {
+	s := &iamService{
+		policies: make(map[string]*iamPolicy),
+		roles:    make(map[string]*iamRole),
+		users:    make(map[string]*iamUser),
+	}
+	s.operations = map[string]queryOperation{
+		"AttachRolePolicy":               s.attachRolePolicy,
+		"CreatePolicy":                   s.createPolicy,
+		"CreatePolicyVersion":            s.createPolicyVersion,
+		"CreateRole":                     s.createRole,
+		"CreateUser":                     s.createUser,
+		"DeletePolicy":                   s.deletePolicy,
+		"DeletePolicyVersion":            s.deletePolicyVersion,
+		"DeleteRole":                     s.deleteRole,
+		"DeleteRolePermissionsBoundary":  s.deleteRolePermissionsBoundary,
+		"DeleteRolePolicy":               s.deleteRolePolicy,
+		"DeleteUser":                     s.deleteUser,
+		"DetachRolePolicy":               s.detachRolePolicy,
+		"GetPolicy":                      s.getPolicy,
+		"GetPolicyVersion":               s.getPolicyVersion,
+		"GetRole":                        s.getRole,
+		"GetRolePolicy":                  s.getRolePolicy,
+		"GetUser":                        s.getUser,
+		"ListAccessKeys":                 s.listEmpty("AccessKeyMetadata"),
+		"ListAttachedRolePolicies":       s.listAttachedRolePolicies,
+		"ListAttachedUserPolicies":       s.listEmpty("AttachedPolicies"),
+		"ListEntitiesForPolicy":          s.listEntitiesForPolicy,
+		"ListGroupsForUser":              s.listEmpty("Groups"),
+		"ListInstanceProfilesForRole":    s.listEmpty("InstanceProfiles"),
+		"ListMFADevices":                 s.listEmpty("MFADevices"),
+		"ListPolicyTags":                 s.listPolicyTags,
+		"ListPolicyVersions":             s.listPolicyVersions,
+		"ListRolePolicies":               s.listRolePolicies,
+		"ListRoleTags":                   s.listRoleTags,
+		"ListRoles":                      s.listRoles,
+		"ListSSHPublicKeys":              s.listEmpty("SSHPublicKeys"),
+		"ListServiceSpecificCredentials": s.listEmpty("ServiceSpecificCredentials"),
+		"ListSigningCertificates":        s.listEmpty("Certificates"),
+		"ListUserPolicies":               s.listEmpty("PolicyNames"),
+		"ListUserTags":                   s.listUserTags,
+		"PutRolePermissionsBoundary":     s.putRolePermissionsBoundary,
+		"PutRolePolicy":                  s.putRolePolicy,
+		"TagPolicy":                      s.tagPolicy,
+		"TagRole":                        s.tagRole,
+		"TagUser":                        s.tagUser,
+		"UntagPolicy":                    s.untagPolicy,
+		"UntagRole":                      s.untagRole,
+		"UntagUser":                      s.untagUser,
+		"UpdateAssumeRolePolicy":         s.updateAssumeRolePolicy,
+		"UpdateRole":                     s.updateRole,
+		"UpdateRoleDescription":          s.updateRoleDescription,
+	}
+
+	return s
+}
+
+func (s *iamService) serve(w http.ResponseWriter, r *request) {
+	serveQuery(w, r, "https://iam.amazonaws.com/doc/2010-05-08/", s.operations)
+}
+
+func iamARN(resource string) string {
+	return fmt.Sprintf("arn:%s:iam::%s:%s", Partition, AccountID, resource)
+}
+
+func newIAMNoSuchEntityError(format string, a ...any) *apiError {
+	return newError(http.StatusNotFound, errCodeIAMNoSuchEntity, format, a...)
+}
+
+// iamPath returns the specified IAM path, defaulting to "/".
+func iamPath(path string) string {
+	if path == "" {
+		return "/"
+	}
+
+	return path
+}
+
+// listEmpty returns an operation that lists no resources in the specified result member.
+func (s *iamService) listEmpty(member string) queryOperation {
+	return func(r *request, form url.Values) (any, error) {
+		return struct {
+			Contents string `xml:",innerxml"`
+		}{
+			Contents: fmt.Sprintf("<%[1]s></%[1]s><IsTruncated>false</IsTruncated>", member),
+		}, nil
+	}
+}
+
+//
+// Roles.
+//
+
+type iamRoleXML struct {
+	ARN                      string                     `xml:"Arn"`
+	AssumeRolePolicyDocument string                     `xml:"AssumeRolePolicyDocument"`
+	CreateDate               string                     `xml:"CreateDate"`
+	Description              string                     `xml:"Description,omitempty"`
+	MaxSessionDuration       int                        `xml:"MaxSessionDuration"`
+	Path                     string                     `xml:"Path"`
+	PermissionsBoundary      *iamPermissionsBoundaryXML `xml:"PermissionsBoundary,omitempty"`
+	RoleID                   string                     `xml:"RoleId"`
+	RoleName                 string                     `xml:"RoleName"`
+	Tags                     []tag                      `xml:"Tags>member,omitempty"`
+}
+
+type iamPermissionsBoundaryXML struct {
+	PermissionsBoundaryARN  string `xml:"PermissionsBoundaryArn"`
+	PermissionsBoundaryType string `xml:"PermissionsBoundaryType"`
+}
+
+func (role *iamRole) xml() iamRoleXML {
+	v := iamRoleXML{
+		ARN:                      role.arn,
+		AssumeRolePolicyDocument: url.QueryEscape(role.assumeRolePolicyDocument),
+		CreateDate:               iso8601(role.createDate),
+		Description:              role.description,
+		MaxSessionDuration:       role.maxSessionDuration,
+		Path:                     role.path,
+		RoleID:                   role.roleID,
+		RoleName:                 role.name,
+		Tags:                     role.tags.list(),
+	}
+	if role.permissionsBoundary != "" {
+		v.PermissionsBoundary = &iamPermissionsBoundaryXML{
+			PermissionsBoundaryARN:  role.permissionsBoundary,
+			PermissionsBoundaryType: "Policy",
+		}
+	}
+
+	return v
+}
+
+// findRole returns the role with the specified name.
+// The service's mutex must be held.
+func (s *iamService) findRole(name string) (*iamRole, error) {
+	role, ok := s.roles[name]
+	if !ok {
+		return nil, newIAMNoSuchEntityError("The role with name %s cannot be found.", name)
+	}
+
+	return role, nil
+}
+
+func (s *iamService) createRole(r *request, form url.Values) (any, error) {
+	name := form.Get("RoleName")
+	if name == "" {
+		return nil, newError(http.StatusBadRequest, "ValidationError", "RoleName is required")
+	}
+
+	maxSessionDuration := 3600
+	if v := form.Get("MaxSessionDuration"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "ValidationError", "invalid MaxSessionDuration: %s", v)
+		}
+		maxSessionDuration = n
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.roles[name]; ok {
+		return nil, newError(http.StatusConflict, errCodeIAMEntityExists, "Role with name %s already exists.", name)
+	}
+
+	path := iamPath(form.Get("Path"))
+	role := &iamRole{
+		arn:                      iamARN("role" + path + name),
+		assumeRolePolicyDocument: form.Get("AssumeRolePolicyDocument"),
+		createDate:               time.Now(),
+		description:              form.Get("Description"),
+		inlinePolicies:           make(map[string]string),
+		maxSessionDuration:       maxSessionDuration,
+		name:                     name,
+		path:                     path,
+		permissionsBoundary:      form.Get("PermissionsBoundary"),
+		roleID:                   strings.ToUpper(newID("AROA", 17)),
+		tags:                     make(tags),
+	}
+	role.tags.set(tagsFromQuery(form, "Tags"))
+	s.roles[name] = role
+
+	return struct {
+		Role iamRoleXML `xml:"Role"`
+	}{
+		Role: role.xml(),
+	}, nil
+}
+
+func (s *iamService) getRole(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		Role iamRoleXML `xml:"Role"`
+	}{
+		Role: role.xml(),
+	}, nil
+}
+
+func (s *iamService) deleteRole(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(role.attachedPolicyARNs) > 0 || len(role.inlinePolicies) > 0 {
+		return nil, newError(http.StatusConflict, errCodeIAMDeleteConflict, "Cannot delete entity, must detach all policies first.")
+	}
+
+	delete(s.roles, role.name)
+
+	return nil, nil
+}
+
+func (s *iamService) listRoles(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prefix := iamPath(form.Get("PathPrefix"))
+
+	names := make([]string, 0, len(s.roles))
+	for name, role := range s.roles {
+		if strings.HasPrefix(role.path, prefix) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	roles := make([]iamRoleXML, 0, len(names))
+	for _, name := range names {
+		roles = append(roles, s.roles[name].xml())
+	}
+
+	return struct {
+		IsTruncated bool         `xml:"IsTruncated"`
+		Roles       []iamRoleXML `xml:"Roles>member"`
+	}{
+		Roles: roles,
+	}, nil
+}
+
+func (s *iamService) updateRole(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := form["Description"]; ok {
+		role.description = form.Get("Description")
+	}
+	if v := form.Get("MaxSessionDuration"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "ValidationError", "invalid MaxSessionDuration: %s", v)
+		}
+		role.maxSessionDuration = n
+	}
+
+	return nil, nil
+}
+
+func (s *iamService) updateRoleDescription(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	role.description = form.Get("Description")
+
+	return struct {
+		Role iamRoleXML `xml:"Role"`
+	}{
+		Role: role.xml(),
+	}, nil
+}
+
+func (s *iamService) updateAssumeRolePolicy(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	role.assumeRolePolicyDocument = form.Get("PolicyDocument")
+
+	return nil, nil
+}
+
+func (s *iamService) putRolePermissionsBoundary(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	role.permissionsBoundary = form.Get("PermissionsBoundary")
+
+	return nil, nil
+}
+
+func (s *iamService) deleteRolePermissionsBoundary(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	role.permissionsBoundary = ""
+
+	return nil, nil
+}
+
+func (s *iamService) putRolePolicy(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	role.inlinePolicies[form.Get("PolicyName")] = form.Get("PolicyDocument")
+
+	return nil, nil
+}
+
+func (s *iamService) getRolePolicy(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	policyName := form.Get("PolicyName")
+	document, ok := role.inlinePolicies[policyName]
+	if !ok {
+		return nil, newIAMNoSuchEntityError("The role policy with name %s cannot be found.", policyName)
+	}
+
+	return struct {
+		PolicyDocument string `xml:"PolicyDocument"`
+		PolicyName     string `xml:"PolicyName"`
+		RoleName       string `xml:"RoleName"`
+	}{
+		PolicyDocument: url.QueryEscape(document),
+		PolicyName:     policyName,
+		RoleName:       role.name,
+	}, nil
+}
+
+func (s *iamService) deleteRolePolicy(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	policyName := form.Get("PolicyName")
+	if _, ok := role.inlinePolicies[policyName]; !ok {
+		return nil, newIAMNoSuchEntityError("The role policy with name %s cannot be found.", policyName)
+	}
+
+	delete(role.inlinePolicies, policyName)
+
+	return nil, nil
+}
+
+func (s *iamService) listRolePolicies(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(role.inlinePolicies))
+	for name := range role.inlinePolicies {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return struct {
+		IsTruncated bool     `xml:"IsTruncated"`
+		PolicyNames []string `xml:"PolicyNames>member"`
+	}{
+		PolicyNames: names,
+	}, nil
+}
+
+type iamAttachedPolicyXML struct {
+	PolicyARN  string `xml:"PolicyArn"`
+	PolicyName string `xml:"PolicyName"`
+}
+
+func (s *iamService) attachRolePolicy(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	policyARN := form.Get("PolicyArn")
+	// AWS managed policies are not emulated and are assumed to exist.
+	if !strings.HasPrefix(policyARN, fmt.Sprintf("arn:%s:iam::aws:", Partition)) {
+		if _, err := s.findPolicy(policyARN); err != nil {
+			return nil, err
+		}
+	}
+
+	if !slices.Contains(role.attachedPolicyARNs, policyARN) {
+		role.attachedPolicyARNs = append(role.attachedPolicyARNs, policyARN)
+	}
+
+	return nil, nil
+}
+
+func (s *iamService) detachRolePolicy(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	policyARN := form.Get("PolicyArn")
+	i := slices.Index(role.attachedPolicyARNs, policyARN)
+	if i < 0 {
+		return nil, newIAMNoSuchEntityError("Policy %s was not found.", policyARN)
+	}
+
+	role.attachedPolicyARNs = slices.Delete(role.attachedPolicyARNs, i, i+1)
+
+	return nil, nil
+}
+
+func (s *iamService) listAttachedRolePolicies(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]iamAttachedPolicyXML, 0, len(role.attachedPolicyARNs))
+	for _, arn := range role.attachedPolicyARNs {
+		policies = append(policies, iamAttachedPolicyXML{
+			PolicyARN:  arn,
+			PolicyName: arn[strings.LastIndexByte(arn, '/')+1:],
+		})
+	}
+
+	return struct {
+		AttachedPolicies []iamAttachedPolicyXML `xml:"AttachedPolicies>member"`
+		IsTruncated      bool                   `xml:"IsTruncated"`
+	}{
+		AttachedPolicies: policies,
+	}, nil
+}
+
+func (s *iamService) listRoleTags(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	return iamTagsXML(role.tags), nil
+}
+
+func (s *iamService) tagRole(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	role.tags.set(tagsFromQuery(form, "Tags"))
+
+	return nil, nil
+}
+
+func (s *iamService) untagRole(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	role.tags.remove(queryList(form, "TagKeys"))
+
+	return nil, nil
+}
+
+func iamTagsXML(t tags) any {
+	return struct {
+		IsTruncated bool  `xml:"IsTruncated"`
+		Tags        []tag `xml:"Tags>member"`
+	}{
+		Tags: t.list(),
+	}
+}
+
+//
+// Managed policies.
+//
+
+type iamPolicyXML struct {
+	ARN                           string `xml:"Arn"`
+	AttachmentCount               int    `xml:"AttachmentCount"`
+	CreateDate                    string `xml:"CreateDate"`
+	DefaultVersionID              string `xml:"DefaultVersionId"`
+	Description                   string `xml:"Description,omitempty"`
+	IsAttachable                  bool   `xml:"IsAttachable"`
+	Path                          string `xml:"Path"`
+	PermissionsBoundaryUsageCount int    `xml:"PermissionsBoundaryUsageCount"`
+	PolicyID                      string `xml:"PolicyId"`
+	PolicyName                    string `xml:"PolicyName"`
+	Tags                          []tag  `xml:"Tags>member,omitempty"`
+	UpdateDate                    string `xml:"UpdateDate"`
+}
+
+type iamPolicyVersionXML struct {
+	CreateDate       string `xml:"CreateDate"`
+	Document         string `xml:"Document,omitempty"`
+	IsDefaultVersion bool   `xml:"IsDefaultVersion"`
+	VersionID        string `xml:"VersionId"`
+}
+
+// attachmentCount returns the number of roles that the policy is attached to.
+// The service's mutex must be held.
+func (s *iamService) attachmentCount(policy *iamPolicy) int {
+	n := 0
+	for _, role := range s.roles {
+		if slices.Contains(role.attachedPolicyARNs, policy.arn) {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (s *iamService) policyXML(policy *iamPolicy) iamPolicyXML {
+	return iamPolicyXML{
+		ARN:              policy.arn,
+		AttachmentCount:  s.attachmentCount(policy),
+		CreateDate:       iso8601(policy.createDate),
+		DefaultVersionID: policy.defaultVersionID,
+		Description:      policy.description,
+		IsAttachable:     true,
+		Path:             policy.path,
+		PolicyID:         policy.policyID,
+		PolicyName:       policy.name,
+		Tags:             policy.tags.list(),
+		UpdateDate:       iso8601(policy.updateDate),
+	}
+}
+
+func (policy *iamPolicy) versionXML(version *iamPolicyVersion, document bool) iamPolicyVersionXML {
+	v := iamPolicyVersionXML{
+		CreateDate:       iso8601(version.createDate),
+		IsDefaultVersion: version.versionID == policy.defaultVersionID,
+		VersionID:        version.versionID,
+	}
+	if document {
+		v.Document = url.QueryEscape(version.document)
+	}
+
+	return v
+}
+
+// addVersion adds a new version with the specified document to the policy.
+func (policy *iamPolicy) addVersion(document string) *iamPolicyVersion {
+	policy.nextVersion++
+	now := time.Now()
+	version := &iamPolicyVersion{
+		createDate: now,
+		document:   document,
+		versionID:  "v" + strconv.Itoa(policy.nextVersion),
+	}
+	policy.versions[version.versionID] = version
+	policy.updateDate = now
+
+	return version
+}
+
+// findPolicy returns the customer managed policy with the specified ARN.
+// The service's mutex must be held.
+func (s *iamService) findPolicy(arn string) (*iamPolicy, error) {
+	policy, ok := s.policies[arn]
+	if !ok {
+		return nil, newIAMNoSuchEntityError("Policy %s does not exist or is not attachable.", arn)
+	}
+
+	return policy, nil
+}
+
+func (s *iamService) createPolicy(r *request, form url.Values) (any, error) {
+	name := form.Get("PolicyName")
+	if name == "" {
+		return nil, newError(http.StatusBadRequest, "ValidationError", "PolicyName is required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := iamPath(form.Get("Path"))
+	arn := iamARN("policy" + path + name)
+	if _, ok := s.policies[arn]; ok {
+		return nil, newError(http.StatusConflict, errCodeIAMEntityExists, "A policy called %s already exists. Duplicate names are not allowed.", name)
+	}
+
+	now := time.Now()
+	policy := &iamPolicy{
+		arn:         arn,
+		createDate:  now,
+		description: form.Get("Description"),
+		name:        name,
+		path:        path,
+		policyID:    strings.ToUpper(newID("ANPA", 17)),
+		tags:        make(tags),
+		versions:    make(map[string]*iamPolicyVersion),
+	}
+	policy.defaultVersionID = policy.addVersion(form.Get("PolicyDocument")).versionID
+	policy.tags.set(tagsFromQuery(form, "Tags"))
+	s.policies[arn] = policy
+
+	return struct {
+		Policy iamPolicyXML `xml:"Policy"`
+	}{
+		Policy: s.policyXML(policy),
+	}, nil
+}
+
+func (s *iamService) getPolicy(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, err := s.findPolicy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		Policy iamPolicyXML `xml:"Policy"`
+	}{
+		Policy: s.policyXML(policy),
+	}, nil
+}
+
+func (s *iamService) deletePolicy(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, err := s.findPolicy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	if s.attachmentCount(policy) > 0 {
+		return nil, newError(http.StatusConflict, errCodeIAMDeleteConflict, "Cannot delete a policy attached to entities.")
+	}
+	if len(policy.versions) > 1 {
+		return nil, newError(http.StatusConflict, errCodeIAMDeleteConflict, "This policy has more than one version. Before you delete a policy, you must delete the policy's versions.")
+	}
+
+	delete(s.policies, policy.arn)
+
+	return nil, nil
+}
+
+func (s *iamService) createPolicyVersion(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, err := s.findPolicy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policy.versions) >= 5 {
+		return nil, newError(http.StatusConflict, "LimitExceeded", "A managed policy can have up to 5 versions.")
+	}
+
+	version := policy.addVersion(form.Get("PolicyDocument"))
+	if form.Get("SetAsDefault") == "true" {
+		policy.defaultVersionID = version.versionID
+	}
+
+	return struct {
+		PolicyVersion iamPolicyVersionXML `xml:"PolicyVersion"`
+	}{
+		PolicyVersion: policy.versionXML(version, false),
+	}, nil
+}
+
+func (s *iamService) getPolicyVersion(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, err := s.findPolicy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	versionID := form.Get("VersionId")
+	version, ok := policy.versions[versionID]
+	if !ok {
+		return nil, newIAMNoSuchEntityError("Policy %s version %s does not exist or is not attachable.", policy.arn, versionID)
+	}
+
+	return struct {
+		PolicyVersion iamPolicyVersionXML `xml:"PolicyVersion"`
+	}{
+		PolicyVersion: policy.versionXML(version, true),
+	}, nil
+}
+
+func (s *iamService) deletePolicyVersion(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, err := s.findPolicy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	versionID := form.Get("VersionId")
+	if _, ok := policy.versions[versionID]; !ok {
+		return nil, newIAMNoSuchEntityError("Policy %s version %s does not exist.", policy.arn, versionID)
+	}
+	if versionID == policy.defaultVersionID {
+		return nil, newError(http.StatusConflict, errCodeIAMDeleteConflict, "Cannot delete the default version of a policy.")
+	}
+
+	delete(policy.versions, versionID)
+
+	return nil, nil
+}
+
+func (s *iamService) listPolicyVersions(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, err := s.findPolicy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]iamPolicyVersionXML, 0, len(policy.versions))
+	for _, version := range policy.versions {
+		versions = append(versions, policy.versionXML(version, false))
+	}
+	slices.SortFunc(versions, func(a, b iamPolicyVersionXML) int {
+		return strings.Compare(b.CreateDate, a.CreateDate)
+	})
+
+	return struct {
+		IsTruncated bool                  `xml:"IsTruncated"`
+		Versions    []iamPolicyVersionXML `xml:"Versions>member"`
+	}{
+		Versions: versions,
+	}, nil
+}
+
+func (s *iamService) listEntitiesForPolicy(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policyARN := form.Get("PolicyArn")
+
+	type policyRole struct {
+		RoleID   string `xml:"RoleId"`
+		RoleName string `xml:"RoleName"`
+	}
+	var roles []policyRole
+	for _, role := range s.roles {
+		if slices.Contains(role.attachedPolicyARNs, policyARN) {
+			roles = append(roles, policyRole{RoleID: role.roleID, RoleName: role.name})
+		}
+	}
+	slices.SortFunc(roles, func(a, b policyRole) int {
+		return strings.Compare(a.RoleName, b.RoleName)
+	})
+
+	return struct {
+		IsTruncated  bool         `xml:"IsTruncated"`
+		PolicyGroups struct{}     `xml:"PolicyGroups"`
+		PolicyRoles  []policyRole `xml:"PolicyRoles>member"`
+		PolicyUsers  struct{}     `xml:"PolicyUsers"`
+	}{
+		PolicyRoles: roles,
+	}, nil
+}
+
+func (s *iamService) listPolicyTags(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, err := s.findPolicy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	return iamTagsXML(policy.tags), nil
+}
+
+func (s *iamService) tagPolicy(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, err := s.findPolicy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	policy.tags.set(tagsFromQuery(form, "Tags"))
+
+	return nil, nil
+}
+
+func (s *iamService) untagPolicy(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, err := s.findPolicy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	policy.tags.remove(queryList(form, "TagKeys"))
+
+	return nil, nil
+}
+
+//
+// Users.
+//
+
+type iamUserXML struct {
+	ARN        string `xml:"Arn"`
+	CreateDate string `xml:"CreateDate"`
+	Path       string `xml:"Path"`
+	Tags       []tag  `xml:"Tags>member,omitempty"`
+	UserID     string `xml:"UserId"`
+	UserName   string `xml:"UserName"`
+}
+
+func (user *iamUser) xml() iamUserXML {
+	return iamUserXML{
+		ARN:        user.arn,
+		CreateDate: iso8601(user.createDate),
+		Path:       user.path,
+		Tags:       user.tags.list(),
+		UserID:     user.userID,
+		UserName:   user.name,
+	}
+}
+
+// findUser returns the user with the specified name.
+// The service's mutex must be held.
+func (s *iamService) findUser(name string) (*iamUser, error) {
+	user, ok := s.users[name]
+	if !ok {
+		return nil, newIAMNoSuchEntityError("The user with name %s cannot be found.", name)
+	}
+
+	return user, nil
+}
+
+func (s *iamService) createUser(r *request, form url.Values) (any, error) {
+	name := form.Get("UserName")
+	if name == "" {
+		return nil, newError(http.StatusBadRequest, "ValidationError", "UserName is required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[name]; ok {
+		return nil, newError(http.StatusConflict, errCodeIAMEntityExists, "User with name %s already exists.", name)
+	}
+
+	path := iamPath(form.Get("Path"))
+	user := &iamUser{
+		arn:        iamARN("user" + path + name),
+		createDate: time.Now(),
+		name:       name,
+		path:       path,
+		tags:       make(tags),
+		userID:     strings.ToUpper(newID("AIDA", 17)),
+	}
+	user.tags.set(tagsFromQuery(form, "Tags"))
+	s.users[name] = user
+
+	return struct {
+		User iamUserXML `xml:"User"`
+	}{
+		User: user.xml(),
+	}, nil
+}
+
+func (s *iamService) getUser(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, err := s.findUser(form.Get("UserName"))
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		User iamUserXML `xml:"User"`
+	}{
+		User: user.xml(),
+	}, nil
+}
+
+func (s *iamService) deleteUser(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, err := s.findUser(form.Get("UserName"))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.users, user.name)
+
+	return nil, nil
+}
+
+func (s *iamService) listUserTags(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, err := s.findUser(form.Get("UserName"))
+	if err != nil {
+		return nil, err
+	}
+
+	return iamTagsXML(user.tags), nil
+}
+
+func (s *iamService) tagUser(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, err := s.findUser(form.Get("UserName"))
+	if err != nil {
+		return nil, err
+	}
+
+	user.tags.set(tagsFromQuery(form, "Tags"))
+
+	return nil, nil
+}
+
+func (s *iamService) untagUser(r *request, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, err := s.findUser(form.Get("UserName"))
+	if err != nil {
+		return nil, err
+	}
+
+	user.tags.remove(queryList(form, "TagKeys"))
+
+	return nil, nil
+}
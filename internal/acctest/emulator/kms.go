@@ -0,0 +1,626 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	errCodeKMSNotFound     = "NotFoundException"
+	errCodeKMSInvalidState = "KMSInvalidStateException"
+)
+
+// kmsService emulates AWS Key Management Service (KMS).
+type kmsService struct {
+	aliases    *store[string]
+	keys       *store[*kmsKey]
+	operations map[string]jsonOperation
+}
+
+type kmsKey struct {
+	arn                   string
+	creationDate          time.Time
+	customerMasterKeySpec string
+	deletionDate          time.Time
+	description           string
+	keyID                 string
+	keyState              string
+	keyUsage              string
+	multiRegion           bool
+	policy                string
+	rotationEnabled       bool
+	rotationPeriodInDays  int
+	tags                  tags
+}
+
+func newKMS() *kmsService {
+	s := &kmsService{
+		aliases: newStore[string](),
+		keys:    newStore[*kmsKey](),
+	}
+	s.operations = map[string]jsonOperation{
+		"CancelKeyDeletion":    jsonOp(s.cancelKeyDeletion),
+		"CreateAlias":          jsonOp(s.createAlias),
+		"CreateKey":            jsonOp(s.createKey),
+		"Decrypt":              jsonOp(s.decrypt),
+		"DeleteAlias":          jsonOp(s.deleteAlias),
+		"DescribeKey":          jsonOp(s.describeKey),
+		"DisableKey":           jsonOp(s.disableKey),
+		"DisableKeyRotation":   jsonOp(s.disableKeyRotation),
+		"EnableKey":            jsonOp(s.enableKey),
+		"EnableKeyRotation":    jsonOp(s.enableKeyRotation),
+		"Encrypt":              jsonOp(s.encrypt),
+		"GetKeyPolicy":         jsonOp(s.getKeyPolicy),
+		"GetKeyRotationStatus": jsonOp(s.getKeyRotationStatus),
+		"ListAliases":          jsonOp(s.listAliases),
+		"ListKeys":             jsonOp(s.listKeys),
+		"ListResourceTags":     jsonOp(s.listResourceTags),
+		"PutKeyPolicy":         jsonOp(s.putKeyPolicy),
+		"ScheduleKeyDeletion":  jsonOp(s.scheduleKeyDeletion),
+		"TagResource":          jsonOp(s.tagResource),
+		"UntagResource":        jsonOp(s.untagResource),
+		"UpdateAlias":          jsonOp(s.updateAlias),
+		"UpdateKeyDescription": jsonOp(s.updateKeyDescription),
+	}
+
+	return s
+}
+
+func (s *kmsService) serve(w http.ResponseWriter, r *request) {
+	serveJSON(w, r, "TrentService", s.operations)
+}
+
+func newKMSNotFoundError(format string, a ...any) *apiError {
+	return newError(http.StatusBadRequest, errCodeKMSNotFound, format, a...)
+}
+
+// defaultKeyPolicy returns the default key policy, which allows the account full access to the key.
+func defaultKeyPolicy() string {
+	return fmt.Sprintf(`{"Version":"2012-10-17","Id":"key-default-1","Statement":[{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"arn:%s:iam::%s:root"},"Action":"kms:*","Resource":"*"}]}`, Partition, AccountID)
+}
+
+func (k *kmsKey) metadata() map[string]any {
+	m := map[string]any{
+		"AWSAccountId":          AccountID,
+		"Arn":                   k.arn,
+		"CreationDate":          epochSeconds(k.creationDate),
+		"CustomerMasterKeySpec": k.customerMasterKeySpec,
+		"Description":           k.description,
+		"Enabled":               k.keyState == "Enabled",
+		"KeyId":                 k.keyID,
+		"KeyManager":            "CUSTOMER",
+		"KeySpec":               k.customerMasterKeySpec,
+		"KeyState":              k.keyState,
+		"KeyUsage":              k.keyUsage,
+		"MultiRegion":           k.multiRegion,
+		"Origin":                "AWS_KMS",
+	}
+	if k.customerMasterKeySpec == "SYMMETRIC_DEFAULT" {
+		m["EncryptionAlgorithms"] = []string{"SYMMETRIC_DEFAULT"}
+	}
+	if !k.deletionDate.IsZero() {
+		m["DeletionDate"] = epochSeconds(k.deletionDate)
+	}
+
+	return m
+}
+
+// findKey returns the key with the specified key ID, key ARN, alias name or alias ARN.
+// The store's mutex must be held.
+func (s *kmsService) findKey(r *request, id string) (*kmsKey, error) {
+	if _, after, ok := strings.Cut(id, ":alias/"); ok {
+		id = "alias/" + after
+	}
+	if strings.HasPrefix(id, "alias/") {
+		s.aliases.mu.Lock()
+		keyID, ok := s.aliases.region(r.region)[id]
+		s.aliases.mu.Unlock()
+		if !ok {
+			return nil, newKMSNotFoundError("Alias %s is not found.", r.arn(id))
+		}
+		id = keyID
+	}
+	if _, after, ok := strings.Cut(id, ":key/"); ok {
+		id = after
+	}
+
+	key, ok := s.keys.region(r.region)[id]
+	if !ok {
+		return nil, newKMSNotFoundError("Key '%s' does not exist", r.arn("key/"+id))
+	}
+
+	return key, nil
+}
+
+type kmsCreateKeyInput struct {
+	CustomerMasterKeySpec string `json:"CustomerMasterKeySpec"`
+	Description           string `json:"Description"`
+	KeySpec               string `json:"KeySpec"`
+	KeyUsage              string `json:"KeyUsage"`
+	MultiRegion           bool   `json:"MultiRegion"`
+	Policy                string `json:"Policy"`
+	Tags                  []struct {
+		TagKey   string `json:"TagKey"`
+		TagValue string `json:"TagValue"`
+	} `json:"Tags"`
+}
+
+func (s *kmsService) createKey(r *request, input *kmsCreateKeyInput) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	keyID := newUUID()
+	if input.MultiRegion {
+		keyID = "mrk-" + newID("", 32)
+	}
+
+	key := &kmsKey{
+		arn:                   r.arn("key/" + keyID),
+		creationDate:          time.Now(),
+		customerMasterKeySpec: "SYMMETRIC_DEFAULT",
+		description:           input.Description,
+		keyID:                 keyID,
+		keyState:              "Enabled",
+		keyUsage:              "ENCRYPT_DECRYPT",
+		multiRegion:           input.MultiRegion,
+		policy:                input.Policy,
+		rotationPeriodInDays:  365,
+		tags:                  make(tags),
+	}
+	if v := input.KeySpec; v != "" {
+		key.customerMasterKeySpec = v
+	} else if v := input.CustomerMasterKeySpec; v != "" {
+		key.customerMasterKeySpec = v
+	}
+	if v := input.KeyUsage; v != "" {
+		key.keyUsage = v
+	}
+	if key.policy == "" {
+		key.policy = defaultKeyPolicy()
+	}
+	for _, v := range input.Tags {
+		key.tags[v.TagKey] = v.TagValue
+	}
+	s.keys.region(r.region)[keyID] = key
+
+	return map[string]any{
+		"KeyMetadata": key.metadata(),
+	}, nil
+}
+
+type kmsKeyIDInput struct {
+	KeyID string `json:"KeyId"`
+}
+
+func (s *kmsService) describeKey(r *request, input *kmsKeyIDInput) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"KeyMetadata": key.metadata(),
+	}, nil
+}
+
+func (s *kmsService) listKeys(r *request, input *struct{}) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	var keys []map[string]string
+	for _, key := range s.keys.region(r.region) {
+		keys = append(keys, map[string]string{
+			"KeyArn": key.arn,
+			"KeyId":  key.keyID,
+		})
+	}
+	slices.SortFunc(keys, func(a, b map[string]string) int {
+		return strings.Compare(a["KeyId"], b["KeyId"])
+	})
+
+	return map[string]any{
+		"Keys":      keys,
+		"Truncated": false,
+	}, nil
+}
+
+func (s *kmsService) getKeyPolicy(r *request, input *kmsKeyIDInput) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"Policy":     key.policy,
+		"PolicyName": "default",
+	}, nil
+}
+
+type kmsPutKeyPolicyInput struct {
+	KeyID  string `json:"KeyId"`
+	Policy string `json:"Policy"`
+}
+
+func (s *kmsService) putKeyPolicy(r *request, input *kmsPutKeyPolicyInput) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	key.policy = input.Policy
+
+	return nil, nil
+}
+
+func (s *kmsService) getKeyRotationStatus(r *request, input *kmsKeyIDInput) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"KeyId":                key.keyID,
+		"KeyRotationEnabled":   key.rotationEnabled,
+		"RotationPeriodInDays": key.rotationPeriodInDays,
+	}, nil
+}
+
+type kmsEnableKeyRotationInput struct {
+	KeyID                string `json:"KeyId"`
+	RotationPeriodInDays int    `json:"RotationPeriodInDays"`
+}
+
+func (s *kmsService) enableKeyRotation(r *request, input *kmsEnableKeyRotationInput) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	key.rotationEnabled = true
+	if input.RotationPeriodInDays > 0 {
+		key.rotationPeriodInDays = input.RotationPeriodInDays
+	}
+
+	return nil, nil
+}
+
+func (s *kmsService) disableKeyRotation(r *request, input *kmsKeyIDInput) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	key.rotationEnabled = false
+
+	return nil, nil
+}
+
+func (s *kmsService) enableKey(r *request, input *kmsKeyIDInput) (any, error) {
+	return nil, s.setKeyState(r, input.KeyID, "Enabled")
+}
+
+func (s *kmsService) disableKey(r *request, input *kmsKeyIDInput) (any, error) {
+	return nil, s.setKeyState(r, input.KeyID, "Disabled")
+}
+
+func (s *kmsService) setKeyState(r *request, keyID, state string) error {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, keyID)
+	if err != nil {
+		return err
+	}
+
+	if key.keyState == "PendingDeletion" {
+		return newError(http.StatusBadRequest, errCodeKMSInvalidState, "%s is pending deletion.", key.arn)
+	}
+
+	key.keyState = state
+
+	return nil
+}
+
+type kmsUpdateKeyDescriptionInput struct {
+	Description string `json:"Description"`
+	KeyID       string `json:"KeyId"`
+}
+
+func (s *kmsService) updateKeyDescription(r *request, input *kmsUpdateKeyDescriptionInput) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	key.description = input.Description
+
+	return nil, nil
+}
+
+type kmsScheduleKeyDeletionInput struct {
+	KeyID               string `json:"KeyId"`
+	PendingWindowInDays int    `json:"PendingWindowInDays"`
+}
+
+func (s *kmsService) scheduleKeyDeletion(r *request, input *kmsScheduleKeyDeletionInput) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	days := input.PendingWindowInDays
+	if days == 0 {
+		days = 30
+	}
+
+	key.keyState = "PendingDeletion"
+	key.deletionDate = time.Now().AddDate(0, 0, days)
+
+	return map[string]any{
+		"DeletionDate":        epochSeconds(key.deletionDate),
+		"KeyId":               key.arn,
+		"KeyState":            key.keyState,
+		"PendingWindowInDays": days,
+	}, nil
+}
+
+func (s *kmsService) cancelKeyDeletion(r *request, input *kmsKeyIDInput) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	key.keyState = "Disabled"
+	key.deletionDate = time.Time{}
+
+	return map[string]any{
+		"KeyId": key.arn,
+	}, nil
+}
+
+type kmsTagsInput struct {
+	KeyID string `json:"KeyId"`
+	Tags  []struct {
+		TagKey   string `json:"TagKey"`
+		TagValue string `json:"TagValue"`
+	} `json:"Tags"`
+	TagKeys []string `json:"TagKeys"`
+}
+
+func (s *kmsService) listResourceTags(r *request, input *kmsTagsInput) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]map[string]string, 0, len(key.tags))
+	for _, v := range key.tags.list() {
+		list = append(list, map[string]string{
+			"TagKey":   v.Key,
+			"TagValue": v.Value,
+		})
+	}
+
+	return map[string]any{
+		"Tags":      list,
+		"Truncated": false,
+	}, nil
+}
+
+func (s *kmsService) tagResource(r *request, input *kmsTagsInput) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range input.Tags {
+		key.tags[v.TagKey] = v.TagValue
+	}
+
+	return nil, nil
+}
+
+func (s *kmsService) untagResource(r *request, input *kmsTagsInput) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	key.tags.remove(input.TagKeys)
+
+	return nil, nil
+}
+
+//
+// Aliases.
+//
+
+type kmsAliasInput struct {
+	AliasName   string `json:"AliasName"`
+	TargetKeyID string `json:"TargetKeyId"`
+}
+
+func (s *kmsService) createAlias(r *request, input *kmsAliasInput) (any, error) {
+	if !strings.HasPrefix(input.AliasName, "alias/") {
+		return nil, newValidationError("Alias must start with the prefix \"alias/\"")
+	}
+
+	s.keys.mu.Lock()
+	key, err := s.findKey(r, input.TargetKeyID)
+	s.keys.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	s.aliases.mu.Lock()
+	defer s.aliases.mu.Unlock()
+
+	aliases := s.aliases.region(r.region)
+	if _, ok := aliases[input.AliasName]; ok {
+		return nil, newError(http.StatusBadRequest, "AlreadyExistsException", "An alias with the name %s already exists", r.arn(input.AliasName))
+	}
+
+	aliases[input.AliasName] = key.keyID
+
+	return nil, nil
+}
+
+func (s *kmsService) updateAlias(r *request, input *kmsAliasInput) (any, error) {
+	s.keys.mu.Lock()
+	key, err := s.findKey(r, input.TargetKeyID)
+	s.keys.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	s.aliases.mu.Lock()
+	defer s.aliases.mu.Unlock()
+
+	aliases := s.aliases.region(r.region)
+	if _, ok := aliases[input.AliasName]; !ok {
+		return nil, newKMSNotFoundError("Alias %s is not found.", r.arn(input.AliasName))
+	}
+
+	aliases[input.AliasName] = key.keyID
+
+	return nil, nil
+}
+
+func (s *kmsService) deleteAlias(r *request, input *kmsAliasInput) (any, error) {
+	s.aliases.mu.Lock()
+	defer s.aliases.mu.Unlock()
+
+	aliases := s.aliases.region(r.region)
+	if _, ok := aliases[input.AliasName]; !ok {
+		return nil, newKMSNotFoundError("Alias %s is not found.", r.arn(input.AliasName))
+	}
+
+	delete(aliases, input.AliasName)
+
+	return nil, nil
+}
+
+func (s *kmsService) listAliases(r *request, input *kmsKeyIDInput) (any, error) {
+	s.aliases.mu.Lock()
+	defer s.aliases.mu.Unlock()
+
+	var aliases []map[string]any
+	for name, keyID := range s.aliases.region(r.region) {
+		if input.KeyID != "" && input.KeyID != keyID && !strings.HasSuffix(input.KeyID, "/"+keyID) {
+			continue
+		}
+
+		aliases = append(aliases, map[string]any{
+			"AliasArn":    r.arn(name),
+			"AliasName":   name,
+			"TargetKeyId": keyID,
+		})
+	}
+	slices.SortFunc(aliases, func(a, b map[string]any) int {
+		return strings.Compare(a["AliasName"].(string), b["AliasName"].(string))
+	})
+
+	return map[string]any{
+		"Aliases":   aliases,
+		"Truncated": false,
+	}, nil
+}
+
+//
+// Cryptographic operations.
+//
+// Ciphertexts are not encrypted: a ciphertext blob is the key ARN and the plaintext, separated by a NUL byte.
+//
+
+type kmsEncryptInput struct {
+	KeyID     string `json:"KeyId"`
+	Plaintext []byte `json:"Plaintext"`
+}
+
+func (s *kmsService) encrypt(r *request, input *kmsEncryptInput) (any, error) {
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.keyState != "Enabled" {
+		return nil, newError(http.StatusBadRequest, "DisabledException", "%s is disabled.", key.arn)
+	}
+
+	return map[string]any{
+		"CiphertextBlob":      append([]byte(key.arn+"\x00"), input.Plaintext...),
+		"EncryptionAlgorithm": "SYMMETRIC_DEFAULT",
+		"KeyId":               key.arn,
+	}, nil
+}
+
+type kmsDecryptInput struct {
+	CiphertextBlob []byte `json:"CiphertextBlob"`
+}
+
+func (s *kmsService) decrypt(r *request, input *kmsDecryptInput) (any, error) {
+	arn, plaintext, ok := strings.Cut(string(input.CiphertextBlob), "\x00")
+	if !ok {
+		return nil, newError(http.StatusBadRequest, "InvalidCiphertextException", "invalid ciphertext: %s", base64.StdEncoding.EncodeToString(input.CiphertextBlob))
+	}
+
+	s.keys.mu.Lock()
+	defer s.keys.mu.Unlock()
+
+	key, err := s.findKey(r, arn)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"EncryptionAlgorithm": "SYMMETRIC_DEFAULT",
+		"KeyId":               key.arn,
+		"Plaintext":           []byte(plaintext),
+	}, nil
+}
@@ -0,0 +1,382 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	errCodeNotImplemented = "NotImplemented"
+)
+
+// apiError is an AWS API error returned by an emulated operation.
+type apiError struct {
+	code       string
+	message    string
+	statusCode int
+	// queryCode is the error code returned to clients of AWS JSON protocol services that were previously AWS Query protocol services.
+	queryCode string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+func newError(statusCode int, code, format string, a ...any) *apiError {
+	return &apiError{
+		code:       code,
+		message:    fmt.Sprintf(format, a...),
+		statusCode: statusCode,
+	}
+}
+
+func newNotImplementedError(service, operation string) *apiError {
+	return newError(http.StatusNotImplemented, errCodeNotImplemented, "Operation %s of service %s is not emulated", operation, service)
+}
+
+func newValidationError(format string, a ...any) *apiError {
+	return newError(http.StatusBadRequest, "ValidationException", format, a...)
+}
+
+// asAPIError returns the specified error as an AWS API error.
+func asAPIError(err error) *apiError {
+	if v, ok := err.(*apiError); ok { //nolint:errorlint // Errors are not wrapped.
+		return v
+	}
+
+	return newError(http.StatusInternalServerError, "InternalFailure", "%s", err)
+}
+
+// newRequestID returns a new random request ID.
+func newRequestID() string {
+	return newUUID()
+}
+
+// newUUID returns a new random (version 4) UUID.
+func newUUID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// newID returns a new random identifier with the specified prefix and number of hexadecimal digits.
+func newID(prefix string, n int) string {
+	b := make([]byte, (n+1)/2)
+	_, _ = rand.Read(b)
+
+	return prefix + hex.EncodeToString(b)[:n]
+}
+
+// epochSeconds returns the specified time as fractional seconds since the Unix epoch, as used by the AWS JSON protocols.
+func epochSeconds(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1000
+}
+
+// iso8601 returns the specified time in the format used by the AWS Query and REST-XML protocols.
+func iso8601(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+//
+// AWS JSON 1.0 and 1.1 protocols.
+//
+
+// jsonOperation is an emulated AWS JSON protocol operation.
+type jsonOperation func(r *request) (any, error)
+
+// jsonOp returns a jsonOperation that decodes the request body into the specified input type.
+func jsonOp[In any](f func(*request, *In) (any, error)) jsonOperation {
+	return func(r *request) (any, error) {
+		var input In
+
+		if len(r.body) > 0 {
+			if err := json.Unmarshal(r.body, &input); err != nil {
+				return nil, newError(http.StatusBadRequest, "SerializationException", "%s", err)
+			}
+		}
+
+		return f(r, &input)
+	}
+}
+
+// serveJSON serves an AWS JSON protocol request.
+// The operation is named by the X-Amz-Target header.
+func serveJSON(w http.ResponseWriter, r *request, targetPrefix string, operations map[string]jsonOperation) {
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "application/x-amz-json-1.1"
+	}
+
+	target := r.Header.Get("X-Amz-Target")
+	name, ok := strings.CutPrefix(target, targetPrefix+".")
+	if !ok {
+		writeJSONError(w, contentType, newError(http.StatusBadRequest, "UnknownOperationException", "Unknown operation %s", target))
+		return
+	}
+
+	operation, ok := operations[name]
+	if !ok {
+		writeJSONError(w, contentType, newNotImplementedError(r.service, name))
+		return
+	}
+
+	output, err := operation(r)
+	if err != nil {
+		writeJSONError(w, contentType, asAPIError(err))
+		return
+	}
+
+	if output == nil {
+		output = struct{}{}
+	}
+
+	body, err := json.Marshal(output)
+	if err != nil {
+		writeJSONError(w, contentType, asAPIError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Amzn-Requestid", newRequestID())
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+func writeJSONError(w http.ResponseWriter, contentType string, err *apiError) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Amzn-Errortype", err.code)
+	w.Header().Set("X-Amzn-Requestid", newRequestID())
+	if err.queryCode != "" {
+		w.Header().Set("X-Amzn-Query-Error", err.queryCode+";Sender")
+	}
+	w.WriteHeader(err.statusCode)
+
+	body, _ := json.Marshal(map[string]string{
+		"__type":  err.code,
+		"message": err.message,
+	})
+	_, _ = w.Write(body)
+}
+
+//
+// AWS Query protocol.
+//
+
+// queryOperation is an emulated AWS Query protocol operation.
+// The returned value is marshaled as the contents of the operation's result element.
+type queryOperation func(r *request, form url.Values) (any, error)
+
+// serveQuery serves an AWS Query protocol request.
+// The operation is named by the Action parameter.
+func serveQuery(w http.ResponseWriter, r *request, xmlns string, operations map[string]queryOperation) {
+	form, err := parseQuery(r.body)
+	if err != nil {
+		writeXMLError(w, newError(http.StatusBadRequest, "MalformedQueryString", "%s", err))
+		return
+	}
+	for k, v := range r.URL.Query() {
+		form[k] = v
+	}
+
+	action := form.Get("Action")
+	operation, ok := operations[action]
+	if !ok {
+		writeXMLError(w, newNotImplementedError(r.service, action))
+		return
+	}
+
+	output, err := operation(r, form)
+	if err != nil {
+		writeXMLError(w, asAPIError(err))
+		return
+	}
+
+	requestID := newRequestID()
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<%sResponse xmlns="%s">`, action, xmlns)
+	if output != nil {
+		encoder := xml.NewEncoder(&b)
+		if err := encoder.EncodeElement(output, xml.StartElement{Name: xml.Name{Local: action + "Result"}}); err != nil {
+			writeXMLError(w, asAPIError(err))
+			return
+		}
+		if err := encoder.Flush(); err != nil {
+			writeXMLError(w, asAPIError(err))
+			return
+		}
+	}
+	fmt.Fprintf(&b, `<ResponseMetadata><RequestId>%s</RequestId></ResponseMetadata></%sResponse>`, requestID, action)
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.Header().Set("X-Amzn-Requestid", requestID)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b.Bytes())
+}
+
+// writeXMLError writes an AWS Query protocol error response.
+func writeXMLError(w http.ResponseWriter, err *apiError) {
+	requestID := newRequestID()
+
+	errorType := "Sender"
+	if err.statusCode >= http.StatusInternalServerError {
+		errorType = "Receiver"
+	}
+
+	body, _ := xml.Marshal(struct {
+		XMLName   xml.Name `xml:"ErrorResponse"`
+		Type      string   `xml:"Error>Type"`
+		Code      string   `xml:"Error>Code"`
+		Message   string   `xml:"Error>Message"`
+		RequestID string   `xml:"RequestId"`
+	}{
+		Type:      errorType,
+		Code:      err.code,
+		Message:   err.message,
+		RequestID: requestID,
+	})
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.Header().Set("X-Amzn-Requestid", requestID)
+	w.WriteHeader(err.statusCode)
+	_, _ = w.Write(body)
+}
+
+// queryMembers returns the structures in the specified AWS Query protocol list parameter,
+// e.g. `Tags.member.1.Key=k&Tags.member.1.Value=v`.
+func queryMembers(form url.Values, prefix string) []map[string]string {
+	return queryIndexed(form, prefix+".member.")
+}
+
+// queryList returns the values in the specified AWS Query protocol list parameter, e.g. `TagKeys.member.1=k`.
+func queryList(form url.Values, prefix string) []string {
+	var values []string
+
+	for _, m := range queryIndexed(form, prefix+".member.") {
+		if v, ok := m[""]; ok {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+// queryEntries returns the entries in the specified AWS Query protocol map parameter,
+// e.g. `Attributes.entry.1.key=k&Attributes.entry.1.value=v`.
+func queryEntries(form url.Values, prefix string) map[string]string {
+	entries := make(map[string]string)
+
+	for _, m := range queryIndexed(form, prefix+".entry.") {
+		entries[m["key"]] = m["value"]
+	}
+
+	return entries
+}
+
+// queryIndexed returns the values of indexed AWS Query protocol parameters with the specified prefix, in index order.
+// Each result maps a parameter's field name, or "" for a scalar, to its value.
+func queryIndexed(form url.Values, prefix string) []map[string]string {
+	members := make(map[int]map[string]string)
+
+	for k, v := range form {
+		rest, ok := strings.CutPrefix(k, prefix)
+		if !ok || len(v) == 0 {
+			continue
+		}
+
+		index, field, _ := strings.Cut(rest, ".")
+		i, err := strconv.Atoi(index)
+		if err != nil {
+			continue
+		}
+
+		if _, ok := members[i]; !ok {
+			members[i] = make(map[string]string)
+		}
+		members[i][field] = v[0]
+	}
+
+	indexes := make([]int, 0, len(members))
+	for i := range members {
+		indexes = append(indexes, i)
+	}
+	slices.Sort(indexes)
+
+	result := make([]map[string]string, 0, len(indexes))
+	for _, i := range indexes {
+		result = append(result, members[i])
+	}
+
+	return result
+}
+
+//
+// Tags.
+//
+
+// tag is a resource tag.
+type tag struct {
+	Key   string `json:"Key" xml:"Key"`
+	Value string `json:"Value" xml:"Value"`
+}
+
+// tags is a resource's tags.
+type tags map[string]string
+
+// list returns the tags ordered by key.
+func (t tags) list() []tag {
+	keys := make([]string, 0, len(t))
+	for k := range t {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	list := make([]tag, 0, len(keys))
+	for _, k := range keys {
+		list = append(list, tag{Key: k, Value: t[k]})
+	}
+
+	return list
+}
+
+// set adds or updates the specified tags.
+func (t tags) set(list []tag) {
+	for _, v := range list {
+		t[v.Key] = v.Value
+	}
+}
+
+// remove removes the tags with the specified keys.
+func (t tags) remove(keys []string) {
+	for _, k := range keys {
+		delete(t, k)
+	}
+}
+
+// tagsFromQuery returns the tags in the specified AWS Query protocol list parameter.
+func tagsFromQuery(form url.Values, prefix string) []tag {
+	var list []tag
+
+	for _, m := range queryMembers(form, prefix) {
+		list = append(list, tag{Key: m["Key"], Value: m["Value"]})
+	}
+
+	return list
+}
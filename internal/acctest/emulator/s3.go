@@ -0,0 +1,764 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5" //nolint:gosec // S3 ETags are MD5 digests.
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	errCodeS3BucketAlreadyOwnedByYou = "BucketAlreadyOwnedByYou"
+	errCodeS3BucketNotEmpty          = "BucketNotEmpty"
+	errCodeS3NoSuchBucket            = "NoSuchBucket"
+	errCodeS3NoSuchKey               = "NoSuchKey"
+	s3XMLNS                          = "http://s3.amazonaws.com/doc/2006-03-01/"
+)
+
+// s3BucketSubresource describes how an emulated bucket subresource is read when it has not been configured.
+// Configured subresources are stored and returned verbatim.
+type s3BucketSubresource struct {
+	// defaultDocument is returned if the subresource has not been configured.
+	defaultDocument func(*s3Bucket) string
+	// notFoundCode is the error code returned if the subresource has not been configured and there is no default document.
+	notFoundCode string
+}
+
+// s3BucketSubresources are the emulated bucket subresources, keyed by query parameter name.
+var s3BucketSubresources = map[string]s3BucketSubresource{
+	"accelerate": {defaultDocument: func(*s3Bucket) string {
+		return `<AccelerateConfiguration xmlns="` + s3XMLNS + `"/>`
+	}},
+	"acl": {defaultDocument: func(*s3Bucket) string {
+		return `<AccessControlPolicy xmlns="` + s3XMLNS + `">` + s3Owner() +
+			`<AccessControlList><Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>` + s3CanonicalUserID + `</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant></AccessControlList></AccessControlPolicy>`
+	}},
+	"cors": {notFoundCode: "NoSuchCORSConfiguration"},
+	"encryption": {defaultDocument: func(*s3Bucket) string {
+		return `<ServerSideEncryptionConfiguration xmlns="` + s3XMLNS + `"><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>AES256</SSEAlgorithm></ApplyServerSideEncryptionByDefault><BucketKeyEnabled>false</BucketKeyEnabled></Rule></ServerSideEncryptionConfiguration>`
+	}},
+	"lifecycle": {notFoundCode: "NoSuchLifecycleConfiguration"},
+	"logging": {defaultDocument: func(*s3Bucket) string {
+		return `<BucketLoggingStatus xmlns="` + s3XMLNS + `"/>`
+	}},
+	"notification": {defaultDocument: func(*s3Bucket) string {
+		return `<NotificationConfiguration xmlns="` + s3XMLNS + `"/>`
+	}},
+	"object-lock": {
+		defaultDocument: func(b *s3Bucket) string {
+			if !b.objectLockEnabled {
+				return ""
+			}
+			return `<ObjectLockConfiguration xmlns="` + s3XMLNS + `"><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`
+		},
+		notFoundCode: "ObjectLockConfigurationNotFoundError",
+	},
+	"ownershipControls": {notFoundCode: "OwnershipControlsNotFoundError"},
+	"policy":            {notFoundCode: "NoSuchBucketPolicy"},
+	"publicAccessBlock": {notFoundCode: "NoSuchPublicAccessBlockConfiguration"},
+	"replication":       {notFoundCode: "ReplicationConfigurationNotFoundError"},
+	"requestPayment": {defaultDocument: func(*s3Bucket) string {
+		return `<RequestPaymentConfiguration xmlns="` + s3XMLNS + `"><Payer>BucketOwner</Payer></RequestPaymentConfiguration>`
+	}},
+	"tagging": {notFoundCode: "NoSuchTagSet"},
+	"versioning": {defaultDocument: func(*s3Bucket) string {
+		return `<VersioningConfiguration xmlns="` + s3XMLNS + `"/>`
+	}},
+	"website": {notFoundCode: "NoSuchWebsiteConfiguration"},
+}
+
+const s3CanonicalUserID = "75aa57f09aa0c8caeab4f8c24e99d10f8e7faeebf76c078efc7c6caea54ba06a"
+
+func s3Owner() string {
+	return `<Owner><ID>` + s3CanonicalUserID + `</ID><DisplayName>emulator</DisplayName></Owner>`
+}
+
+// s3Service emulates Amazon Simple Storage Service (S3).
+// Buckets are addressed path-style and share a single global namespace.
+type s3Service struct {
+	buckets map[string]*s3Bucket
+	mu      sync.Mutex
+}
+
+type s3Bucket struct {
+	creationDate      time.Time
+	name              string
+	objectLockEnabled bool
+	objects           map[string]*s3Object
+	region            string
+	// subresources are the configured bucket subresources, keyed by query parameter name.
+	subresources map[string][]byte
+}
+
+type s3Object struct {
+	body         []byte
+	contentType  string
+	etag         string
+	lastModified time.Time
+	metadata     map[string]string
+	tags         tags
+}
+
+func newS3() *s3Service {
+	return &s3Service{
+		buckets: make(map[string]*s3Bucket),
+	}
+}
+
+func (s *s3Service) serve(w http.ResponseWriter, r *request) {
+	bucketName, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+
+	var (
+		output any
+		err    error
+	)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case bucketName == "":
+		if r.Method != http.MethodGet {
+			err = newNotImplementedError(r.service, r.Method+" /")
+			break
+		}
+		output = s.listBuckets(r)
+	case key == "":
+		output, err = s.serveBucket(w, r, bucketName, query)
+	default:
+		output, err = s.serveObject(w, r, bucketName, key, query)
+	}
+
+	if err != nil {
+		writeS3Error(w, r, asAPIError(err))
+		return
+	}
+
+	w.Header().Set("X-Amz-Request-Id", newRequestID())
+	switch v := output.(type) {
+	case nil:
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+	case []byte:
+		w.WriteHeader(http.StatusOK)
+		if r.Method != http.MethodHead {
+			_, _ = w.Write(v)
+		}
+	default:
+		body, err := xml.Marshal(v)
+		if err != nil {
+			writeS3Error(w, r, asAPIError(err))
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(xml.Header))
+		_, _ = w.Write(body)
+	}
+}
+
+// writeS3Error writes an S3 REST-XML protocol error response.
+func writeS3Error(w http.ResponseWriter, r *request, err *apiError) {
+	requestID := newRequestID()
+
+	w.Header().Set("X-Amz-Request-Id", requestID)
+	if r.Method == http.MethodHead {
+		w.WriteHeader(err.statusCode)
+		return
+	}
+
+	body, _ := xml.Marshal(struct {
+		XMLName   xml.Name `xml:"Error"`
+		Code      string   `xml:"Code"`
+		Message   string   `xml:"Message"`
+		RequestID string   `xml:"RequestId"`
+	}{
+		Code:      err.code,
+		Message:   err.message,
+		RequestID: requestID,
+	})
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(err.statusCode)
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(body)
+}
+
+// findBucket returns the bucket with the specified name.
+// The service's mutex must be held.
+func (s *s3Service) findBucket(name string) (*s3Bucket, error) {
+	bucket, ok := s.buckets[name]
+	if !ok {
+		return nil, newError(http.StatusNotFound, errCodeS3NoSuchBucket, "The specified bucket does not exist")
+	}
+
+	return bucket, nil
+}
+
+// subresource returns the name of the subresource addressed by the request's query string, if any.
+func subresource(query url.Values) string {
+	for k := range query {
+		switch k {
+		case "list-type", "prefix", "delimiter", "max-keys", "continuation-token", "start-after", "encoding-type", "fetch-owner", "marker", "key-marker", "version-id-marker", "versionId", "x-id":
+			continue
+		}
+		return k
+	}
+
+	return ""
+}
+
+//
+// Buckets.
+//
+
+func (s *s3Service) listBuckets(r *request) any {
+	type bucketXML struct {
+		BucketRegion string `xml:"BucketRegion"`
+		CreationDate string `xml:"CreationDate"`
+		Name         string `xml:"Name"`
+	}
+
+	names := make([]string, 0, len(s.buckets))
+	for name := range s.buckets {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	buckets := make([]bucketXML, 0, len(names))
+	for _, name := range names {
+		bucket := s.buckets[name]
+		buckets = append(buckets, bucketXML{
+			BucketRegion: bucket.region,
+			CreationDate: iso8601(bucket.creationDate),
+			Name:         name,
+		})
+	}
+
+	return struct {
+		XMLName xml.Name    `xml:"ListAllMyBucketsResult"`
+		XMLNS   string      `xml:"xmlns,attr"`
+		OwnerID string      `xml:"Owner>ID"`
+		Buckets []bucketXML `xml:"Buckets>Bucket"`
+	}{
+		XMLNS:   s3XMLNS,
+		OwnerID: s3CanonicalUserID,
+		Buckets: buckets,
+	}
+}
+
+func (s *s3Service) serveBucket(w http.ResponseWriter, r *request, name string, query url.Values) (any, error) {
+	if r.Method == http.MethodPut && len(query) == 0 {
+		return s.createBucket(w, r, name)
+	}
+
+	bucket, err := s.findBucket(name)
+	if err != nil {
+		return nil, err
+	}
+
+	switch sub := subresource(query); {
+	case sub == "" && r.Method == http.MethodHead:
+		w.Header().Set("X-Amz-Bucket-Region", bucket.region)
+		return nil, nil
+	case sub == "" && r.Method == http.MethodDelete:
+		if len(bucket.objects) > 0 {
+			return nil, newError(http.StatusConflict, errCodeS3BucketNotEmpty, "The bucket you tried to delete is not empty")
+		}
+		delete(s.buckets, name)
+		return nil, nil
+	case sub == "" && r.Method == http.MethodGet:
+		return bucket.listObjects(query), nil
+	case sub == "versions" && r.Method == http.MethodGet:
+		return bucket.listObjectVersions(query), nil
+	case sub == "delete" && r.Method == http.MethodPost:
+		return bucket.deleteObjects(r)
+	case sub == "location" && r.Method == http.MethodGet:
+		location := bucket.region
+		if location == "us-east-1" {
+			location = ""
+		}
+		return []byte(xml.Header + `<LocationConstraint xmlns="` + s3XMLNS + `">` + location + `</LocationConstraint>`), nil
+	default:
+		return bucket.serveSubresource(r, sub)
+	}
+}
+
+func (s *s3Service) createBucket(w http.ResponseWriter, r *request, name string) (any, error) {
+	if bucket, ok := s.buckets[name]; ok {
+		if bucket.region == r.region {
+			return nil, newError(http.StatusConflict, errCodeS3BucketAlreadyOwnedByYou, "Your previous request to create the named bucket succeeded and you already own it.")
+		}
+		return nil, newError(http.StatusConflict, "BucketAlreadyExists", "The requested bucket name is not available.")
+	}
+
+	region := r.region
+	if len(r.body) > 0 {
+		var configuration struct {
+			LocationConstraint string `xml:"LocationConstraint"`
+		}
+		if err := xml.Unmarshal(r.body, &configuration); err != nil {
+			return nil, newError(http.StatusBadRequest, "MalformedXML", "%s", err)
+		}
+		if v := configuration.LocationConstraint; v != "" {
+			region = v
+		}
+	}
+
+	s.buckets[name] = &s3Bucket{
+		creationDate:      time.Now(),
+		name:              name,
+		objectLockEnabled: strings.EqualFold(r.Header.Get("X-Amz-Bucket-Object-Lock-Enabled"), "true"),
+		objects:           make(map[string]*s3Object),
+		region:            region,
+		subresources:      make(map[string][]byte),
+	}
+	w.Header().Set("Location", "/"+name)
+
+	return nil, nil
+}
+
+func (b *s3Bucket) serveSubresource(r *request, name string) (any, error) {
+	subresource, ok := s3BucketSubresources[name]
+	if !ok {
+		return nil, newNotImplementedError(r.service, r.Method+" bucket ?"+name)
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if v, ok := b.subresources[name]; ok {
+			return v, nil
+		}
+		if subresource.defaultDocument != nil {
+			if v := subresource.defaultDocument(b); v != "" {
+				return []byte(xml.Header + v), nil
+			}
+		}
+		return nil, newError(http.StatusNotFound, subresource.notFoundCode, "The %s configuration does not exist", name)
+	case http.MethodPut:
+		body, err := decodeS3Body(r)
+		if err != nil {
+			return nil, err
+		}
+		b.subresources[name] = body
+		if name == "object-lock" {
+			b.objectLockEnabled = true
+		}
+		return nil, nil
+	case http.MethodDelete:
+		delete(b.subresources, name)
+		return nil, nil
+	default:
+		return nil, newNotImplementedError(r.service, r.Method+" bucket ?"+name)
+	}
+}
+
+//
+// Objects.
+//
+
+type s3ObjectXML struct {
+	ETag         string `xml:"ETag"`
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	Size         int    `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+// keys returns the bucket's object keys with the specified prefix, in order.
+func (b *s3Bucket) keys(prefix string) []string {
+	var keys []string
+	for k := range b.objects {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+func (b *s3Bucket) listObjects(query url.Values) any {
+	prefix, delimiter := query.Get("prefix"), query.Get("delimiter")
+
+	type commonPrefixXML struct {
+		Prefix string `xml:"Prefix"`
+	}
+	var (
+		contents       []s3ObjectXML
+		commonPrefixes []commonPrefixXML
+	)
+	for _, k := range b.keys(prefix) {
+		if delimiter != "" {
+			if i := strings.Index(k[len(prefix):], delimiter); i >= 0 {
+				commonPrefix := k[:len(prefix)+i+len(delimiter)]
+				if !slices.Contains(commonPrefixes, commonPrefixXML{Prefix: commonPrefix}) {
+					commonPrefixes = append(commonPrefixes, commonPrefixXML{Prefix: commonPrefix})
+				}
+				continue
+			}
+		}
+
+		object := b.objects[k]
+		contents = append(contents, s3ObjectXML{
+			ETag:         object.etag,
+			Key:          k,
+			LastModified: iso8601(object.lastModified),
+			Size:         len(object.body),
+			StorageClass: "STANDARD",
+		})
+	}
+
+	return struct {
+		XMLName        xml.Name          `xml:"ListBucketResult"`
+		XMLNS          string            `xml:"xmlns,attr"`
+		Name           string            `xml:"Name"`
+		Prefix         string            `xml:"Prefix"`
+		Delimiter      string            `xml:"Delimiter,omitempty"`
+		KeyCount       int               `xml:"KeyCount"`
+		MaxKeys        int               `xml:"MaxKeys"`
+		IsTruncated    bool              `xml:"IsTruncated"`
+		Contents       []s3ObjectXML     `xml:"Contents"`
+		CommonPrefixes []commonPrefixXML `xml:"CommonPrefixes"`
+	}{
+		XMLNS:          s3XMLNS,
+		Name:           b.name,
+		Prefix:         prefix,
+		Delimiter:      delimiter,
+		KeyCount:       len(contents) + len(commonPrefixes),
+		MaxKeys:        1000,
+		Contents:       contents,
+		CommonPrefixes: commonPrefixes,
+	}
+}
+
+// listObjectVersions lists the bucket's objects.
+// Object versioning is not emulated; each object has a single, latest version with the ID "null".
+func (b *s3Bucket) listObjectVersions(query url.Values) any {
+	type versionXML struct {
+		s3ObjectXML
+		IsLatest  bool   `xml:"IsLatest"`
+		VersionID string `xml:"VersionId"`
+	}
+
+	prefix := query.Get("prefix")
+	var versions []versionXML
+	for _, k := range b.keys(prefix) {
+		object := b.objects[k]
+		versions = append(versions, versionXML{
+			s3ObjectXML: s3ObjectXML{
+				ETag:         object.etag,
+				Key:          k,
+				LastModified: iso8601(object.lastModified),
+				Size:         len(object.body),
+				StorageClass: "STANDARD",
+			},
+			IsLatest:  true,
+			VersionID: "null",
+		})
+	}
+
+	return struct {
+		XMLName     xml.Name     `xml:"ListVersionsResult"`
+		XMLNS       string       `xml:"xmlns,attr"`
+		Name        string       `xml:"Name"`
+		Prefix      string       `xml:"Prefix"`
+		MaxKeys     int          `xml:"MaxKeys"`
+		IsTruncated bool         `xml:"IsTruncated"`
+		Versions    []versionXML `xml:"Version"`
+	}{
+		XMLNS:    s3XMLNS,
+		Name:     b.name,
+		Prefix:   prefix,
+		MaxKeys:  1000,
+		Versions: versions,
+	}
+}
+
+func (b *s3Bucket) deleteObjects(r *request) (any, error) {
+	body, err := decodeS3Body(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var input struct {
+		Objects []struct {
+			Key       string `xml:"Key"`
+			VersionID string `xml:"VersionId"`
+		} `xml:"Object"`
+		Quiet bool `xml:"Quiet"`
+	}
+	if err := xml.Unmarshal(body, &input); err != nil {
+		return nil, newError(http.StatusBadRequest, "MalformedXML", "%s", err)
+	}
+
+	type deletedXML struct {
+		Key       string `xml:"Key"`
+		VersionID string `xml:"VersionId,omitempty"`
+	}
+	var deleted []deletedXML
+	for _, v := range input.Objects {
+		delete(b.objects, v.Key)
+		if !input.Quiet {
+			deleted = append(deleted, deletedXML{Key: v.Key, VersionID: v.VersionID})
+		}
+	}
+
+	return struct {
+		XMLName xml.Name     `xml:"DeleteResult"`
+		XMLNS   string       `xml:"xmlns,attr"`
+		Deleted []deletedXML `xml:"Deleted"`
+	}{
+		XMLNS:   s3XMLNS,
+		Deleted: deleted,
+	}, nil
+}
+
+func (s *s3Service) serveObject(w http.ResponseWriter, r *request, bucketName, key string, query url.Values) (any, error) {
+	bucket, err := s.findBucket(bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	switch sub := subresource(query); {
+	case sub == "" && r.Method == http.MethodPut:
+		if source := r.Header.Get("X-Amz-Copy-Source"); source != "" {
+			return s.copyObject(r, bucket, key, source)
+		}
+		return bucket.putObject(w, r, key)
+	case sub == "" && (r.Method == http.MethodGet || r.Method == http.MethodHead):
+		object, err := bucket.findObject(key)
+		if err != nil {
+			return nil, err
+		}
+		object.writeHeaders(w)
+		return object.body, nil
+	case sub == "" && r.Method == http.MethodDelete:
+		// DeleteObject is idempotent.
+		delete(bucket.objects, key)
+		return nil, nil
+	case sub == "tagging":
+		object, err := bucket.findObject(key)
+		if err != nil {
+			return nil, err
+		}
+		return object.serveTagging(r)
+	default:
+		return nil, newNotImplementedError(r.service, r.Method+" object ?"+sub)
+	}
+}
+
+// findObject returns the object with the specified key.
+func (b *s3Bucket) findObject(key string) (*s3Object, error) {
+	object, ok := b.objects[key]
+	if !ok {
+		return nil, newError(http.StatusNotFound, errCodeS3NoSuchKey, "The specified key does not exist.")
+	}
+
+	return object, nil
+}
+
+func (b *s3Bucket) putObject(w http.ResponseWriter, r *request, key string) (any, error) {
+	body, err := decodeS3Body(r)
+	if err != nil {
+		return nil, err
+	}
+
+	object := &s3Object{
+		body:         body,
+		contentType:  r.Header.Get("Content-Type"),
+		etag:         s3ETag(body),
+		lastModified: time.Now(),
+		metadata:     make(map[string]string),
+		tags:         make(tags),
+	}
+	if object.contentType == "" {
+		object.contentType = "binary/octet-stream"
+	}
+	for k, v := range r.Header {
+		if name, ok := strings.CutPrefix(strings.ToLower(k), "x-amz-meta-"); ok && len(v) > 0 {
+			object.metadata[name] = v[0]
+		}
+	}
+	if v := r.Header.Get("X-Amz-Tagging"); v != "" {
+		tagging, err := url.ParseQuery(v)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "InvalidArgument", "invalid x-amz-tagging header: %s", err)
+		}
+		for k := range tagging {
+			object.tags[k] = tagging.Get(k)
+		}
+	}
+	b.objects[key] = object
+
+	w.Header().Set("ETag", object.etag)
+
+	return nil, nil
+}
+
+func (s *s3Service) copyObject(r *request, bucket *s3Bucket, key, source string) (any, error) {
+	source, err := url.PathUnescape(strings.TrimPrefix(source, "/"))
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, "InvalidArgument", "invalid x-amz-copy-source header: %s", err)
+	}
+	source, _, _ = strings.Cut(source, "?versionId=")
+
+	sourceBucketName, sourceKey, _ := strings.Cut(source, "/")
+	sourceBucket, err := s.findBucket(sourceBucketName)
+	if err != nil {
+		return nil, err
+	}
+	sourceObject, err := sourceBucket.findObject(sourceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	object := &s3Object{
+		body:         slices.Clone(sourceObject.body),
+		contentType:  sourceObject.contentType,
+		etag:         sourceObject.etag,
+		lastModified: time.Now(),
+		metadata:     make(map[string]string),
+		tags:         make(tags),
+	}
+	for k, v := range sourceObject.metadata {
+		object.metadata[k] = v
+	}
+	for k, v := range sourceObject.tags {
+		object.tags[k] = v
+	}
+	if strings.EqualFold(r.Header.Get("X-Amz-Metadata-Directive"), "REPLACE") {
+		object.contentType = r.Header.Get("Content-Type")
+		object.metadata = make(map[string]string)
+		for k, v := range r.Header {
+			if name, ok := strings.CutPrefix(strings.ToLower(k), "x-amz-meta-"); ok && len(v) > 0 {
+				object.metadata[name] = v[0]
+			}
+		}
+	}
+	if strings.EqualFold(r.Header.Get("X-Amz-Tagging-Directive"), "REPLACE") {
+		object.tags = make(tags)
+		if v := r.Header.Get("X-Amz-Tagging"); v != "" {
+			tagging, _ := url.ParseQuery(v)
+			for k := range tagging {
+				object.tags[k] = tagging.Get(k)
+			}
+		}
+	}
+	bucket.objects[key] = object
+
+	return struct {
+		XMLName      xml.Name `xml:"CopyObjectResult"`
+		XMLNS        string   `xml:"xmlns,attr"`
+		ETag         string   `xml:"ETag"`
+		LastModified string   `xml:"LastModified"`
+	}{
+		XMLNS:        s3XMLNS,
+		ETag:         object.etag,
+		LastModified: iso8601(object.lastModified),
+	}, nil
+}
+
+func (o *s3Object) writeHeaders(w http.ResponseWriter) {
+	h := w.Header()
+	h.Set("Content-Length", strconv.Itoa(len(o.body)))
+	h.Set("Content-Type", o.contentType)
+	h.Set("ETag", o.etag)
+	h.Set("Last-Modified", o.lastModified.UTC().Format(http.TimeFormat))
+	for k, v := range o.metadata {
+		h.Set("X-Amz-Meta-"+k, v)
+	}
+	if len(o.tags) > 0 {
+		h.Set("X-Amz-Tagging-Count", strconv.Itoa(len(o.tags)))
+	}
+}
+
+type s3TaggingXML struct {
+	XMLName xml.Name `xml:"Tagging"`
+	XMLNS   string   `xml:"xmlns,attr,omitempty"`
+	TagSet  []tag    `xml:"TagSet>Tag"`
+}
+
+func (o *s3Object) serveTagging(r *request) (any, error) {
+	switch r.Method {
+	case http.MethodGet:
+		return s3TaggingXML{
+			XMLNS:  s3XMLNS,
+			TagSet: o.tags.list(),
+		}, nil
+	case http.MethodPut:
+		body, err := decodeS3Body(r)
+		if err != nil {
+			return nil, err
+		}
+		var tagging s3TaggingXML
+		if err := xml.Unmarshal(body, &tagging); err != nil {
+			return nil, newError(http.StatusBadRequest, "MalformedXML", "%s", err)
+		}
+		o.tags = make(tags)
+		o.tags.set(tagging.TagSet)
+		return nil, nil
+	case http.MethodDelete:
+		o.tags = make(tags)
+		return nil, nil
+	default:
+		return nil, newNotImplementedError(r.service, r.Method+" object ?tagging")
+	}
+}
+
+// s3ETag returns the ETag of an object with the specified contents.
+func s3ETag(body []byte) string {
+	sum := md5.Sum(body) //nolint:gosec // S3 ETags are MD5 digests.
+
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// decodeS3Body returns the request's payload.
+// Payloads sent using the aws-chunked content encoding, e.g. with trailing checksums, are decoded.
+func decodeS3Body(r *request) ([]byte, error) {
+	if !strings.Contains(r.Header.Get("Content-Encoding"), "aws-chunked") {
+		return r.body, nil
+	}
+
+	var payload bytes.Buffer
+	reader := bufio.NewReader(bytes.NewReader(r.body))
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "IncompleteBody", "reading aws-chunked chunk header: %s", err)
+		}
+
+		size, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		n, err := strconv.ParseInt(size, 16, 64)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "IncompleteBody", "invalid aws-chunked chunk size: %q", size)
+		}
+		if n == 0 {
+			// Any trailing headers are ignored.
+			return payload.Bytes(), nil
+		}
+
+		if _, err := io.CopyN(&payload, reader, n); err != nil {
+			return nil, newError(http.StatusBadRequest, "IncompleteBody", "reading aws-chunked chunk: %s", err)
+		}
+		if _, err := reader.Discard(2); err != nil {
+			return nil, newError(http.StatusBadRequest, "IncompleteBody", "reading aws-chunked chunk: %s", err)
+		}
+	}
+}
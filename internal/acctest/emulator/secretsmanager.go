@@ -0,0 +1,565 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	errCodeSecretsManagerInvalidRequest       = "InvalidRequestException"
+	errCodeSecretsManagerResourceNotFound     = "ResourceNotFoundException"
+	errCodeSecretsManagerResourceExists       = "ResourceExistsException"
+	secretsManagerVersionStageCurrent         = "AWSCURRENT"
+	secretsManagerVersionStagePrevious        = "AWSPREVIOUS"
+	secretsManagerDefaultRecoveryWindowInDays = 30
+)
+
+// secretsManagerService emulates AWS Secrets Manager.
+type secretsManagerService struct {
+	operations map[string]jsonOperation
+	secrets    *store[*secretsManagerSecret]
+}
+
+type secretsManagerSecret struct {
+	arn          string
+	createdDate  time.Time
+	deletedDate  time.Time
+	description  string
+	kmsKeyID     string
+	name         string
+	policy       string
+	tags         tags
+	updatedDate  time.Time
+	versions     map[string]*secretsManagerSecretVersion
+	versionOrder []string
+}
+
+type secretsManagerSecretVersion struct {
+	createdDate  time.Time
+	secretBinary []byte
+	secretString *string
+	stages       []string
+	versionID    string
+}
+
+func newSecretsManager() *secretsManagerService {
+	s := &secretsManagerService{
+		secrets: newStore[*secretsManagerSecret](),
+	}
+	s.operations = map[string]jsonOperation{
+		"CreateSecret":           jsonOp(s.createSecret),
+		"DeleteResourcePolicy":   jsonOp(s.deleteResourcePolicy),
+		"DeleteSecret":           jsonOp(s.deleteSecret),
+		"DescribeSecret":         jsonOp(s.describeSecret),
+		"GetResourcePolicy":      jsonOp(s.getResourcePolicy),
+		"GetSecretValue":         jsonOp(s.getSecretValue),
+		"ListSecretVersionIds":   jsonOp(s.listSecretVersionIDs),
+		"ListSecrets":            jsonOp(s.listSecrets),
+		"PutResourcePolicy":      jsonOp(s.putResourcePolicy),
+		"PutSecretValue":         jsonOp(s.putSecretValue),
+		"RestoreSecret":          jsonOp(s.restoreSecret),
+		"TagResource":            jsonOp(s.tagResource),
+		"UntagResource":          jsonOp(s.untagResource),
+		"UpdateSecret":           jsonOp(s.updateSecret),
+		"ValidateResourcePolicy": jsonOp(s.validateResourcePolicy),
+	}
+
+	return s
+}
+
+func (s *secretsManagerService) serve(w http.ResponseWriter, r *request) {
+	serveJSON(w, r, "secretsmanager", s.operations)
+}
+
+func newSecretsManagerNotFoundError() *apiError {
+	return newError(http.StatusBadRequest, errCodeSecretsManagerResourceNotFound, "Secrets Manager can't find the specified secret.")
+}
+
+// findSecret returns the secret with the specified name or ARN.
+// The store's mutex must be held.
+func (s *secretsManagerService) findSecret(r *request, id string) (*secretsManagerSecret, error) {
+	secrets := s.secrets.region(r.region)
+
+	if strings.HasPrefix(id, "arn:") {
+		for _, secret := range secrets {
+			if secret.arn == id {
+				return secret, nil
+			}
+		}
+	} else if secret, ok := secrets[id]; ok {
+		return secret, nil
+	}
+
+	return nil, newSecretsManagerNotFoundError()
+}
+
+// findActiveSecret returns the secret with the specified name or ARN, if it is not scheduled for deletion.
+// The store's mutex must be held.
+func (s *secretsManagerService) findActiveSecret(r *request, id string) (*secretsManagerSecret, error) {
+	secret, err := s.findSecret(r, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !secret.deletedDate.IsZero() {
+		return nil, newError(http.StatusBadRequest, errCodeSecretsManagerInvalidRequest, "You can't perform this operation on the secret because it was marked for deletion.")
+	}
+
+	return secret, nil
+}
+
+// putVersion adds a new version of the secret's value and makes it the current version.
+func (secret *secretsManagerSecret) putVersion(versionID string, secretString *string, secretBinary []byte) *secretsManagerSecretVersion {
+	if versionID == "" {
+		versionID = newUUID()
+	}
+
+	for _, version := range secret.versions {
+		version.stages = slices.DeleteFunc(version.stages, func(stage string) bool {
+			return stage == secretsManagerVersionStagePrevious
+		})
+		if i := slices.Index(version.stages, secretsManagerVersionStageCurrent); i >= 0 {
+			version.stages[i] = secretsManagerVersionStagePrevious
+		}
+	}
+
+	version := &secretsManagerSecretVersion{
+		createdDate:  time.Now(),
+		secretBinary: secretBinary,
+		secretString: secretString,
+		stages:       []string{secretsManagerVersionStageCurrent},
+		versionID:    versionID,
+	}
+	secret.versions[versionID] = version
+	secret.versionOrder = append(secret.versionOrder, versionID)
+	secret.updatedDate = version.createdDate
+
+	return version
+}
+
+// versionByStage returns the version of the secret with the specified stage.
+func (secret *secretsManagerSecret) versionByStage(stage string) *secretsManagerSecretVersion {
+	for _, version := range secret.versions {
+		if slices.Contains(version.stages, stage) {
+			return version
+		}
+	}
+
+	return nil
+}
+
+func (secret *secretsManagerSecret) versionIDsToStages() map[string][]string {
+	m := make(map[string][]string)
+
+	for id, version := range secret.versions {
+		if len(version.stages) > 0 {
+			m[id] = version.stages
+		}
+	}
+
+	return m
+}
+
+func (secret *secretsManagerSecret) describe() map[string]any {
+	m := map[string]any{
+		"ARN":                secret.arn,
+		"CreatedDate":        epochSeconds(secret.createdDate),
+		"LastChangedDate":    epochSeconds(secret.updatedDate),
+		"Name":               secret.name,
+		"RotationEnabled":    false,
+		"Tags":               secret.tags.list(),
+		"VersionIdsToStages": secret.versionIDsToStages(),
+	}
+	if secret.description != "" {
+		m["Description"] = secret.description
+	}
+	if secret.kmsKeyID != "" {
+		m["KmsKeyId"] = secret.kmsKeyID
+	}
+	if !secret.deletedDate.IsZero() {
+		m["DeletedDate"] = epochSeconds(secret.deletedDate)
+	}
+
+	return m
+}
+
+type secretsManagerCreateSecretInput struct {
+	ClientRequestToken string  `json:"ClientRequestToken"`
+	Description        string  `json:"Description"`
+	KMSKeyID           string  `json:"KmsKeyId"`
+	Name               string  `json:"Name"`
+	SecretBinary       []byte  `json:"SecretBinary"`
+	SecretString       *string `json:"SecretString"`
+	Tags               []tag   `json:"Tags"`
+}
+
+func (s *secretsManagerService) createSecret(r *request, input *secretsManagerCreateSecretInput) (any, error) {
+	if input.Name == "" {
+		return nil, newValidationError("Name is required")
+	}
+
+	s.secrets.mu.Lock()
+	defer s.secrets.mu.Unlock()
+
+	secrets := s.secrets.region(r.region)
+	if secret, ok := secrets[input.Name]; ok {
+		if !secret.deletedDate.IsZero() {
+			return nil, newError(http.StatusBadRequest, errCodeSecretsManagerInvalidRequest, "You can't create this secret because a secret with this name is already scheduled for deletion.")
+		}
+		return nil, newError(http.StatusBadRequest, errCodeSecretsManagerResourceExists, "The operation failed because the secret %s already exists.", input.Name)
+	}
+
+	now := time.Now()
+	secret := &secretsManagerSecret{
+		arn:         r.arn("secret:" + input.Name + "-" + newID("", 6)),
+		createdDate: now,
+		description: input.Description,
+		kmsKeyID:    input.KMSKeyID,
+		name:        input.Name,
+		tags:        make(tags),
+		updatedDate: now,
+		versions:    make(map[string]*secretsManagerSecretVersion),
+	}
+	secret.tags.set(input.Tags)
+
+	output := map[string]any{
+		"ARN":  secret.arn,
+		"Name": secret.name,
+	}
+	if input.SecretString != nil || input.SecretBinary != nil {
+		output["VersionId"] = secret.putVersion(input.ClientRequestToken, input.SecretString, input.SecretBinary).versionID
+	}
+	secrets[input.Name] = secret
+
+	return output, nil
+}
+
+type secretsManagerSecretIDInput struct {
+	SecretID string `json:"SecretId"`
+}
+
+func (s *secretsManagerService) describeSecret(r *request, input *secretsManagerSecretIDInput) (any, error) {
+	s.secrets.mu.Lock()
+	defer s.secrets.mu.Unlock()
+
+	secret, err := s.findSecret(r, input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret.describe(), nil
+}
+
+type secretsManagerGetSecretValueInput struct {
+	SecretID     string `json:"SecretId"`
+	VersionID    string `json:"VersionId"`
+	VersionStage string `json:"VersionStage"`
+}
+
+func (s *secretsManagerService) getSecretValue(r *request, input *secretsManagerGetSecretValueInput) (any, error) {
+	s.secrets.mu.Lock()
+	defer s.secrets.mu.Unlock()
+
+	secret, err := s.findActiveSecret(r, input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	var version *secretsManagerSecretVersion
+	switch {
+	case input.VersionID != "":
+		version = secret.versions[input.VersionID]
+	case input.VersionStage != "":
+		version = secret.versionByStage(input.VersionStage)
+	default:
+		version = secret.versionByStage(secretsManagerVersionStageCurrent)
+	}
+	if version == nil {
+		return nil, newError(http.StatusBadRequest, errCodeSecretsManagerResourceNotFound, "Secrets Manager can't find the specified secret value.")
+	}
+
+	output := map[string]any{
+		"ARN":           secret.arn,
+		"CreatedDate":   epochSeconds(version.createdDate),
+		"Name":          secret.name,
+		"VersionId":     version.versionID,
+		"VersionStages": version.stages,
+	}
+	if version.secretString != nil {
+		output["SecretString"] = *version.secretString
+	}
+	if version.secretBinary != nil {
+		output["SecretBinary"] = version.secretBinary
+	}
+
+	return output, nil
+}
+
+type secretsManagerPutSecretValueInput struct {
+	ClientRequestToken string  `json:"ClientRequestToken"`
+	SecretBinary       []byte  `json:"SecretBinary"`
+	SecretID           string  `json:"SecretId"`
+	SecretString       *string `json:"SecretString"`
+}
+
+func (s *secretsManagerService) putSecretValue(r *request, input *secretsManagerPutSecretValueInput) (any, error) {
+	s.secrets.mu.Lock()
+	defer s.secrets.mu.Unlock()
+
+	secret, err := s.findActiveSecret(r, input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	version := secret.putVersion(input.ClientRequestToken, input.SecretString, input.SecretBinary)
+
+	return map[string]any{
+		"ARN":           secret.arn,
+		"Name":          secret.name,
+		"VersionId":     version.versionID,
+		"VersionStages": version.stages,
+	}, nil
+}
+
+type secretsManagerUpdateSecretInput struct {
+	ClientRequestToken string  `json:"ClientRequestToken"`
+	Description        *string `json:"Description"`
+	KMSKeyID           *string `json:"KmsKeyId"`
+	SecretBinary       []byte  `json:"SecretBinary"`
+	SecretID           string  `json:"SecretId"`
+	SecretString       *string `json:"SecretString"`
+}
+
+func (s *secretsManagerService) updateSecret(r *request, input *secretsManagerUpdateSecretInput) (any, error) {
+	s.secrets.mu.Lock()
+	defer s.secrets.mu.Unlock()
+
+	secret, err := s.findActiveSecret(r, input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Description != nil {
+		secret.description = *input.Description
+	}
+	if input.KMSKeyID != nil {
+		secret.kmsKeyID = *input.KMSKeyID
+	}
+	secret.updatedDate = time.Now()
+
+	output := map[string]any{
+		"ARN":  secret.arn,
+		"Name": secret.name,
+	}
+	if input.SecretString != nil || input.SecretBinary != nil {
+		output["VersionId"] = secret.putVersion(input.ClientRequestToken, input.SecretString, input.SecretBinary).versionID
+	}
+
+	return output, nil
+}
+
+type secretsManagerDeleteSecretInput struct {
+	ForceDeleteWithoutRecovery bool   `json:"ForceDeleteWithoutRecovery"`
+	RecoveryWindowInDays       int    `json:"RecoveryWindowInDays"`
+	SecretID                   string `json:"SecretId"`
+}
+
+func (s *secretsManagerService) deleteSecret(r *request, input *secretsManagerDeleteSecretInput) (any, error) {
+	s.secrets.mu.Lock()
+	defer s.secrets.mu.Unlock()
+
+	secret, err := s.findSecret(r, input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	deletionDate := time.Now()
+	if input.ForceDeleteWithoutRecovery {
+		delete(s.secrets.region(r.region), secret.name)
+	} else {
+		days := input.RecoveryWindowInDays
+		if days == 0 {
+			days = secretsManagerDefaultRecoveryWindowInDays
+		}
+		secret.deletedDate = deletionDate
+		deletionDate = deletionDate.AddDate(0, 0, days)
+	}
+
+	return map[string]any{
+		"ARN":          secret.arn,
+		"DeletionDate": epochSeconds(deletionDate),
+		"Name":         secret.name,
+	}, nil
+}
+
+func (s *secretsManagerService) restoreSecret(r *request, input *secretsManagerSecretIDInput) (any, error) {
+	s.secrets.mu.Lock()
+	defer s.secrets.mu.Unlock()
+
+	secret, err := s.findSecret(r, input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret.deletedDate = time.Time{}
+
+	return map[string]any{
+		"ARN":  secret.arn,
+		"Name": secret.name,
+	}, nil
+}
+
+type secretsManagerListSecretsInput struct {
+	IncludePlannedDeletion bool `json:"IncludePlannedDeletion"`
+}
+
+func (s *secretsManagerService) listSecrets(r *request, input *secretsManagerListSecretsInput) (any, error) {
+	s.secrets.mu.Lock()
+	defer s.secrets.mu.Unlock()
+
+	var list []map[string]any
+	for _, secret := range s.secrets.region(r.region) {
+		if !secret.deletedDate.IsZero() && !input.IncludePlannedDeletion {
+			continue
+		}
+		list = append(list, secret.describe())
+	}
+	slices.SortFunc(list, func(a, b map[string]any) int {
+		return strings.Compare(a["Name"].(string), b["Name"].(string))
+	})
+
+	return map[string]any{
+		"SecretList": list,
+	}, nil
+}
+
+func (s *secretsManagerService) listSecretVersionIDs(r *request, input *secretsManagerSecretIDInput) (any, error) {
+	s.secrets.mu.Lock()
+	defer s.secrets.mu.Unlock()
+
+	secret, err := s.findSecret(r, input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]map[string]any, 0, len(secret.versionOrder))
+	for _, id := range secret.versionOrder {
+		version := secret.versions[id]
+		versions = append(versions, map[string]any{
+			"CreatedDate":   epochSeconds(version.createdDate),
+			"VersionId":     version.versionID,
+			"VersionStages": version.stages,
+		})
+	}
+
+	return map[string]any{
+		"ARN":      secret.arn,
+		"Name":     secret.name,
+		"Versions": versions,
+	}, nil
+}
+
+type secretsManagerTagsInput struct {
+	SecretID string   `json:"SecretId"`
+	TagKeys  []string `json:"TagKeys"`
+	Tags     []tag    `json:"Tags"`
+}
+
+func (s *secretsManagerService) tagResource(r *request, input *secretsManagerTagsInput) (any, error) {
+	s.secrets.mu.Lock()
+	defer s.secrets.mu.Unlock()
+
+	secret, err := s.findSecret(r, input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret.tags.set(input.Tags)
+
+	return nil, nil
+}
+
+func (s *secretsManagerService) untagResource(r *request, input *secretsManagerTagsInput) (any, error) {
+	s.secrets.mu.Lock()
+	defer s.secrets.mu.Unlock()
+
+	secret, err := s.findSecret(r, input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret.tags.remove(input.TagKeys)
+
+	return nil, nil
+}
+
+type secretsManagerResourcePolicyInput struct {
+	ResourcePolicy string `json:"ResourcePolicy"`
+	SecretID       string `json:"SecretId"`
+}
+
+func (s *secretsManagerService) getResourcePolicy(r *request, input *secretsManagerResourcePolicyInput) (any, error) {
+	s.secrets.mu.Lock()
+	defer s.secrets.mu.Unlock()
+
+	secret, err := s.findSecret(r, input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := map[string]any{
+		"ARN":  secret.arn,
+		"Name": secret.name,
+	}
+	if secret.policy != "" {
+		output["ResourcePolicy"] = secret.policy
+	}
+
+	return output, nil
+}
+
+func (s *secretsManagerService) putResourcePolicy(r *request, input *secretsManagerResourcePolicyInput) (any, error) {
+	s.secrets.mu.Lock()
+	defer s.secrets.mu.Unlock()
+
+	secret, err := s.findActiveSecret(r, input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret.policy = input.ResourcePolicy
+
+	return map[string]any{
+		"ARN":  secret.arn,
+		"Name": secret.name,
+	}, nil
+}
+
+func (s *secretsManagerService) deleteResourcePolicy(r *request, input *secretsManagerResourcePolicyInput) (any, error) {
+	s.secrets.mu.Lock()
+	defer s.secrets.mu.Unlock()
+
+	secret, err := s.findSecret(r, input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret.policy = ""
+
+	return map[string]any{
+		"ARN":  secret.arn,
+		"Name": secret.name,
+	}, nil
+}
+
+func (s *secretsManagerService) validateResourcePolicy(r *request, input *secretsManagerResourcePolicyInput) (any, error) {
+	return map[string]any{
+		"PolicyValidationPassed": true,
+		"ValidationErrors":       []any{},
+	}, nil
+}
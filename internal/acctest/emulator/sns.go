@@ -0,0 +1,410 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	errCodeSNSNotFound = "NotFound"
+)
+
+// snsService emulates Amazon Simple Notification Service (SNS).
+type snsService struct {
+	operations    map[string]queryOperation
+	subscriptions *store[*snsSubscription]
+	topics        *store[*snsTopic]
+}
+
+type snsTopic struct {
+	arn        string
+	attributes map[string]string
+	name       string
+	tags       tags
+}
+
+type snsSubscription struct {
+	arn        string
+	attributes map[string]string
+	endpoint   string
+	protocol   string
+	topicARN   string
+}
+
+func newSNS() *snsService {
+	s := &snsService{
+		subscriptions: newStore[*snsSubscription](),
+		topics:        newStore[*snsTopic](),
+	}
+	s.operations = map[string]queryOperation{
+		"CreateTopic":               s.createTopic,
+		"DeleteTopic":               s.deleteTopic,
+		"GetSubscriptionAttributes": s.getSubscriptionAttributes,
+		"GetTopicAttributes":        s.getTopicAttributes,
+		"ListSubscriptionsByTopic":  s.listSubscriptionsByTopic,
+		"ListTagsForResource":       s.listTagsForResource,
+		"ListTopics":                s.listTopics,
+		"SetSubscriptionAttributes": s.setSubscriptionAttributes,
+		"SetTopicAttributes":        s.setTopicAttributes,
+		"Subscribe":                 s.subscribe,
+		"TagResource":               s.tagResource,
+		"Unsubscribe":               s.unsubscribe,
+		"UntagResource":             s.untagResource,
+	}
+
+	return s
+}
+
+func (s *snsService) serve(w http.ResponseWriter, r *request) {
+	serveQuery(w, r, "http://sns.amazonaws.com/doc/2010-03-31/", s.operations)
+}
+
+func newSNSNotFoundError(format string, a ...any) *apiError {
+	return newError(http.StatusNotFound, errCodeSNSNotFound, format, a...)
+}
+
+// findTopic returns the topic with the specified ARN.
+// The store's mutex must be held.
+func (s *snsService) findTopic(r *request, arn string) (*snsTopic, error) {
+	topic, ok := s.topics.region(r.region)[arn]
+	if !ok {
+		return nil, newSNSNotFoundError("Topic does not exist")
+	}
+
+	return topic, nil
+}
+
+type snsAttributesXML struct {
+	Entries []snsAttributeEntryXML `xml:"Attributes>entry"`
+}
+
+type snsAttributeEntryXML struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+func snsAttributes(attributes map[string]string) snsAttributesXML {
+	keys := make([]string, 0, len(attributes))
+	for k := range attributes {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	v := snsAttributesXML{}
+	for _, k := range keys {
+		v.Entries = append(v.Entries, snsAttributeEntryXML{Key: k, Value: attributes[k]})
+	}
+
+	return v
+}
+
+func (s *snsService) createTopic(r *request, form url.Values) (any, error) {
+	name := form.Get("Name")
+	if name == "" {
+		return nil, newError(http.StatusBadRequest, "InvalidParameter", "Invalid parameter: Topic Name")
+	}
+
+	s.topics.mu.Lock()
+	defer s.topics.mu.Unlock()
+
+	arn := r.arn(name)
+	topics := s.topics.region(r.region)
+
+	// CreateTopic is idempotent.
+	if _, ok := topics[arn]; !ok {
+		topic := &snsTopic{
+			arn: arn,
+			attributes: map[string]string{
+				"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false,"defaultRequestPolicy":{"headerContentType":"text/plain; charset=UTF-8"}}}`,
+				"Owner":                   AccountID,
+				"Policy":                  `{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":"` + arn + `","Condition":{"StringEquals":{"AWS:SourceOwner":"` + AccountID + `"}}}]}`,
+				"SubscriptionsConfirmed":  "0",
+				"SubscriptionsDeleted":    "0",
+				"SubscriptionsPending":    "0",
+				"TopicArn":                arn,
+			},
+			name: name,
+			tags: make(tags),
+		}
+		if strings.HasSuffix(name, ".fifo") {
+			topic.attributes["FifoTopic"] = "true"
+			topic.attributes["ContentBasedDeduplication"] = "false"
+		}
+		for k, v := range queryEntries(form, "Attributes") {
+			topic.attributes[k] = v
+		}
+		topic.tags.set(tagsFromQuery(form, "Tags"))
+		topics[arn] = topic
+	}
+
+	return struct {
+		TopicARN string `xml:"TopicArn"`
+	}{
+		TopicARN: arn,
+	}, nil
+}
+
+func (s *snsService) deleteTopic(r *request, form url.Values) (any, error) {
+	s.topics.mu.Lock()
+	defer s.topics.mu.Unlock()
+
+	// DeleteTopic is idempotent.
+	delete(s.topics.region(r.region), form.Get("TopicArn"))
+
+	return nil, nil
+}
+
+func (s *snsService) getTopicAttributes(r *request, form url.Values) (any, error) {
+	s.topics.mu.Lock()
+	defer s.topics.mu.Unlock()
+
+	topic, err := s.findTopic(r, form.Get("TopicArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]string, len(topic.attributes))
+	for k, v := range topic.attributes {
+		attributes[k] = v
+	}
+
+	s.subscriptions.mu.Lock()
+	n := 0
+	for _, subscription := range s.subscriptions.region(r.region) {
+		if subscription.topicARN == topic.arn {
+			n++
+		}
+	}
+	s.subscriptions.mu.Unlock()
+	attributes["SubscriptionsConfirmed"] = strconv.Itoa(n)
+
+	return snsAttributes(attributes), nil
+}
+
+func (s *snsService) setTopicAttributes(r *request, form url.Values) (any, error) {
+	s.topics.mu.Lock()
+	defer s.topics.mu.Unlock()
+
+	topic, err := s.findTopic(r, form.Get("TopicArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	name, value := form.Get("AttributeName"), form.Get("AttributeValue")
+	if value == "" {
+		delete(topic.attributes, name)
+	} else {
+		topic.attributes[name] = value
+	}
+
+	return nil, nil
+}
+
+func (s *snsService) listTopics(r *request, form url.Values) (any, error) {
+	s.topics.mu.Lock()
+	defer s.topics.mu.Unlock()
+
+	type topicXML struct {
+		TopicARN string `xml:"TopicArn"`
+	}
+	var topics []topicXML
+	for arn := range s.topics.region(r.region) {
+		topics = append(topics, topicXML{TopicARN: arn})
+	}
+	slices.SortFunc(topics, func(a, b topicXML) int {
+		return strings.Compare(a.TopicARN, b.TopicARN)
+	})
+
+	return struct {
+		Topics []topicXML `xml:"Topics>member"`
+	}{
+		Topics: topics,
+	}, nil
+}
+
+func (s *snsService) listTagsForResource(r *request, form url.Values) (any, error) {
+	s.topics.mu.Lock()
+	defer s.topics.mu.Unlock()
+
+	topic, err := s.findTopic(r, form.Get("ResourceArn"))
+	if err != nil {
+		return nil, newError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	return struct {
+		Tags []tag `xml:"Tags>member"`
+	}{
+		Tags: topic.tags.list(),
+	}, nil
+}
+
+func (s *snsService) tagResource(r *request, form url.Values) (any, error) {
+	s.topics.mu.Lock()
+	defer s.topics.mu.Unlock()
+
+	topic, err := s.findTopic(r, form.Get("ResourceArn"))
+	if err != nil {
+		return nil, newError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	topic.tags.set(tagsFromQuery(form, "Tags"))
+
+	return nil, nil
+}
+
+func (s *snsService) untagResource(r *request, form url.Values) (any, error) {
+	s.topics.mu.Lock()
+	defer s.topics.mu.Unlock()
+
+	topic, err := s.findTopic(r, form.Get("ResourceArn"))
+	if err != nil {
+		return nil, newError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	topic.tags.remove(queryList(form, "TagKeys"))
+
+	return nil, nil
+}
+
+//
+// Subscriptions.
+//
+
+// findSubscription returns the subscription with the specified ARN.
+// The store's mutex must be held.
+func (s *snsService) findSubscription(r *request, arn string) (*snsSubscription, error) {
+	subscription, ok := s.subscriptions.region(r.region)[arn]
+	if !ok {
+		return nil, newSNSNotFoundError("Subscription does not exist")
+	}
+
+	return subscription, nil
+}
+
+func (s *snsService) subscribe(r *request, form url.Values) (any, error) {
+	topicARN := form.Get("TopicArn")
+
+	s.topics.mu.Lock()
+	_, err := s.findTopic(r, topicARN)
+	s.topics.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	s.subscriptions.mu.Lock()
+	defer s.subscriptions.mu.Unlock()
+
+	protocol, endpoint := form.Get("Protocol"), form.Get("Endpoint")
+	subscription := &snsSubscription{
+		arn:      topicARN + ":" + newUUID(),
+		endpoint: endpoint,
+		protocol: protocol,
+		topicARN: topicARN,
+	}
+	// Subscriptions are confirmed immediately.
+	subscription.attributes = map[string]string{
+		"ConfirmationWasAuthenticated": "true",
+		"Endpoint":                     endpoint,
+		"Owner":                        AccountID,
+		"PendingConfirmation":          "false",
+		"Protocol":                     protocol,
+		"RawMessageDelivery":           "false",
+		"SubscriptionArn":              subscription.arn,
+		"SubscriptionPrincipal":        "arn:" + Partition + ":iam::" + AccountID + ":user/emulator",
+		"TopicArn":                     topicARN,
+	}
+	for k, v := range queryEntries(form, "Attributes") {
+		subscription.attributes[k] = v
+	}
+	s.subscriptions.region(r.region)[subscription.arn] = subscription
+
+	return struct {
+		SubscriptionARN string `xml:"SubscriptionArn"`
+	}{
+		SubscriptionARN: subscription.arn,
+	}, nil
+}
+
+func (s *snsService) unsubscribe(r *request, form url.Values) (any, error) {
+	s.subscriptions.mu.Lock()
+	defer s.subscriptions.mu.Unlock()
+
+	subscription, err := s.findSubscription(r, form.Get("SubscriptionArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.subscriptions.region(r.region), subscription.arn)
+
+	return nil, nil
+}
+
+func (s *snsService) getSubscriptionAttributes(r *request, form url.Values) (any, error) {
+	s.subscriptions.mu.Lock()
+	defer s.subscriptions.mu.Unlock()
+
+	subscription, err := s.findSubscription(r, form.Get("SubscriptionArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	return snsAttributes(subscription.attributes), nil
+}
+
+func (s *snsService) setSubscriptionAttributes(r *request, form url.Values) (any, error) {
+	s.subscriptions.mu.Lock()
+	defer s.subscriptions.mu.Unlock()
+
+	subscription, err := s.findSubscription(r, form.Get("SubscriptionArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.attributes[form.Get("AttributeName")] = form.Get("AttributeValue")
+
+	return nil, nil
+}
+
+func (s *snsService) listSubscriptionsByTopic(r *request, form url.Values) (any, error) {
+	topicARN := form.Get("TopicArn")
+
+	s.subscriptions.mu.Lock()
+	defer s.subscriptions.mu.Unlock()
+
+	type subscriptionXML struct {
+		Endpoint        string `xml:"Endpoint"`
+		Owner           string `xml:"Owner"`
+		Protocol        string `xml:"Protocol"`
+		SubscriptionARN string `xml:"SubscriptionArn"`
+		TopicARN        string `xml:"TopicArn"`
+	}
+	var subscriptions []subscriptionXML
+	for _, subscription := range s.subscriptions.region(r.region) {
+		if subscription.topicARN != topicARN {
+			continue
+		}
+
+		subscriptions = append(subscriptions, subscriptionXML{
+			Endpoint:        subscription.endpoint,
+			Owner:           AccountID,
+			Protocol:        subscription.protocol,
+			SubscriptionARN: subscription.arn,
+			TopicARN:        subscription.topicARN,
+		})
+	}
+	slices.SortFunc(subscriptions, func(a, b subscriptionXML) int {
+		return strings.Compare(a.SubscriptionARN, b.SubscriptionARN)
+	})
+
+	return struct {
+		Subscriptions []subscriptionXML `xml:"Subscriptions>member"`
+	}{
+		Subscriptions: subscriptions,
+	}, nil
+}
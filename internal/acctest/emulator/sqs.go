@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"crypto/md5" //nolint:gosec // Used for message digests, as by SQS.
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	errCodeSQSQueueDoesNotExist = "QueueDoesNotExist"
+	errCodeSQSQueueNameExists   = "QueueNameExists"
+)
+
+// sqsService emulates Amazon Simple Queue Service (SQS).
+type sqsService struct {
+	operations map[string]jsonOperation
+	queues     *store[*sqsQueue]
+}
+
+type sqsQueue struct {
+	attributes map[string]string
+	messages   []*sqsMessage
+	name       string
+	tags       tags
+}
+
+type sqsMessage struct {
+	Attributes    map[string]string `json:"Attributes,omitempty"`
+	Body          string            `json:"Body"`
+	MD5OfBody     string            `json:"MD5OfBody"`
+	MessageID     string            `json:"MessageId"`
+	ReceiptHandle string            `json:"ReceiptHandle"`
+}
+
+func newSQS() *sqsService {
+	s := &sqsService{
+		queues: newStore[*sqsQueue](),
+	}
+	s.operations = map[string]jsonOperation{
+		"CreateQueue":        jsonOp(s.createQueue),
+		"DeleteMessage":      jsonOp(s.deleteMessage),
+		"DeleteQueue":        jsonOp(s.deleteQueue),
+		"GetQueueAttributes": jsonOp(s.getQueueAttributes),
+		"GetQueueUrl":        jsonOp(s.getQueueURL),
+		"ListQueueTags":      jsonOp(s.listQueueTags),
+		"ListQueues":         jsonOp(s.listQueues),
+		"PurgeQueue":         jsonOp(s.purgeQueue),
+		"ReceiveMessage":     jsonOp(s.receiveMessage),
+		"SendMessage":        jsonOp(s.sendMessage),
+		"SetQueueAttributes": jsonOp(s.setQueueAttributes),
+		"TagQueue":           jsonOp(s.tagQueue),
+		"UntagQueue":         jsonOp(s.untagQueue),
+	}
+
+	return s
+}
+
+func (s *sqsService) serve(w http.ResponseWriter, r *request) {
+	serveJSON(w, r, "AmazonSQS", s.operations)
+}
+
+func newSQSQueueDoesNotExistError() *apiError {
+	err := newError(http.StatusBadRequest, errCodeSQSQueueDoesNotExist, "The specified queue does not exist.")
+	err.queryCode = "AWS.SimpleQueueService.NonExistentQueue"
+
+	return err
+}
+
+// queueURL returns the URL of the specified queue.
+func (s *sqsService) queueURL(r *request, name string) string {
+	return fmt.Sprintf("%s/%s/%s", r.endpoint(), AccountID, name)
+}
+
+// findQueue returns the queue with the specified URL.
+// The store's mutex must be held.
+func (s *sqsService) findQueue(r *request, queueURL string) (*sqsQueue, error) {
+	u, err := url.Parse(queueURL)
+	if err != nil {
+		return nil, newSQSQueueDoesNotExistError()
+	}
+
+	name := u.Path[strings.LastIndexByte(u.Path, '/')+1:]
+	queue, ok := s.queues.region(r.region)[name]
+	if !ok {
+		return nil, newSQSQueueDoesNotExistError()
+	}
+
+	return queue, nil
+}
+
+type sqsCreateQueueInput struct {
+	Attributes map[string]string `json:"Attributes"`
+	QueueName  string            `json:"QueueName"`
+	Tags       map[string]string `json:"tags"`
+}
+
+func (s *sqsService) createQueue(r *request, input *sqsCreateQueueInput) (any, error) {
+	if input.QueueName == "" {
+		return nil, newError(http.StatusBadRequest, "MissingParameter", "The request must contain the parameter QueueName.")
+	}
+
+	s.queues.mu.Lock()
+	defer s.queues.mu.Unlock()
+
+	queues := s.queues.region(r.region)
+	if queue, ok := queues[input.QueueName]; ok {
+		for k, v := range input.Attributes {
+			if queue.attributes[k] != v {
+				err := newError(http.StatusBadRequest, errCodeSQSQueueNameExists, "A queue already exists with the same name and a different value for attribute %s", k)
+				err.queryCode = "QueueAlreadyExists"
+				return nil, err
+			}
+		}
+	} else {
+		now := strconv.FormatInt(time.Now().Unix(), 10)
+		attributes := map[string]string{
+			"ApproximateNumberOfMessages":           "0",
+			"ApproximateNumberOfMessagesDelayed":    "0",
+			"ApproximateNumberOfMessagesNotVisible": "0",
+			"CreatedTimestamp":                      now,
+			"DelaySeconds":                          "0",
+			"LastModifiedTimestamp":                 now,
+			"MaximumMessageSize":                    "262144",
+			"MessageRetentionPeriod":                "345600",
+			"QueueArn":                              r.arn(input.QueueName),
+			"ReceiveMessageWaitTimeSeconds":         "0",
+			"SqsManagedSseEnabled":                  "true",
+			"VisibilityTimeout":                     "30",
+		}
+		if strings.HasSuffix(input.QueueName, ".fifo") {
+			attributes["ContentBasedDeduplication"] = "false"
+			attributes["DeduplicationScope"] = "queue"
+			attributes["FifoQueue"] = "true"
+			attributes["FifoThroughputLimit"] = "perQueue"
+		}
+		for k, v := range input.Attributes {
+			attributes[k] = v
+		}
+		if attributes["KmsMasterKeyId"] != "" {
+			attributes["SqsManagedSseEnabled"] = "false"
+		}
+
+		queue := &sqsQueue{
+			attributes: attributes,
+			name:       input.QueueName,
+			tags:       make(tags),
+		}
+		for k, v := range input.Tags {
+			queue.tags[k] = v
+		}
+		queues[input.QueueName] = queue
+	}
+
+	return map[string]any{
+		"QueueUrl": s.queueURL(r, input.QueueName),
+	}, nil
+}
+
+type sqsQueueURLInput struct {
+	QueueURL string `json:"QueueUrl"`
+}
+
+func (s *sqsService) deleteQueue(r *request, input *sqsQueueURLInput) (any, error) {
+	s.queues.mu.Lock()
+	defer s.queues.mu.Unlock()
+
+	queue, err := s.findQueue(r, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.queues.region(r.region), queue.name)
+
+	return nil, nil
+}
+
+type sqsGetQueueAttributesInput struct {
+	AttributeNames []string `json:"AttributeNames"`
+	QueueURL       string   `json:"QueueUrl"`
+}
+
+func (s *sqsService) getQueueAttributes(r *request, input *sqsGetQueueAttributesInput) (any, error) {
+	s.queues.mu.Lock()
+	defer s.queues.mu.Unlock()
+
+	queue, err := s.findQueue(r, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]string)
+	for k, v := range queue.attributes {
+		if slices.Contains(input.AttributeNames, "All") || slices.Contains(input.AttributeNames, k) {
+			attributes[k] = v
+		}
+	}
+	if _, ok := attributes["ApproximateNumberOfMessages"]; ok {
+		attributes["ApproximateNumberOfMessages"] = strconv.Itoa(len(queue.messages))
+	}
+
+	return map[string]any{
+		"Attributes": attributes,
+	}, nil
+}
+
+type sqsSetQueueAttributesInput struct {
+	Attributes map[string]string `json:"Attributes"`
+	QueueURL   string            `json:"QueueUrl"`
+}
+
+func (s *sqsService) setQueueAttributes(r *request, input *sqsSetQueueAttributesInput) (any, error) {
+	s.queues.mu.Lock()
+	defer s.queues.mu.Unlock()
+
+	queue, err := s.findQueue(r, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range input.Attributes {
+		if k == "FifoQueue" || k == "QueueArn" {
+			return nil, newError(http.StatusBadRequest, "InvalidAttributeName", "Attribute %s cannot be set", k)
+		}
+		// An empty value removes a policy.
+		if v == "" && (k == "Policy" || k == "RedrivePolicy" || k == "RedriveAllowPolicy") {
+			delete(queue.attributes, k)
+			continue
+		}
+		queue.attributes[k] = v
+	}
+	queue.attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+
+	return nil, nil
+}
+
+type sqsGetQueueURLInput struct {
+	QueueName string `json:"QueueName"`
+}
+
+func (s *sqsService) getQueueURL(r *request, input *sqsGetQueueURLInput) (any, error) {
+	s.queues.mu.Lock()
+	defer s.queues.mu.Unlock()
+
+	if _, ok := s.queues.region(r.region)[input.QueueName]; !ok {
+		return nil, newSQSQueueDoesNotExistError()
+	}
+
+	return map[string]any{
+		"QueueUrl": s.queueURL(r, input.QueueName),
+	}, nil
+}
+
+type sqsListQueuesInput struct {
+	QueueNamePrefix string `json:"QueueNamePrefix"`
+}
+
+func (s *sqsService) listQueues(r *request, input *sqsListQueuesInput) (any, error) {
+	s.queues.mu.Lock()
+	defer s.queues.mu.Unlock()
+
+	var urls []string
+	for name := range s.queues.region(r.region) {
+		if strings.HasPrefix(name, input.QueueNamePrefix) {
+			urls = append(urls, s.queueURL(r, name))
+		}
+	}
+	slices.Sort(urls)
+
+	return map[string]any{
+		"QueueUrls": urls,
+	}, nil
+}
+
+func (s *sqsService) listQueueTags(r *request, input *sqsQueueURLInput) (any, error) {
+	s.queues.mu.Lock()
+	defer s.queues.mu.Unlock()
+
+	queue, err := s.findQueue(r, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"Tags": map[string]string(queue.tags),
+	}, nil
+}
+
+type sqsTagQueueInput struct {
+	QueueURL string            `json:"QueueUrl"`
+	Tags     map[string]string `json:"Tags"`
+}
+
+func (s *sqsService) tagQueue(r *request, input *sqsTagQueueInput) (any, error) {
+	s.queues.mu.Lock()
+	defer s.queues.mu.Unlock()
+
+	queue, err := s.findQueue(r, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range input.Tags {
+		queue.tags[k] = v
+	}
+
+	return nil, nil
+}
+
+type sqsUntagQueueInput struct {
+	QueueURL string   `json:"QueueUrl"`
+	TagKeys  []string `json:"TagKeys"`
+}
+
+func (s *sqsService) untagQueue(r *request, input *sqsUntagQueueInput) (any, error) {
+	s.queues.mu.Lock()
+	defer s.queues.mu.Unlock()
+
+	queue, err := s.findQueue(r, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	queue.tags.remove(input.TagKeys)
+
+	return nil, nil
+}
+
+func (s *sqsService) purgeQueue(r *request, input *sqsQueueURLInput) (any, error) {
+	s.queues.mu.Lock()
+	defer s.queues.mu.Unlock()
+
+	queue, err := s.findQueue(r, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	queue.messages = nil
+
+	return nil, nil
+}
+
+type sqsSendMessageInput struct {
+	MessageBody string `json:"MessageBody"`
+	QueueURL    string `json:"QueueUrl"`
+}
+
+func (s *sqsService) sendMessage(r *request, input *sqsSendMessageInput) (any, error) {
+	s.queues.mu.Lock()
+	defer s.queues.mu.Unlock()
+
+	queue, err := s.findQueue(r, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := md5.Sum([]byte(input.MessageBody)) //nolint:gosec // Used for message digests, as by SQS.
+	message := &sqsMessage{
+		Body:          input.MessageBody,
+		MD5OfBody:     hex.EncodeToString(digest[:]),
+		MessageID:     newUUID(),
+		ReceiptHandle: newID("", 32),
+	}
+	queue.messages = append(queue.messages, message)
+
+	return map[string]any{
+		"MD5OfMessageBody": message.MD5OfBody,
+		"MessageId":        message.MessageID,
+	}, nil
+}
+
+type sqsReceiveMessageInput struct {
+	MaxNumberOfMessages int    `json:"MaxNumberOfMessages"`
+	QueueURL            string `json:"QueueUrl"`
+}
+
+func (s *sqsService) receiveMessage(r *request, input *sqsReceiveMessageInput) (any, error) {
+	s.queues.mu.Lock()
+	defer s.queues.mu.Unlock()
+
+	queue, err := s.findQueue(r, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	n := max(input.MaxNumberOfMessages, 1)
+	messages := queue.messages[:min(n, len(queue.messages))]
+
+	return map[string]any{
+		"Messages": messages,
+	}, nil
+}
+
+type sqsDeleteMessageInput struct {
+	QueueURL      string `json:"QueueUrl"`
+	ReceiptHandle string `json:"ReceiptHandle"`
+}
+
+func (s *sqsService) deleteMessage(r *request, input *sqsDeleteMessageInput) (any, error) {
+	s.queues.mu.Lock()
+	defer s.queues.mu.Unlock()
+
+	queue, err := s.findQueue(r, input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	queue.messages = slices.DeleteFunc(queue.messages, func(m *sqsMessage) bool {
+		return m.ReceiptHandle == input.ReceiptHandle
+	})
+
+	return nil, nil
+}
@@ -0,0 +1,440 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	errCodeSSMInvalidResourceID    = "InvalidResourceId"
+	errCodeSSMParameterExists      = "ParameterAlreadyExists"
+	errCodeSSMParameterNotFound    = "ParameterNotFound"
+	ssmParameterTypeSecureString   = "SecureString"
+	ssmParameterTierStandard       = "Standard"
+	ssmParameterDataTypeText       = "text"
+	ssmResourceTypeParameter       = "Parameter"
+	ssmDefaultKeyID                = "alias/aws/ssm"
+	ssmParameterFilterKeyName      = "Name"
+	ssmParameterFilterOptionEquals = "Equals"
+)
+
+// ssmService emulates AWS Systems Manager (SSM) Parameter Store.
+type ssmService struct {
+	operations map[string]jsonOperation
+	parameters *store[*ssmParameter]
+}
+
+type ssmParameter struct {
+	allowedPattern   string
+	dataType         string
+	description      string
+	keyID            string
+	lastModifiedDate time.Time
+	name             string
+	tags             tags
+	tier             string
+	parameterType    string
+	value            string
+	version          int
+}
+
+func newSSM() *ssmService {
+	s := &ssmService{
+		parameters: newStore[*ssmParameter](),
+	}
+	s.operations = map[string]jsonOperation{
+		"AddTagsToResource":      jsonOp(s.addTagsToResource),
+		"DeleteParameter":        jsonOp(s.deleteParameter),
+		"DeleteParameters":       jsonOp(s.deleteParameters),
+		"DescribeParameters":     jsonOp(s.describeParameters),
+		"GetParameter":           jsonOp(s.getParameter),
+		"GetParameters":          jsonOp(s.getParameters),
+		"GetParametersByPath":    jsonOp(s.getParametersByPath),
+		"ListTagsForResource":    jsonOp(s.listTagsForResource),
+		"PutParameter":           jsonOp(s.putParameter),
+		"RemoveTagsFromResource": jsonOp(s.removeTagsFromResource),
+	}
+
+	return s
+}
+
+func (s *ssmService) serve(w http.ResponseWriter, r *request) {
+	serveJSON(w, r, "AmazonSSM", s.operations)
+}
+
+func newSSMParameterNotFoundError(name string) *apiError {
+	return newError(http.StatusBadRequest, errCodeSSMParameterNotFound, "Parameter %s not found.", name)
+}
+
+// parameterARN returns the ARN of the parameter with the specified name.
+func parameterARN(r *request, name string) string {
+	return r.arn("parameter/" + strings.TrimPrefix(name, "/"))
+}
+
+// parameterName returns the name of the parameter with the specified name or ARN.
+// Parameter ARNs don't distinguish names with and without a leading "/", so the store is consulted.
+// The store's mutex must be held.
+func (s *ssmService) parameterName(r *request, id string) string {
+	if !strings.HasPrefix(id, "arn:") {
+		return id
+	}
+
+	_, after, ok := strings.Cut(id, ":parameter/")
+	if !ok {
+		return id
+	}
+
+	if _, ok := s.parameters.region(r.region)["/"+after]; ok {
+		return "/" + after
+	}
+
+	return after
+}
+
+func (p *ssmParameter) output(r *request, withDecryption bool) map[string]any {
+	value := p.value
+	if p.parameterType == ssmParameterTypeSecureString && !withDecryption {
+		// Real ciphertexts are opaque; any value that differs from the plaintext is sufficient.
+		value = "kms:" + p.keyID + ":" + newID("", 32)
+	}
+
+	return map[string]any{
+		"ARN":              parameterARN(r, p.name),
+		"DataType":         p.dataType,
+		"LastModifiedDate": epochSeconds(p.lastModifiedDate),
+		"Name":             p.name,
+		"Type":             p.parameterType,
+		"Value":            value,
+		"Version":          p.version,
+	}
+}
+
+func (p *ssmParameter) metadata(r *request) map[string]any {
+	m := map[string]any{
+		"ARN":              parameterARN(r, p.name),
+		"DataType":         p.dataType,
+		"LastModifiedDate": epochSeconds(p.lastModifiedDate),
+		"LastModifiedUser": "arn:" + Partition + ":iam::" + AccountID + ":user/emulator",
+		"Name":             p.name,
+		"Policies":         []any{},
+		"Tier":             p.tier,
+		"Type":             p.parameterType,
+		"Version":          p.version,
+	}
+	if p.allowedPattern != "" {
+		m["AllowedPattern"] = p.allowedPattern
+	}
+	if p.description != "" {
+		m["Description"] = p.description
+	}
+	if p.keyID != "" {
+		m["KeyId"] = p.keyID
+	}
+
+	return m
+}
+
+type ssmPutParameterInput struct {
+	AllowedPattern string `json:"AllowedPattern"`
+	DataType       string `json:"DataType"`
+	Description    string `json:"Description"`
+	KeyID          string `json:"KeyId"`
+	Name           string `json:"Name"`
+	Overwrite      bool   `json:"Overwrite"`
+	Tags           []tag  `json:"Tags"`
+	Tier           string `json:"Tier"`
+	Type           string `json:"Type"`
+	Value          string `json:"Value"`
+}
+
+func (s *ssmService) putParameter(r *request, input *ssmPutParameterInput) (any, error) {
+	if input.Name == "" {
+		return nil, newValidationError("Parameter name is required")
+	}
+
+	s.parameters.mu.Lock()
+	defer s.parameters.mu.Unlock()
+
+	parameters := s.parameters.region(r.region)
+	p, ok := parameters[input.Name]
+	if ok {
+		if !input.Overwrite {
+			return nil, newError(http.StatusBadRequest, errCodeSSMParameterExists, "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+		}
+		if len(input.Tags) > 0 {
+			return nil, newValidationError("Invalid request: tags and overwrite can't be used together. To create a parameter with tags, please remove overwrite flag. To update tags for an existing parameter, please use AddTagsToResource or RemoveTagsFromResource.")
+		}
+	} else {
+		if input.Type == "" {
+			return nil, newValidationError("A parameter type is required when you create a parameter.")
+		}
+		p = &ssmParameter{
+			dataType: ssmParameterDataTypeText,
+			name:     input.Name,
+			tags:     make(tags),
+			tier:     ssmParameterTierStandard,
+		}
+		p.tags.set(input.Tags)
+		parameters[input.Name] = p
+	}
+
+	if v := input.Type; v != "" {
+		p.parameterType = v
+	}
+	if v := input.DataType; v != "" {
+		p.dataType = v
+	}
+	if v := input.Tier; v != "" && v != "Intelligent-Tiering" {
+		p.tier = v
+	}
+	if p.parameterType == ssmParameterTypeSecureString {
+		p.keyID = input.KeyID
+		if p.keyID == "" {
+			p.keyID = ssmDefaultKeyID
+		}
+	} else {
+		p.keyID = ""
+	}
+	p.allowedPattern = input.AllowedPattern
+	p.description = input.Description
+	p.lastModifiedDate = time.Now()
+	p.value = input.Value
+	p.version++
+
+	return map[string]any{
+		"Tier":    p.tier,
+		"Version": p.version,
+	}, nil
+}
+
+type ssmGetParameterInput struct {
+	Name           string `json:"Name"`
+	WithDecryption bool   `json:"WithDecryption"`
+}
+
+func (s *ssmService) getParameter(r *request, input *ssmGetParameterInput) (any, error) {
+	s.parameters.mu.Lock()
+	defer s.parameters.mu.Unlock()
+
+	name := s.parameterName(r, input.Name)
+	p, ok := s.parameters.region(r.region)[name]
+	if !ok {
+		return nil, newSSMParameterNotFoundError(name)
+	}
+
+	return map[string]any{
+		"Parameter": p.output(r, input.WithDecryption),
+	}, nil
+}
+
+type ssmGetParametersInput struct {
+	Names          []string `json:"Names"`
+	WithDecryption bool     `json:"WithDecryption"`
+}
+
+func (s *ssmService) getParameters(r *request, input *ssmGetParametersInput) (any, error) {
+	s.parameters.mu.Lock()
+	defer s.parameters.mu.Unlock()
+
+	parameters := s.parameters.region(r.region)
+	outputs, invalid := []map[string]any{}, []string{}
+	for _, v := range input.Names {
+		if p, ok := parameters[s.parameterName(r, v)]; ok {
+			outputs = append(outputs, p.output(r, input.WithDecryption))
+		} else {
+			invalid = append(invalid, v)
+		}
+	}
+
+	return map[string]any{
+		"InvalidParameters": invalid,
+		"Parameters":        outputs,
+	}, nil
+}
+
+type ssmGetParametersByPathInput struct {
+	Path           string `json:"Path"`
+	Recursive      bool   `json:"Recursive"`
+	WithDecryption bool   `json:"WithDecryption"`
+}
+
+func (s *ssmService) getParametersByPath(r *request, input *ssmGetParametersByPathInput) (any, error) {
+	s.parameters.mu.Lock()
+	defer s.parameters.mu.Unlock()
+
+	prefix := strings.TrimSuffix(input.Path, "/") + "/"
+
+	var names []string
+	for name := range s.parameters.region(r.region) {
+		rest, ok := strings.CutPrefix(name, prefix)
+		if !ok || (!input.Recursive && strings.Contains(rest, "/")) {
+			continue
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	outputs := make([]map[string]any, 0, len(names))
+	for _, name := range names {
+		outputs = append(outputs, s.parameters.region(r.region)[name].output(r, input.WithDecryption))
+	}
+
+	return map[string]any{
+		"Parameters": outputs,
+	}, nil
+}
+
+type ssmDescribeParametersInput struct {
+	ParameterFilters []struct {
+		Key    string   `json:"Key"`
+		Option string   `json:"Option"`
+		Values []string `json:"Values"`
+	} `json:"ParameterFilters"`
+}
+
+func (s *ssmService) describeParameters(r *request, input *ssmDescribeParametersInput) (any, error) {
+	s.parameters.mu.Lock()
+	defer s.parameters.mu.Unlock()
+
+	var names []string
+	for name := range s.parameters.region(r.region) {
+		match := true
+		for _, filter := range input.ParameterFilters {
+			if filter.Key != ssmParameterFilterKeyName {
+				continue
+			}
+
+			switch filter.Option {
+			case "", ssmParameterFilterOptionEquals:
+				match = match && slices.Contains(filter.Values, name)
+			case "BeginsWith":
+				match = match && slices.ContainsFunc(filter.Values, func(v string) bool {
+					return strings.HasPrefix(name, v)
+				})
+			case "Contains":
+				match = match && slices.ContainsFunc(filter.Values, func(v string) bool {
+					return strings.Contains(name, v)
+				})
+			}
+		}
+		if match {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	metadata := make([]map[string]any, 0, len(names))
+	for _, name := range names {
+		metadata = append(metadata, s.parameters.region(r.region)[name].metadata(r))
+	}
+
+	return map[string]any{
+		"Parameters": metadata,
+	}, nil
+}
+
+func (s *ssmService) deleteParameter(r *request, input *ssmGetParameterInput) (any, error) {
+	s.parameters.mu.Lock()
+	defer s.parameters.mu.Unlock()
+
+	parameters := s.parameters.region(r.region)
+	name := s.parameterName(r, input.Name)
+	if _, ok := parameters[name]; !ok {
+		return nil, newSSMParameterNotFoundError(name)
+	}
+
+	delete(parameters, name)
+
+	return nil, nil
+}
+
+func (s *ssmService) deleteParameters(r *request, input *ssmGetParametersInput) (any, error) {
+	s.parameters.mu.Lock()
+	defer s.parameters.mu.Unlock()
+
+	parameters := s.parameters.region(r.region)
+	deleted, invalid := []string{}, []string{}
+	for _, v := range input.Names {
+		name := s.parameterName(r, v)
+		if _, ok := parameters[name]; ok {
+			delete(parameters, name)
+			deleted = append(deleted, v)
+		} else {
+			invalid = append(invalid, v)
+		}
+	}
+
+	return map[string]any{
+		"DeletedParameters": deleted,
+		"InvalidParameters": invalid,
+	}, nil
+}
+
+type ssmTagsInput struct {
+	ResourceID   string   `json:"ResourceId"`
+	ResourceType string   `json:"ResourceType"`
+	TagKeys      []string `json:"TagKeys"`
+	Tags         []tag    `json:"Tags"`
+}
+
+// findTaggableParameter returns the parameter identified by the specified tagging operation input.
+// The store's mutex must be held.
+func (s *ssmService) findTaggableParameter(r *request, input *ssmTagsInput) (*ssmParameter, error) {
+	if input.ResourceType != ssmResourceTypeParameter {
+		return nil, newNotImplementedError(r.service, "tagging of resource type "+input.ResourceType)
+	}
+
+	p, ok := s.parameters.region(r.region)[s.parameterName(r, input.ResourceID)]
+	if !ok {
+		return nil, newError(http.StatusBadRequest, errCodeSSMInvalidResourceID, "The resource ID \"%s\" is not valid. Verify the ID and try again.", input.ResourceID)
+	}
+
+	return p, nil
+}
+
+func (s *ssmService) listTagsForResource(r *request, input *ssmTagsInput) (any, error) {
+	s.parameters.mu.Lock()
+	defer s.parameters.mu.Unlock()
+
+	p, err := s.findTaggableParameter(r, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"TagList": p.tags.list(),
+	}, nil
+}
+
+func (s *ssmService) addTagsToResource(r *request, input *ssmTagsInput) (any, error) {
+	s.parameters.mu.Lock()
+	defer s.parameters.mu.Unlock()
+
+	p, err := s.findTaggableParameter(r, input)
+	if err != nil {
+		return nil, err
+	}
+
+	p.tags.set(input.Tags)
+
+	return nil, nil
+}
+
+func (s *ssmService) removeTagsFromResource(r *request, input *ssmTagsInput) (any, error) {
+	s.parameters.mu.Lock()
+	defer s.parameters.mu.Unlock()
+
+	p, err := s.findTaggableParameter(r, input)
+	if err != nil {
+		return nil, err
+	}
+
+	p.tags.remove(input.TagKeys)
+
+	return nil, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// stsService emulates AWS Security Token Service (STS).
+type stsService struct {
+	operations map[string]queryOperation
+}
+
+func newSTS() *stsService {
+	s := &stsService{}
+	s.operations = map[string]queryOperation{
+		"AssumeRole":        s.assumeRole,
+		"GetCallerIdentity": s.getCallerIdentity,
+		"GetSessionToken":   s.getSessionToken,
+	}
+
+	return s
+}
+
+func (s *stsService) serve(w http.ResponseWriter, r *request) {
+	serveQuery(w, r, "https://sts.amazonaws.com/doc/2011-06-15/", s.operations)
+}
+
+type stsAssumedRoleUser struct {
+	ARN           string `xml:"Arn"`
+	AssumedRoleID string `xml:"AssumedRoleId"`
+}
+
+type stsCredentials struct {
+	AccessKeyID     string `xml:"AccessKeyId"`
+	Expiration      string `xml:"Expiration"`
+	SecretAccessKey string `xml:"SecretAccessKey"`
+	SessionToken    string `xml:"SessionToken"`
+}
+
+func newSTSCredentials(duration time.Duration) stsCredentials {
+	return stsCredentials{
+		AccessKeyID:     newID("ASIA", 16),
+		Expiration:      iso8601(time.Now().Add(duration)),
+		SecretAccessKey: SecretAccessKey,
+		SessionToken:    newID("", 64),
+	}
+}
+
+func (s *stsService) assumeRole(r *request, form url.Values) (any, error) {
+	roleARN, sessionName := form.Get("RoleArn"), form.Get("RoleSessionName")
+	if roleARN == "" || sessionName == "" {
+		return nil, newError(http.StatusBadRequest, "ValidationError", "RoleArn and RoleSessionName are required")
+	}
+
+	duration := time.Hour
+	if v := form.Get("DurationSeconds"); v != "" {
+		seconds, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "ValidationError", "invalid DurationSeconds: %s", v)
+		}
+		duration = time.Duration(seconds) * time.Second
+	}
+
+	roleName := roleARN[strings.LastIndexByte(roleARN, '/')+1:]
+
+	return struct {
+		AssumedRoleUser stsAssumedRoleUser `xml:"AssumedRoleUser"`
+		Credentials     stsCredentials     `xml:"Credentials"`
+	}{
+		AssumedRoleUser: stsAssumedRoleUser{
+			ARN:           fmt.Sprintf("arn:%s:sts::%s:assumed-role/%s/%s", Partition, AccountID, roleName, sessionName),
+			AssumedRoleID: newID("AROA", 17) + ":" + sessionName,
+		},
+		Credentials: newSTSCredentials(duration),
+	}, nil
+}
+
+func (s *stsService) getCallerIdentity(r *request, form url.Values) (any, error) {
+	return struct {
+		Account string `xml:"Account"`
+		ARN     string `xml:"Arn"`
+		UserID  string `xml:"UserId"`
+	}{
+		Account: AccountID,
+		ARN:     fmt.Sprintf("arn:%s:iam::%s:user/emulator", Partition, AccountID),
+		UserID:  "AIDAEMULATOR00000000",
+	}, nil
+}
+
+func (s *stsService) getSessionToken(r *request, form url.Values) (any, error) {
+	return struct {
+		Credentials stsCredentials `xml:"Credentials"`
+	}{
+		Credentials: newSTSCredentials(12 * time.Hour),
+	}, nil
+}
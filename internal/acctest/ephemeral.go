@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// Ephemeral resource variants of standard acceptance test helpers.
+
+// EphemeralValuesDataSourceName is the name of the data source through which ConfigEphemeralValues exposes ephemeral values.
+const EphemeralValuesDataSourceName = "data.aws_default_tags.ephemeral"
+
+// EphemeralResourceTerraformVersionChecks skips tests on Terraform versions without ephemeral resource support.
+var EphemeralResourceTerraformVersionChecks = []tfversion.TerraformVersionCheck{
+	tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0"))),
+}
+
+// ConfigEphemeralValues returns configuration that makes ephemeral values checkable.
+//
+// Ephemeral values are never persisted in state, but they may be used in provider configuration.
+// Each value expression is set as a default tag, keyed by the map key, on an aliased provider whose
+// default tags are read back by the EphemeralValuesDataSourceName data source, e.g.
+//
+//	resource.TestCheckResourceAttr(acctest.EphemeralValuesDataSourceName, "tags.value", "...")
+func ConfigEphemeralValues(values map[string]string) string {
+	var tags strings.Builder
+
+	keys := tfmaps.Keys(values)
+	slices.Sort(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&tags, "      %[1]q = %[2]s\n", k, values[k])
+	}
+
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  alias  = "ephemeral"
+  region = %[1]q
+
+  default_tags {
+    tags = {
+%[2]s    }
+  }
+}
+
+data "aws_default_tags" "ephemeral" {
+  provider = aws.ephemeral
+}
+`, Region(), tags.String())
+}
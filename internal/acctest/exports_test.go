@@ -5,5 +5,18 @@ package acctest
 
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder      = closeVCRRecorder
+	VCRRequestBodiesMatch = vcrRequestBodiesMatch
 )
+
+func VCRRedact(accountID, s string) (string, error) {
+	r, err := newVCRRedactor()
+
+	if err != nil {
+		return "", err
+	}
+
+	r.setAccountID(accountID)
+
+	return r.redact(s), nil
+}
@@ -7,22 +7,17 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
-	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -30,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 )
@@ -164,11 +160,23 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 
 		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
 
+		redactor, err := newVCRRedactor()
+
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		// When replaying, don't wait for the recorded response latency or for state changes that have already happened.
+		// This is the only place that sleepless waits may be enabled.
+		replaying := vcrMode == recorder.ModeReplayOnly
+		tfresource.SetSleepless(replaying)
+
 		// Create a VCR recorder around a default HTTP client.
 		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
+			CassetteName:       path,
+			Mode:               vcrMode,
+			RealTransport:      httpClient.Transport,
+			SkipRequestLatency: replaying,
 		})
 
 		if err != nil {
@@ -183,77 +191,16 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil
 		}, recorder.AfterCaptureHook)
 
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+		// Redact sensitive values before the cassette is saved.
+		// Redaction can't be done as interactions are captured as the provider needs the real values, e.g. the account ID.
+		r.AddHook(func(i *cassette.Interaction) error {
+			redactor.redactInteraction(i)
 
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
+			return nil
+		}, recorder.BeforeSaveHook)
 
-			return false
-		})
+		// Defines how VCR will match requests to responses.
+		r.SetMatcher(vcrRequestMatcher(ctx, redactor))
 
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
@@ -284,6 +231,8 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		// 	}
 		// })
 
+		redactor.setAccountID(meta.AccountID)
+
 		providerMetas[testName] = meta
 
 		return meta, diags
@@ -426,10 +375,12 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 }
 
-// ParallelTest wraps resource.ParallelTest, initializing VCR if enabled.
+// ParallelTest wraps resource.ParallelTest, initializing VCR and the AWS emulator if enabled.
 func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
+	startEmulator(t)
+
 	if isVCREnabled() {
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(ctx, t)
@@ -438,10 +389,12 @@ func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	resource.ParallelTest(t, c)
 }
 
-// Test wraps resource.Test, initializing VCR if enabled.
+// Test wraps resource.Test, initializing VCR and the AWS emulator if enabled.
 func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
+	startEmulator(t)
+
 	if isVCREnabled() {
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(ctx, t)
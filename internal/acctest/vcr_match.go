@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// vcrVolatileRequestFields are request parameters whose values differ between recording and replay,
+// typically because they are generated by the AWS SDK or the provider for idempotency.
+// They are ignored when matching requests to recorded interactions.
+var vcrVolatileRequestFields = []string{
+	"CallerReference",
+	"ClientRequestToken",
+	"ClientToken",
+	"IdempotencyToken",
+	"clientRequestToken",
+	"clientToken",
+	"idempotencyToken",
+}
+
+func isVCRVolatileRequestField(name string) bool {
+	return slices.Contains(vcrVolatileRequestFields, name)
+}
+
+// vcrRequestBodiesMatch returns whether the request body `body` matches the recorded request body `cassetteBody`.
+// Bodies match if they are identical or if, after parsing according to the request's content type
+// and removing volatile fields, they are semantically equal.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func vcrRequestBodiesMatch(contentType, body, cassetteBody string) (bool, error) {
+	if body == cassetteBody {
+		return true, nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+
+	if err != nil {
+		return false, nil
+	}
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		return vcrJSONBodiesMatch(body, cassetteBody)
+	case "application/xml", "text/xml":
+		return vcrXMLBodiesMatch(body, cassetteBody)
+	case "application/x-www-form-urlencoded":
+		return vcrFormBodiesMatch(body, cassetteBody)
+	}
+
+	return false, nil
+}
+
+// vcrJSONBodiesMatch compares JSON documents, ignoring object key order and volatile fields.
+func vcrJSONBodiesMatch(body, cassetteBody string) (bool, error) {
+	var requestJSON, cassetteJSON any
+
+	if err := json.Unmarshal([]byte(body), &requestJSON); err != nil {
+		return false, err
+	}
+
+	if err := json.Unmarshal([]byte(cassetteBody), &cassetteJSON); err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(removeVCRVolatileJSONFields(requestJSON), removeVCRVolatileJSONFields(cassetteJSON)), nil
+}
+
+func removeVCRVolatileJSONFields(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if isVCRVolatileRequestField(k) {
+				delete(v, k)
+			} else {
+				v[k] = removeVCRVolatileJSONFields(e)
+			}
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = removeVCRVolatileJSONFields(e)
+		}
+	}
+
+	return v
+}
+
+// vcrFormBodiesMatch compares AWS Query protocol request bodies, ignoring parameter order and volatile parameters.
+// Query protocol parameter names are flattened paths, e.g. "Tags.member.1.Key", so a parameter is volatile
+// if the last element of its path is a volatile field name.
+func vcrFormBodiesMatch(body, cassetteBody string) (bool, error) {
+	requestForm, err := url.ParseQuery(body)
+
+	if err != nil {
+		return false, err
+	}
+
+	cassetteForm, err := url.ParseQuery(cassetteBody)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, form := range []url.Values{requestForm, cassetteForm} {
+		for k := range form {
+			if isVCRVolatileRequestField(k[strings.LastIndex(k, ".")+1:]) {
+				delete(form, k)
+			}
+		}
+	}
+
+	return reflect.DeepEqual(requestForm, cassetteForm), nil
+}
+
+// vcrXMLNode is a canonical representation of an XML element.
+type vcrXMLNode struct {
+	Name     xml.Name
+	Attrs    []xml.Attr
+	Text     string
+	Children []*vcrXMLNode
+}
+
+// vcrXMLBodiesMatch compares XML documents, ignoring insignificant whitespace, attribute order and volatile elements.
+// Element order is significant as XML lists are ordered.
+func vcrXMLBodiesMatch(body, cassetteBody string) (bool, error) {
+	requestXML, err := parseVCRXML(body)
+
+	if err != nil {
+		return false, err
+	}
+
+	cassetteXML, err := parseVCRXML(cassetteBody)
+
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(requestXML, cassetteXML), nil
+}
+
+func parseVCRXML(s string) (*vcrXMLNode, error) {
+	root := &vcrXMLNode{}
+	stack := []*vcrXMLNode{root}
+	// Depth of the volatile element currently being skipped, if any.
+	skip := 0
+
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if skip > 0 || isVCRVolatileRequestField(token.Name.Local) {
+				skip++
+				continue
+			}
+
+			node := &vcrXMLNode{
+				Name:  token.Name,
+				Attrs: slices.Clone(token.Attr),
+			}
+			slices.SortFunc(node.Attrs, func(a, b xml.Attr) int {
+				return strings.Compare(a.Name.Space+":"+a.Name.Local, b.Name.Space+":"+b.Name.Local)
+			})
+
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if skip > 0 {
+				continue
+			}
+
+			stack[len(stack)-1].Text += string(bytes.TrimSpace(token))
+		}
+	}
+
+	return root, nil
+}
+
+// vcrRequestMatcher returns a VCR matcher that matches requests to recorded interactions on method, URL, operation and body.
+// Requests are redacted in the same way as recorded interactions before being compared.
+func vcrRequestMatcher(ctx context.Context, redactor *vcrRedactor) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method || redactor.redact(r.URL.String()) != i.URL {
+			return false
+		}
+
+		// JSON protocols identify the operation via a header rather than the URL.
+		if r.Header.Get("X-Amz-Target") != i.Headers.Get("X-Amz-Target") {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+
+		match, err := vcrRequestBodiesMatch(r.Header.Get("Content-Type"), redactor.redact(b.String()), i.Body)
+
+		if err != nil {
+			tflog.Debug(ctx, "Failed to compare request body with cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		return match
+	}
+}
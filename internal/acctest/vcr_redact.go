@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	envVarVCRRedact         = "VCR_REDACT"
+	envVarVCRRedactPatterns = "VCR_REDACT_PATTERNS"
+)
+
+// Built-in redaction categories, selected via VCR_REDACT.
+const (
+	vcrRedactAccessKeys    = "access_keys"
+	vcrRedactAccountIDs    = "account_ids"
+	vcrRedactSessionTokens = "session_tokens"
+
+	vcrRedactNone = "none"
+)
+
+const (
+	vcrRedactedAccountID = "123456789012"
+	vcrRedactedSecret    = "REDACTED"
+
+	vcrRedactedAccessKeyIDReplacement = "${1}IOSFODNN7EXAMPLE"
+	vcrRedactedValueReplacement       = "${1}" + vcrRedactedSecret + "${2}"
+)
+
+var (
+	// Access key IDs keep their prefix, which identifies long-term (AKIA) vs temporary (ASIA) credentials.
+	vcrAccessKeyIDRegexp         = regexache.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)
+	vcrSecretAccessKeyJSONRegexp = regexache.MustCompile(`("(?i:SecretAccessKey)"\s*:\s*")[^"]*(")`)
+	vcrSecretAccessKeyXMLRegexp  = regexache.MustCompile(`(<SecretAccessKey>)[^<]*(</SecretAccessKey>)`)
+	vcrSessionTokenJSONRegexp    = regexache.MustCompile(`("(?i:SessionToken)"\s*:\s*")[^"]*(")`)
+	vcrSessionTokenXMLRegexp     = regexache.MustCompile(`(<SessionToken>)[^<]*(</SessionToken>)`)
+)
+
+type vcrRedaction struct {
+	regexp      *regexp.Regexp
+	replacement string
+}
+
+// vcrRedactor removes sensitive values from recorded interactions.
+// Redaction is applied when a cassette is saved, so recorded requests and responses never contain the
+// redacted values, and to requests being matched against a cassette, so that replays match recordings.
+type vcrRedactor struct {
+	accountIDs  bool
+	redactions  []vcrRedaction
+	mu          sync.RWMutex
+	accountIDRe *regexp.Regexp
+}
+
+// newVCRRedactor returns a redactor configured from the VCR_REDACT and VCR_REDACT_PATTERNS environment variables.
+//
+// VCR_REDACT is a comma-separated list of built-in redaction categories (access_keys, account_ids, session_tokens).
+// All categories are enabled if it is not set. The value "none" disables built-in redaction.
+//
+// VCR_REDACT_PATTERNS is a JSON object mapping regular expressions to their replacements.
+// Replacements may reference capture groups, e.g. {"(arn:aws:kms:[^:]+:[^:]+:key/)[0-9a-f-]+": "${1}REDACTED"}.
+func newVCRRedactor() (*vcrRedactor, error) {
+	categories := []string{vcrRedactAccessKeys, vcrRedactAccountIDs, vcrRedactSessionTokens}
+
+	if v, ok := os.LookupEnv(envVarVCRRedact); ok {
+		categories = nil
+
+		for _, v := range strings.Split(v, ",") {
+			switch v := strings.TrimSpace(v); v {
+			case "":
+			case vcrRedactNone:
+				categories = nil
+			case vcrRedactAccessKeys, vcrRedactAccountIDs, vcrRedactSessionTokens:
+				categories = append(categories, v)
+			default:
+				return nil, fmt.Errorf("unsupported value for %s: %s", envVarVCRRedact, v)
+			}
+		}
+	}
+
+	r := &vcrRedactor{}
+
+	if slices.Contains(categories, vcrRedactAccessKeys) {
+		r.redactions = append(r.redactions,
+			vcrRedaction{regexp: vcrAccessKeyIDRegexp, replacement: vcrRedactedAccessKeyIDReplacement},
+			vcrRedaction{regexp: vcrSecretAccessKeyJSONRegexp, replacement: vcrRedactedValueReplacement},
+			vcrRedaction{regexp: vcrSecretAccessKeyXMLRegexp, replacement: vcrRedactedValueReplacement},
+		)
+	}
+
+	if slices.Contains(categories, vcrRedactSessionTokens) {
+		r.redactions = append(r.redactions,
+			vcrRedaction{regexp: vcrSessionTokenJSONRegexp, replacement: vcrRedactedValueReplacement},
+			vcrRedaction{regexp: vcrSessionTokenXMLRegexp, replacement: vcrRedactedValueReplacement},
+		)
+	}
+
+	r.accountIDs = slices.Contains(categories, vcrRedactAccountIDs)
+
+	if v := os.Getenv(envVarVCRRedactPatterns); v != "" {
+		var patterns map[string]string
+
+		if err := json.Unmarshal([]byte(v), &patterns); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", envVarVCRRedactPatterns, err)
+		}
+
+		// Apply custom patterns in a deterministic order.
+		keys := make([]string, 0, len(patterns))
+		for k := range patterns {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			re, err := regexp.Compile(k)
+
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s: %w", envVarVCRRedactPatterns, err)
+			}
+
+			r.redactions = append(r.redactions, vcrRedaction{regexp: re, replacement: patterns[k]})
+		}
+	}
+
+	return r, nil
+}
+
+// setAccountID sets the AWS account ID to be redacted.
+// The account ID is only known once the provider has been configured.
+func (r *vcrRedactor) setAccountID(accountID string) {
+	if !r.accountIDs || accountID == "" || accountID == vcrRedactedAccountID {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.accountIDRe = regexache.MustCompile(`\b` + regexp.QuoteMeta(accountID) + `\b`)
+}
+
+// redact returns `s` with all sensitive values replaced.
+func (r *vcrRedactor) redact(s string) string {
+	if s == "" {
+		return s
+	}
+
+	r.mu.RLock()
+	accountIDRe := r.accountIDRe
+	r.mu.RUnlock()
+
+	if accountIDRe != nil {
+		s = accountIDRe.ReplaceAllLiteralString(s, vcrRedactedAccountID)
+	}
+
+	for _, v := range r.redactions {
+		s = v.regexp.ReplaceAllString(s, v.replacement)
+	}
+
+	return s
+}
+
+// redactInteraction redacts sensitive values from a recorded request and response.
+func (r *vcrRedactor) redactInteraction(i *cassette.Interaction) {
+	i.Request.Body = r.redact(i.Request.Body)
+	i.Request.URL = r.redact(i.Request.URL)
+	i.Request.Host = r.redact(i.Request.Host)
+	for _, v := range i.Request.Form {
+		for n := range v {
+			v[n] = r.redact(v[n])
+		}
+	}
+	for _, v := range i.Request.Headers {
+		for n := range v {
+			v[n] = r.redact(v[n])
+		}
+	}
+
+	i.Response.Body = r.redact(i.Response.Body)
+	for _, v := range i.Response.Headers {
+		for n := range v {
+			v[n] = r.redact(v[n])
+		}
+	}
+}
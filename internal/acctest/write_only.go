@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// WriteOnlyAttributeTerraformVersionChecks skips tests on Terraform versions without write-only attribute support.
+var WriteOnlyAttributeTerraformVersionChecks = []tfversion.TerraformVersionCheck{
+	tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// validateAssumeRoleChain returns an error if the specified chain of IAM Roles is invalid.
+// A single IAM Role without an ARN is permitted, and means that no role is assumed.
+func validateAssumeRoleChain(chain []*awsbase.AssumeRole) error {
+	if len(chain) <= 1 {
+		return nil
+	}
+
+	for i, v := range chain {
+		if v == nil || v.RoleARN == "" {
+			return fmt.Errorf("assume_role[%d]: role_arn must be set when more than one role is assumed", i)
+		}
+	}
+
+	return nil
+}
+
+// assumeRoleChainCredentialsProvider returns a credentials provider that assumes each of the specified IAM Roles in turn,
+// using the specified configuration's credentials to assume the first and each role's credentials to assume the next.
+// Each role is assumed immediately so that configuration errors are reported when the provider is configured.
+func assumeRoleChainCredentialsProvider(ctx context.Context, cfg aws_sdkv2.Config, stsRegion, stsEndpoint string, chain []*awsbase.AssumeRole) (aws_sdkv2.CredentialsProvider, error) {
+	credentials := cfg.Credentials
+
+	for _, ar := range chain {
+		tflog.Info(ctx, "Assuming IAM Role", map[string]any{
+			"tf_aws.assume_role.role_arn":        ar.RoleARN,
+			"tf_aws.assume_role.session_name":    ar.SessionName,
+			"tf_aws.assume_role.external_id":     ar.ExternalID,
+			"tf_aws.assume_role.source_identity": ar.SourceIdentity,
+		})
+
+		cfg.Credentials = credentials
+		client := sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+			if stsRegion != "" {
+				o.Region = stsRegion
+			}
+			if stsEndpoint != "" {
+				o.BaseEndpoint = aws_sdkv2.String(stsEndpoint)
+			}
+		})
+
+		credentials = aws_sdkv2.NewCredentialsCache(stscreds_sdkv2.NewAssumeRoleProvider(client, ar.RoleARN, assumeRoleOptions(ar)))
+
+		if _, err := credentials.Retrieve(ctx); err != nil {
+			return nil, fmt.Errorf("assuming IAM Role (%s): %w", ar.RoleARN, err)
+		}
+	}
+
+	return credentials, nil
+}
+
+func assumeRoleOptions(ar *awsbase.AssumeRole) func(*stscreds_sdkv2.AssumeRoleOptions) {
+	return func(o *stscreds_sdkv2.AssumeRoleOptions) {
+		o.RoleSessionName = ar.SessionName
+		o.Duration = ar.Duration
+
+		if ar.ExternalID != "" {
+			o.ExternalID = aws_sdkv2.String(ar.ExternalID)
+		}
+
+		if ar.Policy != "" {
+			o.Policy = aws_sdkv2.String(ar.Policy)
+		}
+
+		for _, v := range ar.PolicyARNs {
+			o.PolicyARNs = append(o.PolicyARNs, ststypes_sdkv2.PolicyDescriptorType{Arn: aws_sdkv2.String(v)})
+		}
+
+		if ar.SourceIdentity != "" {
+			o.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+		}
+
+		keys := make([]string, 0, len(ar.Tags))
+		for k := range ar.Tags {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			o.Tags = append(o.Tags, ststypes_sdkv2.Tag{Key: aws_sdkv2.String(k), Value: aws_sdkv2.String(ar.Tags[k])})
+		}
+
+		o.TransitiveTagKeys = ar.TransitiveTagKeys
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+func TestValidateAssumeRoleChain(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		chain         []*awsbase.AssumeRole
+		expectedError bool
+	}{
+		{
+			name: "no roles",
+		},
+		{
+			name:  "single empty role",
+			chain: []*awsbase.AssumeRole{{}},
+		},
+		{
+			name: "chain",
+			chain: []*awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/hub"},
+				{RoleARN: "arn:aws:iam::222222222222:role/spoke"},
+			},
+		},
+		{
+			name: "chain with missing role ARN",
+			chain: []*awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/hub"},
+				{SessionName: "spoke"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "chain with nil role",
+			chain: []*awsbase.AssumeRole{
+				nil,
+				{RoleARN: "arn:aws:iam::222222222222:role/spoke"},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateAssumeRoleChain(testCase.chain)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Errorf("got error %t, expected error %t (%v)", got, want, err)
+			}
+		})
+	}
+}
+
+func TestAssumeRoleOptions(t *testing.T) {
+	t.Parallel()
+
+	ar := &awsbase.AssumeRole{
+		RoleARN:           "arn:aws:iam::222222222222:role/spoke",
+		Duration:          time.Hour,
+		ExternalID:        "external-id",
+		SessionName:       "session",
+		Tags:              map[string]string{"b": "2", "a": "1"},
+		TransitiveTagKeys: []string{"a"},
+	}
+
+	var got stscreds.AssumeRoleOptions
+	assumeRoleOptions(ar)(&got)
+
+	if got, want := got.RoleSessionName, "session"; got != want {
+		t.Errorf("RoleSessionName = %q, want %q", got, want)
+	}
+	if got, want := got.Duration, time.Hour; got != want {
+		t.Errorf("Duration = %s, want %s", got, want)
+	}
+	if got, want := aws.ToString(got.ExternalID), "external-id"; got != want {
+		t.Errorf("ExternalID = %q, want %q", got, want)
+	}
+	if got.Policy != nil || got.SourceIdentity != nil || len(got.PolicyARNs) != 0 {
+		t.Errorf("unexpected options: %+v", got)
+	}
+
+	var gotTags []string
+	for _, v := range got.Tags {
+		gotTags = append(gotTags, aws.ToString(v.Key)+"="+aws.ToString(v.Value))
+	}
+	if diff := cmp.Diff(gotTags, []string{"a=1", "b=2"}); diff != "" {
+		t.Errorf("unexpected Tags diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(got.TransitiveTagKeys, []string{"a"}, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("unexpected TransitiveTagKeys diff (+wanted, -got): %s", diff)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	rds_sdkv1 "github.com/aws/aws-sdk-go/service/rds"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -33,22 +34,33 @@ type AWSClient struct {
 	DefaultTagsConfig *tftags.DefaultConfig
 	IgnoreTagsConfig  *tftags.IgnoreConfig
 	Partition         string
-	Region            string
+	ProtectConfig     *tftags.ProtectConfig
 	ServicePackages   map[string]ServicePackage
-
-	awsConfig                 *aws_sdkv2.Config
-	clients                   map[string]any
-	conns                     map[string]any
-	dnsSuffix                 string
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	TagPolicyConfig   *tftags.PolicyConfig
+
+	allowedRegions                 []string // From provider configuration.
+	awsConfig                      *aws_sdkv2.Config
+	clients                        map[string]any
+	conns                          map[string]any
+	dnsSuffix                      string
+	endpoints                      map[string]string // From provider configuration.
+	forbiddenRegions               []string          // From provider configuration.
+	httpClient                     *http.Client
+	iamPermissionPreflight         *IAMPermissionPreflightConfig // From provider configuration.
+	iamPermissionPreflightCache    iamPermissionPreflightCache
+	iamPermissionPreflightLock     sync.Mutex
+	iamPolicyValidation            string // From provider configuration.
+	lock                           sync.Mutex
+	logger                         baselogging.Logger
+	rateLimiters                   map[string]*tfsync.Limiter // From provider configuration.
+	region                         string                     // From provider configuration.
+	retryConfigs                   map[string]*RetryConfig    // From provider configuration.
+	session                        *session_sdkv1.Session
+	s3ExpressClient                *s3_sdkv2.Client
+	s3UsePathStyle                 bool   // From provider configuration.
+	s3USEast1RegionalEndpoint      string // From provider configuration.
+	stsRegion                      string // From provider configuration.
+	tokenBucketRateLimiterCapacity int    // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -67,30 +79,39 @@ func (c *AWSClient) AwsConfig(context.Context) aws_sdkv2.Config { // nosemgrep:c
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) DSConnForRegion(ctx context.Context, region string) *directoryservice_sdkv1.DirectoryService {
-	if region == c.Region {
+	if region == c.Region(ctx) {
 		return c.DSConn(ctx)
 	}
-	return directoryservice_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
+	return directoryservice_sdkv1.New(c.sessionForService(names.DS), aws_sdkv1.NewConfig().WithRegion(region))
 }
 
 // EFSConnForRegion returns an AWS SDK For Go v1 EFS API client for the specified AWS Region.
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) EFSConnForRegion(ctx context.Context, region string) *efs_sdkv1.EFS {
-	if region == c.Region {
+	if region == c.Region(ctx) {
 		return c.EFSConn(ctx)
 	}
-	return efs_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
+	return efs_sdkv1.New(c.sessionForService(names.EFS), aws_sdkv1.NewConfig().WithRegion(region))
 }
 
 // OpsWorksConnForRegion returns an AWS SDK For Go v1 OpsWorks API client for the specified AWS Region.
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) OpsWorksConnForRegion(ctx context.Context, region string) *opsworks_sdkv1.OpsWorks {
-	if region == c.Region {
+	if region == c.Region(ctx) {
 		return c.OpsWorksConn(ctx)
 	}
-	return opsworks_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
+	return opsworks_sdkv1.New(c.sessionForService(names.OpsWorks), aws_sdkv1.NewConfig().WithRegion(region))
+}
+
+// Region returns the AWS Region for the resource or data source in Context.
+// This is the resource-level `region` override if set, otherwise the provider's configured Region.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok && inContext.OverrideRegion != "" {
+		return inContext.OverrideRegion
+	}
+	return c.region
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -104,17 +125,17 @@ func (c *AWSClient) PartitionHostname(ctx context.Context, prefix string) string
 // e.g. PREFIX.us-west-2.amazonaws.com
 // The prefix should not contain a trailing period.
 func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string {
-	return fmt.Sprintf("%s.%s.%s", prefix, c.Region, c.DNSSuffix(ctx))
+	return fmt.Sprintf("%s.%s.%s", prefix, c.Region(ctx), c.DNSSuffix(ctx))
 }
 
 // RDSConnForRegion returns an AWS SDK For Go v1 RDS API client for the specified AWS Region.
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) RDSConnForRegion(ctx context.Context, region string) *rds_sdkv1.RDS {
-	if region == c.Region {
+	if region == c.Region(ctx) {
 		return c.RDSConn(ctx)
 	}
-	return rds_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
+	return rds_sdkv1.New(c.sessionForService(names.RDS), aws_sdkv1.NewConfig().WithRegion(region))
 }
 
 // S3ExpressClient returns an AWS SDK for Go v2 S3 API client suitable for use with S3 Express (directory buckets).
@@ -224,14 +245,19 @@ func (c *AWSClient) DNSSuffix(context.Context) string {
 	return c.dnsSuffix
 }
 
+// IAMPolicyValidation returns the configured mode of plan-time IAM policy validation.
+func (c *AWSClient) IAMPolicyValidation(context.Context) string {
+	return c.iamPolicyValidation
+}
+
 // ReverseDNSPrefix returns the reverse DNS prefix for the configured AWS partition.
 func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 	return names.ReverseDNS(c.DNSSuffix(ctx))
 }
 
 // EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPrivateDNSSuffix(ctx context.Context) string {
+	region := c.Region(ctx)
 	if region == names.USEast1RegionID {
 		return "ec2.internal"
 	}
@@ -240,8 +266,8 @@ func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
 }
 
 // EC2RegionalPublicDNSSuffix returns the EC2 public DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPublicDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPublicDNSSuffix(ctx context.Context) string {
+	region := c.Region(ctx)
 	if region == names.USEast1RegionID {
 		return "compute-1"
 	}
@@ -271,6 +297,21 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition,
 		"session":          c.session,
 	}
+	// Resource-level region override. Clients are configured for the overriding region.
+	if region := c.Region(ctx); region != c.region {
+		cfg := c.awsConfig.Copy()
+		cfg.Region = region
+		m["aws_sdkv2_config"] = &cfg
+		m["session"] = c.session.Copy(aws_sdkv1.NewConfig().WithRegion(region))
+	}
+	// Per-service retry policy.
+	if v, ok := c.retryConfigs[servicePackageName]; ok {
+		m["aws_sdkv2_config"], m["session"] = c.withRetryConfig(m["aws_sdkv2_config"].(*aws_sdkv2.Config), m["session"].(*session_sdkv1.Session), v)
+	}
+	// Client-side rate limiting.
+	if limiter, ok := c.rateLimiters[servicePackageName]; ok {
+		m["aws_sdkv2_config"], m["session"] = withRateLimiter(m["aws_sdkv2_config"].(*aws_sdkv2.Config), m["session"].(*session_sdkv1.Session), limiter)
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -287,6 +328,21 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
+// sessionForService returns the AWS SDK for Go v1 session for the specified service, applying any configured retry policy and rate limit.
+// Use for clients that are not constructed via the service package, e.g. "simple" clients for a non-default region.
+func (c *AWSClient) sessionForService(servicePackageName string) *session_sdkv1.Session {
+	session := c.session
+
+	if v, ok := c.retryConfigs[servicePackageName]; ok {
+		_, session = c.withRetryConfig(nil, session, v)
+	}
+	if limiter, ok := c.rateLimiters[servicePackageName]; ok {
+		_, session = withRateLimiter(nil, session, limiter)
+	}
+
+	return session
+}
+
 func (c *AWSClient) resolveEndpoint(ctx context.Context, servicePackageName string) string {
 	endpoint := c.endpoints[servicePackageName]
 	if endpoint != "" {
@@ -340,17 +396,27 @@ func resolveServiceBaseEndpoint(ctx context.Context, sdkID string, configs []any
 	return
 }
 
+// clientCacheKey returns the key under which API clients for the specified service are cached.
+// Clients for an overriding region are cached separately to those for the provider's configured Region.
+func (c *AWSClient) clientCacheKey(ctx context.Context, servicePackageName string) string {
+	if region := c.Region(ctx); region != c.region {
+		return servicePackageName + "@" + region
+	}
+	return servicePackageName
+}
+
 // conn returns the AWS SDK for Go v1 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached per-region. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	isDefault := len(extra) == 0
+	cacheKey := c.clientCacheKey(ctx, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.conns[servicePackageName]; ok {
+		if raw, ok := c.conns[cacheKey]; ok {
 			if conn, ok := raw.(T); ok {
 				return conn, nil
 			} else {
@@ -394,23 +460,24 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 
 	// Default service client is cached.
 	if isDefault {
-		c.conns[servicePackageName] = conn
+		c.conns[cacheKey] = conn
 	}
 
 	return conn, nil
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached per-region. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	isDefault := len(extra) == 0
+	cacheKey := c.clientCacheKey(ctx, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[cacheKey]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -445,7 +512,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[cacheKey] = client
 	}
 
 	return client, nil
@@ -64,7 +64,7 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 			Name: "AWS Commercial",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-west-2", //lintignore:AWSAT003
+				region:    "us-west-2", //lintignore:AWSAT003
 			},
 			Prefix:   "test",
 			Expected: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
@@ -73,7 +73,7 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 			Name: "AWS China",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com.cn",
-				Region:    "cn-northwest-1", //lintignore:AWSAT003
+				region:    "cn-northwest-1", //lintignore:AWSAT003
 			},
 			Prefix:   "test",
 			Expected: "test.cn-northwest-1.amazonaws.com.cn", //lintignore:AWSAT003
@@ -108,7 +108,7 @@ func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-
 			Name: "us-west-2",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-west-2", //lintignore:AWSAT003
+				region:    "us-west-2", //lintignore:AWSAT003
 			},
 			IP:       "10.20.30.40",
 			Expected: "ip-10-20-30-40.us-west-2.compute.internal", //lintignore:AWSAT003
@@ -117,7 +117,7 @@ func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-
 			Name: "us-east-1",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-east-1", //lintignore:AWSAT003
+				region:    "us-east-1", //lintignore:AWSAT003
 			},
 			IP:       "10.20.30.40",
 			Expected: "ip-10-20-30-40.ec2.internal",
@@ -152,7 +152,7 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 			Name: "us-west-2",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-west-2", //lintignore:AWSAT003
+				region:    "us-west-2", //lintignore:AWSAT003
 			},
 			IP:       "10.20.30.40",
 			Expected: "ec2-10-20-30-40.us-west-2.compute.amazonaws.com", //lintignore:AWSAT003
@@ -161,7 +161,7 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 			Name: "us-east-1",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-east-1", //lintignore:AWSAT003
+				region:    "us-east-1", //lintignore:AWSAT003
 			},
 			IP:       "10.20.30.40",
 			Expected: "ec2-10-20-30-40.compute-1.amazonaws.com",
@@ -181,3 +181,48 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		AWSClient      *AWSClient
+		OverrideRegion string
+		Expected       string
+	}{
+		{
+			Name: "no override",
+			AWSClient: &AWSClient{
+				region: "us-west-2", //lintignore:AWSAT003
+			},
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "override",
+			AWSClient: &AWSClient{
+				region: "us-west-2", //lintignore:AWSAT003
+			},
+			OverrideRegion: "eu-west-1",
+			Expected:       "eu-west-1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(context.TODO(), "test", "Test")
+			if v, ok := FromContext(ctx); ok {
+				v.OverrideRegion = testCase.OverrideRegion
+			}
+
+			got := testCase.AWSClient.Region(ctx)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
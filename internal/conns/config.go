@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -29,7 +30,8 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AllowedRegions                 []string
+	AssumeRole                     []*awsbase.AssumeRole // Assumed in order, each using the previous role's credentials.
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
@@ -38,13 +40,19 @@ type Config struct {
 	EC2MetadataServiceEndpointMode string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
+	ForbiddenRegions               []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
+	IAMPermissionPreflight         *IAMPermissionPreflightConfig
+	IAMPolicyValidation            string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	ProtectConfig                  *tftags.ProtectConfig
+	RateLimits                     map[string]*RateLimitConfig
+	RetryConfigs                   map[string]*RetryConfig
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
@@ -57,22 +65,25 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
+	Tracing                        *tracing.Config
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
 }
 
+const (
+	maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
+)
+
 // ConfigureProvider configures the provided provider Meta (instance data).
 func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWSClient, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
-	const (
-		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
-	)
 	awsbaseConfig := awsbase.Config{
 		AccessKey:         c.AccessKey,
 		AllowedAccountIds: c.AllowedAccountIds,
@@ -114,8 +125,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	if err := validateAssumeRoleChain(c.AssumeRole); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// The first IAM Role is assumed using the base credentials. Any further roles are assumed in turn below.
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0] != nil && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -135,6 +151,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.SharedCredentialsFiles = c.SharedCredentialsFiles
 	}
 
+	if c.Tracing != nil {
+		if err := tracing.Configure(ctx, c.Tracing); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "configuring tracing: %s", err)
+		}
+	}
+
 	if c.STSRegion != "" {
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
@@ -159,6 +181,18 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if len(c.AssumeRole) > 1 {
+		credentials, err := assumeRoleChainCredentialsProvider(ctx, cfg, c.STSRegion, c.Endpoints[names.STS], c.AssumeRole[1:])
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "Cannot assume IAM Role: %s", err)
+		}
+		cfg.Credentials = credentials
+	}
+
+	if c.Tracing != nil {
+		tracing.AppendSDKv2Middlewares(&cfg)
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -166,6 +200,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if err := verifyRegionAllowed(c.Region, c.AllowedRegions, c.ForbiddenRegions); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -183,6 +221,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if c.Tracing != nil {
+		tracing.AddSDKv1Handlers(&session.Handlers)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -201,7 +243,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
 	if err != nil {
-		return nil, sdkdiag.AppendErrorf(diags, err.Error())
+		return nil, sdkdiag.AppendFromErr(diags, err)
 	}
 
 	dnsSuffix := "amazonaws.com"
@@ -214,19 +256,28 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.dnsSuffix = dnsSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
-	client.Region = c.Region
+	client.ProtectConfig = c.ProtectConfig
+	client.region = c.Region
+	client.TagPolicyConfig = c.TagPolicyConfig
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
 	// Used for lazy-loading AWS API clients.
+	client.allowedRegions = c.AllowedRegions
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
+	client.forbiddenRegions = c.ForbiddenRegions
+	client.iamPermissionPreflight = c.IAMPermissionPreflight
+	client.iamPolicyValidation = c.IAMPolicyValidation
 	client.logger = logger
+	client.rateLimiters = newRateLimiters(c.RateLimits)
+	client.retryConfigs = c.RetryConfigs
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
+	client.tokenBucketRateLimiterCapacity = c.TokenBucketRateLimiterCapacity
 
 	return client, diags
 }
@@ -250,3 +301,18 @@ func NormalizeS3USEast1RegionalEndpoint(v string) string {
 		return ""
 	}
 }
+
+// Valid values for the provider's `iam_policy_validation` argument.
+const (
+	IAMPolicyValidationOff   = "off"
+	IAMPolicyValidationWarn  = "warn"
+	IAMPolicyValidationError = "error"
+)
+
+func IAMPolicyValidationValues() []string {
+	return []string{
+		IAMPolicyValidationOff,
+		IAMPolicyValidationWarn,
+		IAMPolicyValidationError,
+	}
+}
@@ -19,6 +19,20 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithEphemeralResources is an optional interface exported from AWS service packages
+// that implement Plugin Framework ephemeral resources.
+type ServicePackageWithEphemeralResources interface {
+	ServicePackage
+	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
+}
+
+// ServicePackageWithSDKListResources is an optional interface exported from AWS service packages
+// that implement Plugin Framework list resources for Plugin SDK resources.
+type ServicePackageWithSDKListResources interface {
+	ServicePackage
+	SDKListResources(context.Context) []*types.ServicePackageSDKListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -29,9 +43,11 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
-	IsDataSource       bool   // Data source?
-	ResourceName       string // Friendly resource name, e.g. "Subnet"
-	ServicePackageName string // Canonical name defined as a constant in names package
+	IsDataSource        bool   // Data source?
+	IsEphemeralResource bool   // Ephemeral resource?
+	OverrideRegion      string // Resource-level AWS Region override, e.g. "us-west-2"
+	ResourceName        string // Friendly resource name, e.g. "Subnet"
+	ServicePackageName  string // Canonical name defined as a constant in names package
 }
 
 func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
@@ -53,6 +69,16 @@ func NewResourceContext(ctx context.Context, servicePackageName, resourceName st
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+	v := InContext{
+		IsEphemeralResource: true,
+		ResourceName:        resourceName,
+		ServicePackageName:  servicePackageName,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
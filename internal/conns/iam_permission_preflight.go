@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	arn_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/arn"
+	iam_sdkv2 "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// Valid values for the provider's `iam_permission_preflight` `enforcement` argument.
+const (
+	IAMPermissionPreflightEnforcementError = "error"
+	IAMPermissionPreflightEnforcementWarn  = "warn"
+)
+
+func IAMPermissionPreflightEnforcementValues() []string {
+	return []string{
+		IAMPermissionPreflightEnforcementError,
+		IAMPermissionPreflightEnforcementWarn,
+	}
+}
+
+// IAMPermissionPreflightConfig is the provider's plan-time IAM permission preflight configuration.
+type IAMPermissionPreflightConfig struct {
+	Enforcement  string // IAMPermissionPreflightEnforcementError (the default) or IAMPermissionPreflightEnforcementWarn
+	PrincipalARN string // If empty, the IAM principal is derived from the caller identity
+}
+
+// IsWarning returns whether missing permissions are warnings rather than errors.
+func (c *IAMPermissionPreflightConfig) IsWarning() bool {
+	return c != nil && c.Enforcement == IAMPermissionPreflightEnforcementWarn
+}
+
+// iamPermissionPreflightCache caches the IAM principal and policy simulation results for the life of the provider.
+type iamPermissionPreflightCache struct {
+	principalARN string
+	decisions    map[iamPermissionPreflightKey]iamtypes_sdkv2.PolicyEvaluationDecisionType
+}
+
+// iamPermissionPreflightKey identifies a simulated IAM action.
+type iamPermissionPreflightKey struct {
+	action      string
+	resourceARN string // Empty if the action was simulated against all resources
+}
+
+// IAMPermissionPreflight returns the configured plan-time IAM permission preflight, if any.
+func (c *AWSClient) IAMPermissionPreflight(context.Context) *IAMPermissionPreflightConfig {
+	return c.iamPermissionPreflight
+}
+
+// MissingIAMActions returns those of the specified IAM actions that the provider's IAM principal is not allowed to perform on the specified resource.
+// Actions are evaluated using iam:SimulatePrincipalPolicy, and each action is only simulated once per resource.
+// If the resource's ARN is not known the actions are simulated against all resources ("*"). Actions that are then only implicitly denied,
+// e.g. because the principal's policies allow them on specific resources, are returned as possibly missing.
+func (c *AWSClient) MissingIAMActions(ctx context.Context, actions []string, resourceARN string) ([]string, []string, error) {
+	c.iamPermissionPreflightLock.Lock()
+	defer c.iamPermissionPreflightLock.Unlock()
+
+	cache := &c.iamPermissionPreflightCache
+
+	if cache.principalARN == "" {
+		principalARN, err := c.iamPermissionPreflightPrincipalARN(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		cache.principalARN = principalARN
+		cache.decisions = make(map[iamPermissionPreflightKey]iamtypes_sdkv2.PolicyEvaluationDecisionType)
+	}
+
+	var unknown []string
+	for _, action := range actions {
+		if _, ok := cache.decisions[iamPermissionPreflightKey{action, resourceARN}]; !ok && !slices.Contains(unknown, action) {
+			unknown = append(unknown, action)
+		}
+	}
+
+	if len(unknown) > 0 {
+		input := &iam_sdkv2.SimulatePrincipalPolicyInput{
+			ActionNames:     unknown,
+			PolicySourceArn: aws_sdkv2.String(cache.principalARN),
+		}
+		if resourceARN != "" {
+			input.ResourceArns = []string{resourceARN}
+		}
+
+		pages := iam_sdkv2.NewSimulatePrincipalPolicyPaginator(c.IAMClient(ctx), input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("simulating IAM Principal Policy (%s): %w", cache.principalARN, err)
+			}
+
+			for _, v := range page.EvaluationResults {
+				cache.decisions[iamPermissionPreflightKey{aws_sdkv2.ToString(v.EvalActionName), resourceARN}] = v.EvalDecision
+			}
+		}
+	}
+
+	missing, possiblyMissing := deniedIAMActions(actions, func(action string) iamtypes_sdkv2.PolicyEvaluationDecisionType {
+		return cache.decisions[iamPermissionPreflightKey{action, resourceARN}]
+	}, resourceARN != "")
+
+	return missing, possiblyMissing, nil
+}
+
+// deniedIAMActions returns the sorted, unique IAM actions that are missing and possibly missing given each action's simulated decision.
+// If the actions weren't simulated against a specific resource, implicitly denied actions are only possibly missing.
+func deniedIAMActions(actions []string, decision func(string) iamtypes_sdkv2.PolicyEvaluationDecisionType, resourceKnown bool) ([]string, []string) {
+	var missing, possiblyMissing []string
+
+	for _, action := range actions {
+		switch decision(action) {
+		case iamtypes_sdkv2.PolicyEvaluationDecisionTypeAllowed:
+		case iamtypes_sdkv2.PolicyEvaluationDecisionTypeImplicitDeny:
+			if !resourceKnown {
+				possiblyMissing = append(possiblyMissing, action)
+				break
+			}
+			fallthrough
+		default:
+			missing = append(missing, action)
+		}
+	}
+
+	slices.Sort(missing)
+	slices.Sort(possiblyMissing)
+
+	return slices.Compact(missing), slices.Compact(possiblyMissing)
+}
+
+func (c *AWSClient) iamPermissionPreflightPrincipalARN(ctx context.Context) (string, error) {
+	if v := c.iamPermissionPreflight; v != nil && v.PrincipalARN != "" {
+		return v.PrincipalARN, nil
+	}
+
+	output, err := c.STSClient(ctx).GetCallerIdentity(ctx, &sts_sdkv2.GetCallerIdentityInput{})
+
+	if err != nil {
+		return "", fmt.Errorf("reading STS Caller Identity: %w", err)
+	}
+
+	return iamPrincipalARNFromCallerARN(aws_sdkv2.ToString(output.Arn))
+}
+
+// iamPrincipalARNFromCallerARN returns the ARN of the IAM user or role for the specified caller identity ARN.
+// The role ARN for an assumed role session cannot include any role path, so `principal_arn` must be configured for roles with a path.
+func iamPrincipalARNFromCallerARN(callerARN string) (string, error) {
+	v, err := arn_sdkv2.Parse(callerARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	switch v.Service {
+	case "iam":
+		return callerARN, nil
+	case "sts":
+		if resource, ok := strings.CutPrefix(v.Resource, "assumed-role/"); ok {
+			if roleName, _, ok := strings.Cut(resource, "/"); ok {
+				return arn_sdkv2.ARN{
+					Partition: v.Partition,
+					Service:   "iam",
+					AccountID: v.AccountID,
+					Resource:  "role/" + roleName,
+				}.String(), nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("unsupported caller identity (%s), configure `principal_arn`", callerARN)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	iamtypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIAMPrincipalARNFromCallerARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		CallerARN     string
+		Expected      string
+		ExpectedError bool
+	}{
+		{
+			Name:      "IAM user",
+			CallerARN: "arn:aws:iam::123456789012:user/division/Alice",
+			Expected:  "arn:aws:iam::123456789012:user/division/Alice",
+		},
+		{
+			Name:      "assumed role",
+			CallerARN: "arn:aws:sts::123456789012:assumed-role/CI/session-1",
+			Expected:  "arn:aws:iam::123456789012:role/CI",
+		},
+		{
+			Name:      "assumed role other partition",
+			CallerARN: "arn:aws-us-gov:sts::123456789012:assumed-role/CI/session-1",
+			Expected:  "arn:aws-us-gov:iam::123456789012:role/CI",
+		},
+		{
+			Name:          "federated user",
+			CallerARN:     "arn:aws:sts::123456789012:federated-user/Bob",
+			ExpectedError: true,
+		},
+		{
+			Name:          "invalid ARN",
+			CallerARN:     "not-an-arn",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := iamPrincipalARNFromCallerARN(testCase.CallerARN)
+
+			if got, want := err != nil, testCase.ExpectedError; got != want {
+				t.Fatalf("got error %t, expected error %t (%v)", got, want, err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDeniedIAMActions(t *testing.T) {
+	t.Parallel()
+
+	decisions := map[string]iamtypes_sdkv2.PolicyEvaluationDecisionType{
+		"svc:Allowed":          iamtypes_sdkv2.PolicyEvaluationDecisionTypeAllowed,
+		"svc:ExplicitlyDenied": iamtypes_sdkv2.PolicyEvaluationDecisionTypeExplicitDeny,
+		"svc:ImplicitlyDenied": iamtypes_sdkv2.PolicyEvaluationDecisionTypeImplicitDeny,
+	}
+	decision := func(action string) iamtypes_sdkv2.PolicyEvaluationDecisionType {
+		return decisions[action]
+	}
+	actions := []string{"svc:ImplicitlyDenied", "svc:Allowed", "svc:NotSimulated", "svc:ExplicitlyDenied", "svc:ImplicitlyDenied"}
+
+	testCases := map[string]struct {
+		resourceKnown       bool
+		wantMissing         []string
+		wantPossiblyMissing []string
+	}{
+		"resource known": {
+			resourceKnown: true,
+			wantMissing:   []string{"svc:ExplicitlyDenied", "svc:ImplicitlyDenied", "svc:NotSimulated"},
+		},
+		"resource not known": {
+			wantMissing:         []string{"svc:ExplicitlyDenied", "svc:NotSimulated"},
+			wantPossiblyMissing: []string{"svc:ImplicitlyDenied"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotMissing, gotPossiblyMissing := deniedIAMActions(actions, decision, testCase.resourceKnown)
+
+			if diff := cmp.Diff(gotMissing, testCase.wantMissing); diff != "" {
+				t.Errorf("unexpected missing diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(gotPossiblyMissing, testCase.wantPossiblyMissing); diff != "" {
+				t.Errorf("unexpected possibly missing diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
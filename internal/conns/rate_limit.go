@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"slices"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+// RateLimitConfig is the client-side rate and concurrency limit for a service's API calls.
+type RateLimitConfig struct {
+	MaxInFlight       int     // Zero for no concurrency limit
+	RequestsPerSecond float64 // Zero for no rate limit
+}
+
+// newRateLimiters returns a Limiter for each configured service, keyed by service package name.
+// Limiters are shared by all of a service's API clients, including those for an overriding region.
+func newRateLimiters(rateLimits map[string]*RateLimitConfig) map[string]*tfsync.Limiter {
+	limiters := make(map[string]*tfsync.Limiter, len(rateLimits))
+
+	for servicePackageName, v := range rateLimits {
+		if v == nil || (v.MaxInFlight <= 0 && v.RequestsPerSecond <= 0) {
+			continue
+		}
+
+		limiters[servicePackageName] = tfsync.NewLimiter(v.RequestsPerSecond, v.MaxInFlight)
+	}
+
+	return limiters
+}
+
+// withRateLimiter returns copies of the specified AWS SDK for Go v2 configuration and v1 session that apply the limiter to each API call attempt.
+func withRateLimiter(cfg *aws_sdkv2.Config, session *session_sdkv1.Session, limiter *tfsync.Limiter) (*aws_sdkv2.Config, *session_sdkv1.Session) {
+	if cfg != nil {
+		v := cfg.Copy()
+		// Clone so as not to share the backing array with other services' configurations.
+		v.APIOptions = append(slices.Clone(v.APIOptions), rateLimitMiddleware(limiter))
+		cfg = &v
+	}
+
+	if session != nil {
+		session = session.Copy()
+		addRateLimitHandlers(&session.Handlers, limiter)
+	}
+
+	return cfg, session
+}
+
+// rateLimitMiddleware returns AWS SDK for Go v2 middleware that applies the limiter to each API call attempt.
+func rateLimitMiddleware(limiter *tfsync.Limiter) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Finalize middleware added after the Retry middleware is run for each attempt.
+		return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("ServiceRateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			if err := limiter.Wait(ctx); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+			defer limiter.Done()
+
+			return next.HandleFinalize(ctx, in)
+		}), middleware.After)
+	}
+}
+
+type rateLimitTokenKey struct{}
+
+// addRateLimitHandlers registers AWS SDK for Go v1 request handlers that apply the limiter to each API call attempt.
+func addRateLimitHandlers(handlers *request_sdkv1.Handlers, limiter *tfsync.Limiter) {
+	handlers.Send.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "conns.RateLimitWait",
+		Fn: func(r *request_sdkv1.Request) {
+			ctx := r.Context()
+
+			if err := limiter.Wait(ctx); err != nil {
+				r.Error = err
+				return
+			}
+
+			r.SetContext(context.WithValue(ctx, rateLimitTokenKey{}, true))
+		},
+	})
+	// Send handlers are all run, even if an earlier handler fails.
+	handlers.Send.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "conns.RateLimitDone",
+		Fn: func(r *request_sdkv1.Request) {
+			ctx := r.Context()
+
+			if v, ok := ctx.Value(rateLimitTokenKey{}).(bool); ok && v {
+				limiter.Done()
+				r.SetContext(context.WithValue(ctx, rateLimitTokenKey{}, false))
+			}
+		},
+	})
+}
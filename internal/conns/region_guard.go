@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// verifyRegionAllowed returns an error if the specified AWS Region is not allowed by the specified allow or deny lists.
+func verifyRegionAllowed(region string, allowedRegions, forbiddenRegions []string) error {
+	if len(allowedRegions) > 0 && !slices.Contains(allowedRegions, region) {
+		return fmt.Errorf("AWS Region not allowed: %s (allowed Regions: %s)", region, strings.Join(allowedRegions, ", "))
+	}
+
+	if slices.Contains(forbiddenRegions, region) {
+		return fmt.Errorf("AWS Region forbidden: %s", region)
+	}
+
+	return nil
+}
+
+// VerifyRegionAllowed returns an error if the specified AWS Region is not allowed by the provider's
+// `allowed_regions` or `forbidden_regions` configuration.
+// Resources and data sources that make API calls in a Region other than the provider's configured Region,
+// e.g. via the `region` argument or a replica or copy destination Region, must call this before making any API call.
+func (c *AWSClient) VerifyRegionAllowed(_ context.Context, region string) error {
+	if region == "" {
+		return nil
+	}
+
+	return verifyRegionAllowed(region, c.allowedRegions, c.forbiddenRegions)
+}
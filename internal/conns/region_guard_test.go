@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestVerifyRegionAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		region           string
+		allowedRegions   []string
+		forbiddenRegions []string
+		expectedError    bool
+	}{
+		{
+			name:   "no lists",
+			region: "us-east-1", //lintignore:AWSAT003
+		},
+		{
+			name:           "allowed",
+			region:         "eu-west-1",                           //lintignore:AWSAT003
+			allowedRegions: []string{"eu-west-1", "eu-central-1"}, //lintignore:AWSAT003
+		},
+		{
+			name:           "not allowed",
+			region:         "us-east-1",                           //lintignore:AWSAT003
+			allowedRegions: []string{"eu-west-1", "eu-central-1"}, //lintignore:AWSAT003
+			expectedError:  true,
+		},
+		{
+			name:             "not forbidden",
+			region:           "eu-west-1",           //lintignore:AWSAT003
+			forbiddenRegions: []string{"us-east-1"}, //lintignore:AWSAT003
+		},
+		{
+			name:             "forbidden",
+			region:           "us-east-1",           //lintignore:AWSAT003
+			forbiddenRegions: []string{"us-east-1"}, //lintignore:AWSAT003
+			expectedError:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifyRegionAllowed(testCase.region, testCase.allowedRegions, testCase.forbiddenRegions)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Errorf("got error %t, expected error %t (%v)", got, want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	ratelimit_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	client_sdkv1 "github.com/aws/aws-sdk-go/aws/client"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// RetryConfig is the retry policy for a service's API calls, overriding the provider-level retry configuration.
+type RetryConfig struct {
+	MaxAttempts            int                 // Zero for the provider's maximum attempts
+	MaxBackoff             time.Duration       // Zero for the provider's maximum backoff
+	Mode                   aws_sdkv2.RetryMode // Empty for the provider's retry mode. AWS SDK for Go v1 API clients only support standard mode
+	RetryableErrorCodes    []string            // API error codes that are retried in addition to the AWS SDK's defaults
+	RetryableErrorMessages []string            // API error message substrings that are retried in addition to the AWS SDK's defaults
+}
+
+func (v *RetryConfig) isErrorRetryable(code, message string) bool {
+	if slices.Contains(v.RetryableErrorCodes, code) {
+		return true
+	}
+
+	return slices.ContainsFunc(v.RetryableErrorMessages, func(s string) bool {
+		return strings.Contains(message, s)
+	})
+}
+
+// withRetryConfig returns copies of the specified AWS SDK for Go v2 configuration and v1 session that apply the retry policy.
+func (c *AWSClient) withRetryConfig(cfg *aws_sdkv2.Config, session *session_sdkv1.Session, retryConfig *RetryConfig) (*aws_sdkv2.Config, *session_sdkv1.Session) {
+	if cfg != nil {
+		v := cfg.Copy()
+		v.Retryer = c.newServiceRetryer(cfg.Retryer, retryConfig)
+		cfg = &v
+	}
+
+	if session != nil {
+		session = session.Copy(retryConfigSDKv1(session.Config, retryConfig))
+		addRetryableErrorHandler(&session.Handlers, retryConfig)
+	}
+
+	return cfg, session
+}
+
+// newServiceRetryer returns an AWS SDK for Go v2 Retryer factory that applies the retry policy to the provider-level Retryer.
+func (c *AWSClient) newServiceRetryer(retryer func() aws_sdkv2.Retryer, retryConfig *RetryConfig) func() aws_sdkv2.Retryer {
+	return func() aws_sdkv2.Retryer {
+		var r aws_sdkv2.Retryer
+		if retryer != nil {
+			r = retryer()
+		} else {
+			r = retry_sdkv2.NewStandard()
+		}
+
+		if mode := retryConfig.Mode; mode != "" {
+			// Rebuild the Retryer, keeping the provider-level options.
+			maxAttempts := r.MaxAttempts()
+			standardOptions := func(o *retry_sdkv2.StandardOptions) {
+				o.Backoff = &v1CompatibleBackoff{maxRetryDelay: maxBackoff}
+				o.MaxAttempts = maxAttempts
+				o.MaxBackoff = maxBackoff
+				if v := c.tokenBucketRateLimiterCapacity; v > 0 {
+					o.RateLimiter = ratelimit_sdkv2.NewTokenRateLimit(uint(v))
+				} else {
+					o.RateLimiter = ratelimit_sdkv2.None
+				}
+			}
+
+			switch mode {
+			case aws_sdkv2.RetryModeAdaptive:
+				r = retry_sdkv2.NewAdaptiveMode(func(o *retry_sdkv2.AdaptiveModeOptions) {
+					o.StandardOptions = append(o.StandardOptions, standardOptions)
+				})
+			default:
+				r = retry_sdkv2.NewStandard(standardOptions)
+			}
+		}
+
+		if v := retryConfig.MaxAttempts; v > 0 {
+			r = retry_sdkv2.AddWithMaxAttempts(r, v)
+		}
+
+		if v := retryConfig.MaxBackoff; v > 0 {
+			r = retry_sdkv2.AddWithMaxBackoffDelay(r, v)
+		}
+
+		if len(retryConfig.RetryableErrorCodes) > 0 || len(retryConfig.RetryableErrorMessages) > 0 {
+			r = AddIsErrorRetryables(r.(aws_sdkv2.RetryerV2), retry_sdkv2.IsErrorRetryableFunc(func(err error) aws_sdkv2.Ternary {
+				if apiErr, ok := errs.As[smithy.APIError](err); ok && retryConfig.isErrorRetryable(apiErr.ErrorCode(), apiErr.ErrorMessage()) {
+					return aws_sdkv2.TrueTernary
+				}
+				return aws_sdkv2.UnknownTernary
+			}))
+		}
+
+		return r
+	}
+}
+
+// retryConfigSDKv1 returns the AWS SDK for Go v1 configuration that applies the retry policy's maximum attempts and backoff.
+func retryConfigSDKv1(config *aws_sdkv1.Config, retryConfig *RetryConfig) *aws_sdkv1.Config {
+	cfg := aws_sdkv1.NewConfig()
+
+	// Consistent with the provider-level configuration, maximum attempts are used as maximum retries.
+	maxRetries := aws_sdkv1.IntValue(config.MaxRetries)
+	if v := retryConfig.MaxAttempts; v > 0 {
+		maxRetries = v
+		cfg.WithMaxRetries(v)
+	}
+
+	if v := retryConfig.MaxBackoff; v > 0 {
+		cfg.Retryer = client_sdkv1.DefaultRetryer{
+			NumMaxRetries:    maxRetries,
+			MaxRetryDelay:    v,
+			MaxThrottleDelay: v,
+		}
+	}
+
+	return cfg
+}
+
+// addRetryableErrorHandler registers an AWS SDK for Go v1 request handler that retries the retry policy's additional errors.
+func addRetryableErrorHandler(handlers *request_sdkv1.Handlers, retryConfig *RetryConfig) {
+	if len(retryConfig.RetryableErrorCodes) == 0 && len(retryConfig.RetryableErrorMessages) == 0 {
+		return
+	}
+
+	// Added to the front so that later handlers can still disable retries, e.g. for expired credentials.
+	handlers.Retry.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "conns.RetryableErrors",
+		Fn: func(r *request_sdkv1.Request) {
+			var awsErr awserr.Error
+			if errors.As(r.Error, &awsErr) && retryConfig.isErrorRetryable(awsErr.Code(), awsErr.Message()) {
+				r.Retryable = aws_sdkv1.Bool(true)
+			}
+		},
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	smithy "github.com/aws/smithy-go"
+)
+
+func TestNewServiceRetryer(t *testing.T) {
+	t.Parallel()
+
+	client := &AWSClient{}
+	providerRetryer := func() aws.Retryer {
+		return retry.NewStandard(func(o *retry.StandardOptions) {
+			o.MaxAttempts = 25
+		})
+	}
+	testCases := []struct {
+		name                string
+		retryConfig         *RetryConfig
+		err                 error
+		expectedMaxAttempts int
+		expectedRetryable   bool
+	}{
+		{
+			name:                "defaults",
+			retryConfig:         &RetryConfig{},
+			err:                 &smithy.GenericAPIError{Code: "SomethingTransient"},
+			expectedMaxAttempts: 25,
+		},
+		{
+			name:                "max attempts",
+			retryConfig:         &RetryConfig{MaxAttempts: 5},
+			err:                 errors.New("test"),
+			expectedMaxAttempts: 5,
+		},
+		{
+			name:                "adaptive mode keeps provider max attempts",
+			retryConfig:         &RetryConfig{Mode: aws.RetryModeAdaptive, MaxBackoff: 10 * time.Second},
+			err:                 errors.New("test"),
+			expectedMaxAttempts: 25,
+		},
+		{
+			name:                "retryable error code",
+			retryConfig:         &RetryConfig{RetryableErrorCodes: []string{"SomethingTransient"}},
+			err:                 &smithy.GenericAPIError{Code: "SomethingTransient"},
+			expectedMaxAttempts: 25,
+			expectedRetryable:   true,
+		},
+		{
+			name:                "retryable error message",
+			retryConfig:         &RetryConfig{RetryableErrorMessages: []string{"try again"}},
+			err:                 &smithy.GenericAPIError{Code: "ValidationException", Message: "Please try again later"},
+			expectedMaxAttempts: 25,
+			expectedRetryable:   true,
+		},
+		{
+			name:                "other error code",
+			retryConfig:         &RetryConfig{RetryableErrorCodes: []string{"SomethingTransient"}},
+			err:                 &smithy.GenericAPIError{Code: "ValidationException"},
+			expectedMaxAttempts: 25,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			retryer := client.newServiceRetryer(providerRetryer, testCase.retryConfig)()
+
+			if got, want := retryer.MaxAttempts(), testCase.expectedMaxAttempts; got != want {
+				t.Errorf("MaxAttempts = %d, want %d", got, want)
+			}
+			if got, want := retryer.IsErrorRetryable(testCase.err), testCase.expectedRetryable; got != want {
+				t.Errorf("IsErrorRetryable = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@
 package depgraph
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
@@ -186,6 +188,91 @@ func (g *Graph) OverallOrder() ([]string, error) {
 	return order, nil
 }
 
+// Walk calls f for each node in the graph once f has returned successfully for all of the node's dependencies.
+// Up to parallelism calls to f are made concurrently.
+// If f returns an error, no further calls are started and, once all calls in progress have returned, the errors are returned.
+// Returns an error if a dependency cycle is detected.
+// The graph must not be modified during the walk.
+func (g *Graph) Walk(ctx context.Context, parallelism int, f func(context.Context, string) error) error {
+	if _, err := g.OverallOrder(); err != nil {
+		return err
+	}
+
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	walkCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		node string
+		err  error
+	}
+
+	results := make(chan result)
+	semaphore := make(chan struct{}, parallelism)
+	running := 0
+	start := func(node string) {
+		running++
+
+		go func() {
+			var err error
+
+			select {
+			case semaphore <- struct{}{}:
+				if walkCtx.Err() == nil {
+					err = f(walkCtx, node)
+				}
+				<-semaphore
+			case <-walkCtx.Done():
+			}
+
+			results <- result{node: node, err: err}
+		}()
+	}
+
+	// The number of each node's dependencies that have not yet been walked.
+	pending := make(map[string]int, len(g.nodes))
+	for _, node := range g.nodes {
+		pending[node] = len(g.outgoingEdges[node])
+	}
+
+	for _, node := range g.nodes {
+		if pending[node] == 0 {
+			start(node)
+		}
+	}
+
+	var errs []error
+
+	for running > 0 {
+		r := <-results
+		running--
+
+		if r.err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", r.node, r.err))
+			cancel()
+		}
+
+		if walkCtx.Err() != nil {
+			continue
+		}
+
+		for _, node := range g.incomingEdges[r.node] {
+			if pending[node]--; pending[node] == 0 {
+				start(node)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	return ctx.Err()
+}
+
 // depthFirstSearch returns a Topological Sort using Depth-First-Search on a set of edges.
 // Returns an error if a dependency cycle is detected.
 func depthFirstSearch(edges map[string][]string) func(s string) ([]string, error) {
@@ -4,8 +4,13 @@
 package depgraph
 
 import (
+	"context"
+	"errors"
 	"reflect"
+	"slices"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -417,3 +422,124 @@ func TestDependencyGraphOverallOrder(t *testing.T) {
 		t.Fatalf("incorrect overall order. Expected: %v, got: %v", expected, got)
 	}
 }
+
+func TestDependencyGraphWalk(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	g := New()
+
+	g.AddNode("a")
+	g.AddNode("b")
+	g.AddNode("c")
+	g.AddNode("d")
+	g.AddNode("e")
+
+	if err := g.AddDependency("a", "b"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := g.AddDependency("a", "c"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := g.AddDependency("b", "d"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := g.AddDependency("c", "d"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, parallelism := range []int{1, 2, 5} {
+		var (
+			mu                  sync.Mutex
+			walked              []string
+			running, maxRunning int
+		)
+
+		err := g.Walk(ctx, parallelism, func(_ context.Context, s string) error {
+			mu.Lock()
+			dependencies, _ := g.DirectDependenciesOf(s)
+			for _, dependency := range dependencies {
+				if !slices.Contains(walked, dependency) {
+					t.Errorf("parallelism %d: %s walked before its dependency %s", parallelism, s, dependency)
+				}
+			}
+			running++
+			maxRunning = max(maxRunning, running)
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			walked = append(walked, s)
+			mu.Unlock()
+
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("parallelism %d: unexpected error: %s", parallelism, err)
+		}
+
+		if got, expected := len(walked), g.Len(); got != expected {
+			t.Errorf("parallelism %d: incorrect number of nodes walked. Expected: %d, got: %d", parallelism, expected, got)
+		}
+		if maxRunning > parallelism {
+			t.Errorf("parallelism %d: %d nodes walked concurrently", parallelism, maxRunning)
+		}
+		if parallelism > 1 && maxRunning < 2 {
+			t.Errorf("parallelism %d: no nodes walked concurrently", parallelism)
+		}
+	}
+}
+
+func TestDependencyGraphWalkError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	g := New()
+
+	g.AddNode("a")
+	g.AddNode("b")
+	g.AddNode("c")
+
+	if err := g.AddDependency("a", "b"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := g.AddDependency("b", "c"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var walked []string
+	err := g.Walk(ctx, 1, func(_ context.Context, s string) error {
+		walked = append(walked, s)
+		if s == "b" {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if expected := []string{"c", "b"}; !reflect.DeepEqual(walked, expected) {
+		t.Fatalf("incorrect nodes walked. Expected: %v, got: %v", expected, walked)
+	}
+
+	// Cycle.
+	if err := g.AddDependency("c", "a"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	walked = nil
+	err = g.Walk(ctx, 1, func(_ context.Context, s string) error {
+		walked = append(walked, s)
+		return nil
+	})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if len(walked) != 0 {
+		t.Fatalf("expected no nodes walked, got: %v", walked)
+	}
+}
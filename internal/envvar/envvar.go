@@ -66,6 +66,10 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For running acceptance tests against the in-process AWS emulator instead of an AWS account
+	// See also the internal/acctest/emulator package
+	Emulator = "TF_AWS_EMULATOR"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -84,6 +88,30 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to control resource sweepers
+const (
+	// If true, sweepers list the resources that would be deleted without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated list of resource name or ID patterns that are never swept
+	SweepExclude = "TF_AWS_SWEEP_EXCLUDE"
+
+	// Minimum age, as a Go duration, of resources to be swept
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// Comma-separated list of name prefixes of resources to be swept
+	SweepNamePrefixes = "TF_AWS_SWEEP_NAME_PREFIXES"
+
+	// Maximum number of sweepers run concurrently
+	SweepParallelism = "TF_AWS_SWEEP_PARALLELISM"
+
+	// Path of the JSON (default) or CSV (".csv" extension) sweep report file
+	SweepReport = "TF_AWS_SWEEP_REPORT"
+
+	// Comma-separated list of tags ("key=value" or "key") of resources to be swept
+	SweepTags = "TF_AWS_SWEEP_TAGS"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
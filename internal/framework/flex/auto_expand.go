@@ -358,6 +358,15 @@ func (expander autoExpander) object(ctx context.Context, vFrom basetypes.ObjectV
 				return diags
 			}
 		}
+
+	case reflect.Interface:
+		//
+		// types.Object -> union.
+		//
+		if vFrom, ok := vFrom.(fwtypes.NestedObjectValue); ok {
+			diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
@@ -704,17 +713,17 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 
 		case reflect.Interface:
 			//
-			// types.List(OfObject) -> []interface.
+			// types.List(OfObject) -> []union.
 			//
-			// Smithy union type handling not yet implemented. Silently skip.
+			diags.Append(expander.nestedObjectToUnionSlice(ctx, vFrom, tTo, tElem, vTo)...)
 			return diags
 		}
 
 	case reflect.Interface:
 		//
-		// types.List(OfObject) -> interface.
+		// types.List(OfObject) -> union.
 		//
-		// Smithy union type handling not yet implemented. Silently skip.
+		diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, vTo)...)
 		return diags
 	}
 
@@ -786,6 +795,145 @@ func (expander autoExpander) nestedObjectToSlice(ctx context.Context, vFrom fwty
 	return diags
 }
 
+// nestedObjectToUnion copies a Plugin Framework NestedObjectValue to a compatible AWS API union value.
+func (expander autoExpander) nestedObjectToUnion(ctx context.Context, vFrom fwtypes.NestedObjectValue, tUnion reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unregistered union types are skipped, leaving them to be expanded by hand.
+	if len(unionMemberTypes(tUnion)) == 0 {
+		tflog.Info(ctx, "AutoFlex Expand; unregistered union type", map[string]interface{}{
+			"to": tUnion,
+		})
+		return diags
+	}
+
+	// Get the nested Object as a pointer.
+	from, d := vFrom.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	f := reflect.ValueOf(from)
+	if !f.IsValid() || f.IsNil() {
+		return diags
+	}
+
+	to, d := expander.union(ctx, f.Elem(), tUnion)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if to.IsValid() {
+		vTo.Set(to)
+	}
+
+	return diags
+}
+
+// nestedObjectToUnionSlice copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API []union value.
+func (expander autoExpander) nestedObjectToUnionSlice(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tSlice, tUnion reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unregistered union types are skipped, leaving them to be expanded by hand.
+	if len(unionMemberTypes(tUnion)) == 0 {
+		tflog.Info(ctx, "AutoFlex Expand; unregistered union type", map[string]interface{}{
+			"to": tUnion,
+		})
+		return diags
+	}
+
+	// Get the nested Objects as a slice.
+	from, d := vFrom.ToObjectSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Create a new target slice and expand each element.
+	f := reflect.ValueOf(from)
+	n := f.Len()
+	t := reflect.MakeSlice(tSlice, n, n)
+	for i := 0; i < n; i++ {
+		target, d := expander.union(ctx, f.Index(i).Elem(), tUnion)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if target.IsValid() {
+			t.Index(i).Set(target)
+		}
+	}
+
+	vTo.Set(t)
+
+	return diags
+}
+
+// union expands the nested object struct `vFrom` to a member of the specified union type.
+// At most one of the struct's fields may be set; the set field determines the union member.
+// Returns an invalid reflect.Value if no field is set.
+func (expander autoExpander) union(ctx context.Context, vFrom reflect.Value, tUnion reflect.Type) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var to reflect.Value
+	var toFieldName string
+
+	opts := expander.getOptions()
+	for i, typFrom := 0, vFrom.Type(); i < typFrom.NumField(); i++ {
+		field := typFrom.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		fieldName := field.Name
+		if opts.IsIgnoredField(fieldName) {
+			continue
+		}
+
+		v, ok := vFrom.Field(i).Interface().(attr.Value)
+		if !ok || !isUnionMemberSet(v) {
+			continue
+		}
+
+		tMember := findUnionMemberType(fieldName, tUnion)
+		if tMember == nil {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union type %s has no member corresponding to field %s", tUnion, fieldName))
+			return to, diags
+		}
+
+		if to.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union type %s: only one of fields %s and %s may be set", tUnion, toFieldName, fieldName))
+			return to, diags
+		}
+
+		// Create a new union member and expand into its value.
+		to = reflect.New(tMember)
+		toFieldName = fieldName
+		diags.Append(expander.convert(ctx, vFrom.Field(i), to.Elem().FieldByName(unionMemberValueFieldName))...)
+		if diags.HasError() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", fieldName))
+			return to, diags
+		}
+	}
+
+	return to, diags
+}
+
+// isUnionMemberSet returns whether the specified nested object field corresponds to a set union member.
+// Empty collections are considered unset.
+func isUnionMemberSet(v attr.Value) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return false
+	}
+
+	if v, ok := v.(interface{ Elements() []attr.Value }); ok {
+		return len(v.Elements()) > 0
+	}
+
+	return true
+}
+
 // nestedKeyObjectToMap copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API map[string]struct value.
 func (expander autoExpander) nestedKeyObjectToMap(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tElem reflect.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
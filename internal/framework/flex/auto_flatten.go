@@ -111,7 +111,7 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, false, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
 		return diags
 	}
 
@@ -356,6 +356,15 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 
 		vTo.Set(reflect.ValueOf(v))
 		return diags
+
+	case fwtypes.NestedObjectType:
+		//
+		// union -> types.List(OfObject) or types.Object.
+		//
+		if len(unionMemberTypes(vFrom.Type())) > 0 {
+			diags.Append(flattener.unionToNestedObject(ctx, vFrom, isNullFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -564,8 +573,13 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 		}
 
 	case reflect.Interface:
-		// Smithy union type handling not yet implemented. Silently skip.
-		return diags
+		if tTo, ok := tTo.(fwtypes.NestedObjectCollectionType); ok && len(unionMemberTypes(tSliceElem)) > 0 {
+			//
+			// []union -> types.List(OfObject).
+			//
+			diags.Append(flattener.sliceOfUnionNestedObjectCollection(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -937,6 +951,159 @@ func (flattener autoFlattener) sliceOfStructNestedObjectCollection(ctx context.C
 	return diags
 }
 
+// unionToNestedObject copies an AWS API union value to a compatible Plugin Framework NestedObjectValue value.
+func (flattener autoFlattener) unionToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if isNullFrom {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target structure and set the field corresponding to the union member.
+	to, d := tTo.NewObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(flattener.union(ctx, vFrom, reflect.ValueOf(to).Elem())...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Set the target structure as a mapped Object.
+	val, d := tTo.ValueFromObjectPtr(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// sliceOfUnionNestedObjectCollection copies an AWS API []union value to a compatible Plugin Framework NestedObjectCollectionValue value.
+func (flattener autoFlattener) sliceOfUnionNestedObjectCollection(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectCollectionType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target slice and flatten each element.
+	n := vFrom.Len()
+	to, d := tTo.NewObjectSlice(ctx, n, n)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	t := reflect.ValueOf(to)
+	for i := 0; i < n; i++ {
+		target, d := tTo.NewObjectPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if !vFrom.Index(i).IsNil() {
+			diags.Append(flattener.union(ctx, vFrom.Index(i), reflect.ValueOf(target).Elem())...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		t.Index(i).Set(reflect.ValueOf(target))
+	}
+
+	// Set the target structure as a nested Object.
+	val, d := tTo.ValueFromObjectSlice(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// union flattens the non-nil AWS API union value `vFrom` into the nested object struct `vTo`.
+// The field corresponding to the union member is set and all other fields are set to null.
+func (flattener autoFlattener) union(ctx context.Context, vFrom reflect.Value, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tUnion := vFrom.Type()
+	vMember := vFrom.Elem()
+	if vMember.Kind() == reflect.Ptr {
+		vMember = vMember.Elem()
+	}
+	memberName := unionMemberName(tUnion, vMember.Type())
+
+	var toFieldVal reflect.Value
+	opts := flattener.getOptions()
+	for i, typTo := 0, vTo.Type(); i < typTo.NumField(); i++ {
+		field := typTo.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		fieldName := field.Name
+		if opts.IsIgnoredField(fieldName) {
+			continue
+		}
+
+		fieldVal := vTo.Field(i)
+		if strings.EqualFold(fieldName, memberName) {
+			toFieldVal = fieldVal
+		}
+
+		null, err := fwtypes.NullValueOf(ctx, fieldVal.Interface())
+		if err != nil {
+			diags.AddError("AutoFlEx", err.Error())
+			return diags
+		}
+		if v := reflect.ValueOf(null); null != nil && v.Type().AssignableTo(fieldVal.Type()) {
+			fieldVal.Set(v)
+		}
+	}
+
+	if !toFieldVal.IsValid() {
+		// e.g. the AWS SDK for Go v2's UnknownUnionMember.
+		tflog.Info(ctx, "AutoFlex Flatten; no field for union member", map[string]interface{}{
+			"from": vMember.Type(),
+			"to":   vTo.Type(),
+		})
+		return diags
+	}
+
+	vValue := vMember.FieldByName(unionMemberValueFieldName)
+	if !vValue.IsValid() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union type %s member %s has no %s field", tUnion, vMember.Type(), unionMemberValueFieldName))
+		return diags
+	}
+
+	diags.Append(flattener.convert(ctx, vValue, toFieldVal)...)
+	if diags.HasError() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", memberName))
+		return diags
+	}
+
+	return diags
+}
+
 // blockKeyMapSet takes a struct and assigns the value of the `key`
 func blockKeyMapSet(to any, key reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
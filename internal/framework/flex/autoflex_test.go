@@ -4,7 +4,9 @@
 package flex
 
 import (
+	"context"
 	"encoding/json"
+	"testing"
 	"time"
 
 	smithydocument "github.com/aws/smithy-go/document"
@@ -361,3 +363,330 @@ type TestFlexAWS21 struct {
 type TestFlexAWS22 struct {
 	Field1 map[string]map[string]*string
 }
+
+// TestFlexUnionAWS is a Smithy union type, as generated by AWS SDK for Go v2.
+type TestFlexUnionAWS interface {
+	isTestFlexUnionAWS()
+}
+
+type TestFlexUnionAWSMemberString struct {
+	Value string
+}
+
+func (*TestFlexUnionAWSMemberString) isTestFlexUnionAWS() {}
+
+type TestFlexUnionAWSMemberInt64 struct {
+	Value int64
+}
+
+func (*TestFlexUnionAWSMemberInt64) isTestFlexUnionAWS() {}
+
+type TestFlexUnionAWSMemberObject struct {
+	Value TestFlexAWS01
+}
+
+func (*TestFlexUnionAWSMemberObject) isTestFlexUnionAWS() {}
+
+// TestFlexUnionAWSMemberUnknown is a member without a corresponding Terraform field, ie UnknownUnionMember.
+type TestFlexUnionAWSMemberUnknown struct {
+	Value []byte
+}
+
+func (*TestFlexUnionAWSMemberUnknown) isTestFlexUnionAWS() {}
+
+// TestFlexUnregisteredUnionAWS is a Smithy union type whose members are not registered.
+type TestFlexUnregisteredUnionAWS interface {
+	isTestFlexUnregisteredUnionAWS()
+}
+
+type TestFlexUnregisteredUnionAWSMemberString struct {
+	Value string
+}
+
+func (*TestFlexUnregisteredUnionAWSMemberString) isTestFlexUnregisteredUnionAWS() {}
+
+func init() {
+	RegisterUnionType[TestFlexUnionAWS](
+		(*TestFlexUnionAWSMemberString)(nil),
+		(*TestFlexUnionAWSMemberInt64)(nil),
+		(*TestFlexUnionAWSMemberObject)(nil),
+	)
+}
+
+type TestFlexUnionTF01 struct {
+	String types.String                                  `tfsdk:"string"`
+	Int64  types.Int64                                   `tfsdk:"int64"`
+	Object fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"object"`
+}
+
+type TestFlexUnionTF02 struct {
+	Field1 fwtypes.ListNestedObjectValueOf[TestFlexUnionTF01] `tfsdk:"field1"`
+}
+
+type TestFlexUnionTF03 struct {
+	Field1 fwtypes.ObjectValueOf[TestFlexUnionTF01] `tfsdk:"field1"`
+}
+
+type TestFlexUnionTF04 struct {
+	String types.String `tfsdk:"string"`
+	Bool   types.Bool   `tfsdk:"bool"`
+}
+
+type TestFlexUnionTF05 struct {
+	Field1 fwtypes.ListNestedObjectValueOf[TestFlexUnionTF04] `tfsdk:"field1"`
+}
+
+type TestFlexUnionAWS02 struct {
+	Field1 TestFlexUnionAWS
+}
+
+type TestFlexUnionAWS03 struct {
+	Field1 []TestFlexUnionAWS
+}
+
+type TestFlexUnionAWS04 struct {
+	Field1 TestFlexUnregisteredUnionAWS
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "string member",
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				String: types.StringValue("a"),
+				Int64:  types.Int64Null(),
+				Object: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+			Target:     &TestFlexUnionAWS02{},
+			WantTarget: &TestFlexUnionAWS02{Field1: &TestFlexUnionAWSMemberString{Value: "a"}},
+		},
+		{
+			TestName: "int64 member",
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				String: types.StringNull(),
+				Int64:  types.Int64Value(42),
+				Object: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+			Target:     &TestFlexUnionAWS02{},
+			WantTarget: &TestFlexUnionAWS02{Field1: &TestFlexUnionAWSMemberInt64{Value: 42}},
+		},
+		{
+			TestName: "object member",
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				String: types.StringNull(),
+				Int64:  types.Int64Null(),
+				Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("a")}),
+			})},
+			Target:     &TestFlexUnionAWS02{},
+			WantTarget: &TestFlexUnionAWS02{Field1: &TestFlexUnionAWSMemberObject{Value: TestFlexAWS01{Field1: "a"}}},
+		},
+		{
+			TestName: "empty object member",
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				String: types.StringNull(),
+				Int64:  types.Int64Value(42),
+				Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []TestFlexTF01{}),
+			})},
+			Target:     &TestFlexUnionAWS02{},
+			WantTarget: &TestFlexUnionAWS02{Field1: &TestFlexUnionAWSMemberInt64{Value: 42}},
+		},
+		{
+			TestName: "single nested block",
+			Source: &TestFlexUnionTF03{Field1: fwtypes.NewObjectValueOfMust(ctx, &TestFlexUnionTF01{
+				String: types.StringValue("a"),
+				Int64:  types.Int64Null(),
+				Object: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+			Target:     &TestFlexUnionAWS02{},
+			WantTarget: &TestFlexUnionAWS02{Field1: &TestFlexUnionAWSMemberString{Value: "a"}},
+		},
+		{
+			TestName: "no member",
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				String: types.StringNull(),
+				Int64:  types.Int64Null(),
+				Object: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+			Target:     &TestFlexUnionAWS02{},
+			WantTarget: &TestFlexUnionAWS02{},
+		},
+		{
+			TestName:   "null",
+			Source:     &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF01](ctx)},
+			Target:     &TestFlexUnionAWS02{},
+			WantTarget: &TestFlexUnionAWS02{},
+		},
+		{
+			TestName: "multiple members",
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				String: types.StringValue("a"),
+				Int64:  types.Int64Value(42),
+				Object: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+			Target:  &TestFlexUnionAWS02{},
+			WantErr: true,
+		},
+		{
+			TestName: "no corresponding member",
+			Source: &TestFlexUnionTF05{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF04{
+				Bool: types.BoolValue(true),
+			})},
+			Target:  &TestFlexUnionAWS02{},
+			WantErr: true,
+		},
+		{
+			TestName: "list of unions",
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*TestFlexUnionTF01{
+				{
+					String: types.StringValue("a"),
+					Int64:  types.Int64Null(),
+					Object: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				},
+				{
+					String: types.StringNull(),
+					Int64:  types.Int64Value(42),
+					Object: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				},
+			})},
+			Target: &TestFlexUnionAWS03{},
+			WantTarget: &TestFlexUnionAWS03{Field1: []TestFlexUnionAWS{
+				&TestFlexUnionAWSMemberString{Value: "a"},
+				&TestFlexUnionAWSMemberInt64{Value: 42},
+			}},
+		},
+		{
+			TestName: "unregistered union",
+			Source: &TestFlexUnionTF05{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF04{
+				String: types.StringValue("a"),
+			})},
+			Target:     &TestFlexUnionAWS04{},
+			WantTarget: &TestFlexUnionAWS04{},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "string member",
+			Source:   &TestFlexUnionAWS02{Field1: &TestFlexUnionAWSMemberString{Value: "a"}},
+			Target:   &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				String: types.StringValue("a"),
+				Int64:  types.Int64Null(),
+				Object: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+		},
+		{
+			TestName: "int64 member",
+			Source:   &TestFlexUnionAWS02{Field1: &TestFlexUnionAWSMemberInt64{Value: 42}},
+			Target:   &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				String: types.StringNull(),
+				Int64:  types.Int64Value(42),
+				Object: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+		},
+		{
+			TestName: "object member",
+			Source:   &TestFlexUnionAWS02{Field1: &TestFlexUnionAWSMemberObject{Value: TestFlexAWS01{Field1: "a"}}},
+			Target:   &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				String: types.StringNull(),
+				Int64:  types.Int64Null(),
+				Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("a")}),
+			})},
+		},
+		{
+			TestName: "single nested block",
+			Source:   &TestFlexUnionAWS02{Field1: &TestFlexUnionAWSMemberString{Value: "a"}},
+			Target:   &TestFlexUnionTF03{},
+			WantTarget: &TestFlexUnionTF03{Field1: fwtypes.NewObjectValueOfMust(ctx, &TestFlexUnionTF01{
+				String: types.StringValue("a"),
+				Int64:  types.Int64Null(),
+				Object: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+		},
+		{
+			TestName:   "nil",
+			Source:     &TestFlexUnionAWS02{},
+			Target:     &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF01](ctx)},
+		},
+		{
+			TestName: "unknown member",
+			Source:   &TestFlexUnionAWS02{Field1: &TestFlexUnionAWSMemberUnknown{Value: []byte("a")}},
+			Target:   &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				String: types.StringNull(),
+				Int64:  types.Int64Null(),
+				Object: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+		},
+		{
+			TestName: "list of unions",
+			Source: &TestFlexUnionAWS03{Field1: []TestFlexUnionAWS{
+				&TestFlexUnionAWSMemberString{Value: "a"},
+				&TestFlexUnionAWSMemberInt64{Value: 42},
+			}},
+			Target: &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*TestFlexUnionTF01{
+				{
+					String: types.StringValue("a"),
+					Int64:  types.Int64Null(),
+					Object: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				},
+				{
+					String: types.StringNull(),
+					Int64:  types.Int64Value(42),
+					Object: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				},
+			})},
+		},
+		{
+			TestName:   "unregistered union",
+			Source:     &TestFlexUnionAWS04{Field1: &TestFlexUnregisteredUnionAWSMemberString{Value: "a"}},
+			Target:     &TestFlexUnionTF05{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF04](ctx)},
+			WantTarget: &TestFlexUnionTF05{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF04](ctx)},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestRegisterUnionTypeInvalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(){
+		"not an interface": func() {
+			RegisterUnionType[TestFlexAWS01](TestFlexAWS01{})
+		},
+		"member not a pointer": func() {
+			RegisterUnionType[any](TestFlexUnionAWSMemberString{})
+		},
+		"member without value": func() {
+			RegisterUnionType[any](&TestFlexAWS02{})
+		},
+	}
+
+	for name, f := range testCases {
+		f := f
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic")
+				}
+			}()
+
+			f()
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Smithy union types are generated in AWS SDK for Go v2 as an interface type (e.g. PolicyDefinition)
+// implemented by a pointer to a member struct type per union member (e.g. *PolicyDefinitionMemberStatic).
+// Each member struct has a single Value field holding the member's value.
+//
+// AutoFlEx maps a union to and from a nested object with one field per union member,
+// only one of which may be set. The field for a member is found by (case insensitive) name,
+// e.g. field Static for member type PolicyDefinitionMemberStatic.
+//
+// Go reflection cannot discover the types implementing an interface, so union member types
+// must be registered via RegisterUnionType before AutoFlEx can expand to a union.
+
+const (
+	unionMemberTypeNameSeparator = "Member"
+	unionMemberValueFieldName    = "Value"
+)
+
+var unionTypes = struct {
+	sync.RWMutex
+	members map[reflect.Type][]reflect.Type
+}{
+	members: make(map[reflect.Type][]reflect.Type),
+}
+
+// RegisterUnionType registers the member types of the Smithy union type `T`.
+// Members are passed as (typically nil) pointers to member structs, e.g.
+//
+//	flex.RegisterUnionType[awstypes.PolicyDefinition](
+//		(*awstypes.PolicyDefinitionMemberStatic)(nil),
+//		(*awstypes.PolicyDefinitionMemberTemplateLinked)(nil),
+//	)
+//
+// RegisterUnionType panics if `T` is not an interface type or a member is not a pointer to a struct with a Value field.
+func RegisterUnionType[T any](members ...T) {
+	tUnion := reflect.TypeFor[T]()
+	if tUnion.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("AutoFlEx: union type %s is not an interface", tUnion))
+	}
+
+	tMembers := make([]reflect.Type, 0, len(members))
+	for _, member := range members {
+		tMember := reflect.TypeOf(member)
+		if tMember == nil || tMember.Kind() != reflect.Ptr || tMember.Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("AutoFlEx: union type %s member %s is not a pointer to struct", tUnion, tMember))
+		}
+		if _, ok := tMember.Elem().FieldByName(unionMemberValueFieldName); !ok {
+			panic(fmt.Sprintf("AutoFlEx: union type %s member %s has no %s field", tUnion, tMember, unionMemberValueFieldName))
+		}
+
+		tMembers = append(tMembers, tMember.Elem())
+	}
+
+	unionTypes.Lock()
+	defer unionTypes.Unlock()
+
+	unionTypes.members[tUnion] = tMembers
+}
+
+// unionMemberTypes returns the registered member struct types of the specified union type.
+func unionMemberTypes(tUnion reflect.Type) []reflect.Type {
+	unionTypes.RLock()
+	defer unionTypes.RUnlock()
+
+	return unionTypes.members[tUnion]
+}
+
+// unionMemberName returns the union member name for the specified member struct type.
+// For example, the member name of PolicyDefinitionMemberStatic is Static.
+func unionMemberName(tUnion, tMember reflect.Type) string {
+	name := tMember.Name()
+
+	if v, ok := strings.CutPrefix(name, tUnion.Name()+unionMemberTypeNameSeparator); ok {
+		return v
+	}
+
+	if i := strings.LastIndex(name, unionMemberTypeNameSeparator); i >= 0 {
+		return name[i+len(unionMemberTypeNameSeparator):]
+	}
+
+	return name
+}
+
+// findUnionMemberType returns the registered member struct type of the specified union type
+// corresponding to the specified nested object field, or nil if there is none.
+func findUnionMemberType(fieldName string, tUnion reflect.Type) reflect.Type {
+	for _, tMember := range unionMemberTypes(tUnion) {
+		if strings.EqualFold(fieldName, unionMemberName(tUnion, tMember)) {
+			return tMember
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// IdentitySchema returns the Plugin Framework identity schema for a resource with the specified identity.
+func IdentitySchema(identity inttypes.Identity) identityschema.Schema {
+	attributes := map[string]identityschema.Attribute{
+		names.AttrAccountID: identityschema.StringAttribute{
+			OptionalForImport: true,
+		},
+	}
+
+	if !identity.IsGlobalResource {
+		attributes[names.AttrRegion] = identityschema.StringAttribute{
+			OptionalForImport: true,
+		}
+	}
+
+	for _, v := range identity.Attributes {
+		attributes[v.Name] = identityschema.StringAttribute{
+			RequiredForImport: v.Required,
+			OptionalForImport: !v.Required,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// SetIdentity sets a resource's identity from its state.
+func SetIdentity(ctx context.Context, state tfsdk.State, target *tfsdk.ResourceIdentity, identity inttypes.Identity, accountID, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(target.SetAttribute(ctx, path.Root(names.AttrAccountID), accountID)...)
+
+	if !identity.IsGlobalResource {
+		diags.Append(target.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+	}
+
+	for _, v := range identity.Attributes {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(v.Name), &value)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(target.SetAttribute(ctx, path.Root(v.Name), value)...)
+	}
+
+	return diags
+}
+
+// IdentityValues returns the values of a resource's identity attributes.
+func IdentityValues(ctx context.Context, source *tfsdk.ResourceIdentity, identity inttypes.Identity) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	values := make(map[string]string)
+
+	for _, v := range identity.Attributes {
+		var value types.String
+		diags.Append(source.GetAttribute(ctx, path.Root(v.Name), &value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if s := value.ValueString(); s != "" {
+			values[v.Name] = s
+		}
+	}
+
+	return values, diags
+}
+
+// ValidateIdentity verifies that the specified resource's identity attributes are defined in its schema
+// and that identity values round-trip through the resource's ID.
+func ValidateIdentity(ctx context.Context, r resource.Resource, identity inttypes.Identity) error {
+	var response resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &response)
+
+	for _, v := range identity.Attributes {
+		attr, ok := response.Schema.Attributes[v.Name]
+		if !ok {
+			return fmt.Errorf("identity attribute %q is not defined in the resource schema", v.Name)
+		}
+		if _, ok := attr.(schema.StringAttribute); !ok {
+			return fmt.Errorf("identity attribute %q is not a string", v.Name)
+		}
+	}
+
+	values := make(map[string]string)
+	for _, v := range identity.IDAttributes() {
+		values[v] = "identity-" + v
+	}
+
+	id, err := identity.FormatID(values)
+	if err != nil {
+		return fmt.Errorf("formatting ID: %w", err)
+	}
+
+	parsed, err := identity.ParseID(id)
+	if err != nil {
+		return fmt.Errorf("parsing ID (%s): %w", id, err)
+	}
+
+	for name, want := range values {
+		if got := parsed[name]; got != want {
+			return fmt.Errorf("ID (%s) attribute %q parses as %q, want %q", id, name, got, want)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ListFilter holds a list resource's name prefix and tag filters.
+// It is used when the AWS List API cannot filter by name or tag, and listed resources are filtered client-side.
+type ListFilter struct {
+	NamePrefix string
+	Tags       tftags.KeyValueTags
+}
+
+// NewListFilter returns a ListFilter for the configured name prefix and tags.
+// Tags with the reserved "aws:" prefix are ignored.
+func NewListFilter(ctx context.Context, namePrefix types.String, tags types.Map) ListFilter {
+	return ListFilter{
+		NamePrefix: namePrefix.ValueString(),
+		Tags:       tftags.New(ctx, tags).IgnoreAWS(),
+	}
+}
+
+// MatchName returns whether the specified name begins with the filter's name prefix.
+func (f ListFilter) MatchName(name string) bool {
+	return strings.HasPrefix(name, f.NamePrefix)
+}
+
+// HasTags returns whether the filter has tags.
+// If it does, each listed resource's tags must be read and passed to MatchTags.
+func (f ListFilter) HasTags() bool {
+	return len(f.Tags) > 0
+}
+
+// MatchTags returns whether the specified tags contain all of the filter's tags.
+func (f ListFilter) MatchTags(tags tftags.KeyValueTags) bool {
+	return tags.ContainsAll(f.Tags)
+}
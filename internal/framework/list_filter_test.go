@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestListFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resourceTags := tftags.New(ctx, map[string]string{
+		"Environment": "test",
+		"Owner":       "team-a",
+	})
+
+	testCases := map[string]struct {
+		namePrefix        types.String
+		tags              types.Map
+		name              string
+		expectedMatchName bool
+		expectedHasTags   bool
+		expectedMatchTags bool
+	}{
+		"no filters": {
+			namePrefix:        types.StringNull(),
+			tags:              types.MapNull(types.StringType),
+			name:              "tf-acc-test",
+			expectedMatchName: true,
+			expectedHasTags:   false,
+			expectedMatchTags: true,
+		},
+		"name prefix matches": {
+			namePrefix:        types.StringValue("tf-acc-"),
+			tags:              types.MapNull(types.StringType),
+			name:              "tf-acc-test",
+			expectedMatchName: true,
+			expectedHasTags:   false,
+			expectedMatchTags: true,
+		},
+		"name prefix does not match": {
+			namePrefix:        types.StringValue("prod-"),
+			tags:              types.MapNull(types.StringType),
+			name:              "tf-acc-test",
+			expectedMatchName: false,
+			expectedHasTags:   false,
+			expectedMatchTags: true,
+		},
+		"name prefix is case sensitive": {
+			namePrefix:        types.StringValue("TF-ACC-"),
+			tags:              types.MapNull(types.StringType),
+			name:              "tf-acc-test",
+			expectedMatchName: false,
+			expectedHasTags:   false,
+			expectedMatchTags: true,
+		},
+		"tags match": {
+			namePrefix: types.StringNull(),
+			tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"Environment": types.StringValue("test"),
+			}),
+			name:              "tf-acc-test",
+			expectedMatchName: true,
+			expectedHasTags:   true,
+			expectedMatchTags: true,
+		},
+		"all tags match": {
+			namePrefix: types.StringNull(),
+			tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"Environment": types.StringValue("test"),
+				"Owner":       types.StringValue("team-a"),
+			}),
+			name:              "tf-acc-test",
+			expectedMatchName: true,
+			expectedHasTags:   true,
+			expectedMatchTags: true,
+		},
+		"tag value does not match": {
+			namePrefix: types.StringNull(),
+			tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"Environment": types.StringValue("prod"),
+			}),
+			name:              "tf-acc-test",
+			expectedMatchName: true,
+			expectedHasTags:   true,
+			expectedMatchTags: false,
+		},
+		"tag key does not match": {
+			namePrefix: types.StringNull(),
+			tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"Environment": types.StringValue("test"),
+				"CostCenter":  types.StringValue("1234"),
+			}),
+			name:              "tf-acc-test",
+			expectedMatchName: true,
+			expectedHasTags:   true,
+			expectedMatchTags: false,
+		},
+		"aws tags ignored": {
+			namePrefix: types.StringNull(),
+			tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"aws:cloudformation:stack-name": types.StringValue("stack"),
+			}),
+			name:              "tf-acc-test",
+			expectedMatchName: true,
+			expectedHasTags:   false,
+			expectedMatchTags: true,
+		},
+		"name prefix and tags": {
+			namePrefix: types.StringValue("tf-acc-"),
+			tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"Owner": types.StringValue("team-a"),
+			}),
+			name:              "tf-acc-test",
+			expectedMatchName: true,
+			expectedHasTags:   true,
+			expectedMatchTags: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := NewListFilter(ctx, testCase.namePrefix, testCase.tags)
+
+			if got, want := filter.MatchName(testCase.name), testCase.expectedMatchName; got != want {
+				t.Errorf("MatchName(%q) = %t, want %t", testCase.name, got, want)
+			}
+			if got, want := filter.HasTags(), testCase.expectedHasTags; got != want {
+				t.Errorf("HasTags() = %t, want %t", got, want)
+			}
+			if got, want := filter.MatchTags(resourceTags), testCase.expectedMatchTags; got != want {
+				t.Errorf("MatchTags() = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ListResourceWithSDKv2Resource is a structure to be embedded within a list resource
+// that enumerates instances of a Plugin SDK v2 resource.
+// The resource's schema and identity are set by the provider when the list resource is registered.
+type ListResourceWithSDKv2Resource struct {
+	identity       inttypes.Identity
+	resourceSchema *schema.Resource
+}
+
+// SetIdentitySpec sets the identity of the listed resource.
+func (l *ListResourceWithSDKv2Resource) SetIdentitySpec(identity inttypes.Identity) {
+	l.identity = identity
+}
+
+// SetResourceSchema sets the schema of the listed resource.
+func (l *ListResourceWithSDKv2Resource) SetResourceSchema(resource *schema.Resource) {
+	l.resourceSchema = resource
+}
+
+// RawV5Schemas returns the listed resource's schema and identity schema.
+func (l *ListResourceWithSDKv2Resource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	response.ProtoV5Schema = l.resourceSchema.ProtoSchema(ctx)()
+	response.ProtoV5IdentitySchema = l.resourceSchema.ProtoIdentitySchema(ctx)()
+}
+
+// ResourceData returns a new, empty ResourceData for the listed resource.
+func (l *ListResourceWithSDKv2Resource) ResourceData() *schema.ResourceData {
+	return l.resourceSchema.Data(&terraform.InstanceState{})
+}
+
+// SetResult populates a list result from the specified resource data, whose ID must be set.
+// If the full resource is requested the resource's Read handler is called to populate the resource data.
+// Returns false if the resource no longer exists.
+func (l *ListResourceWithSDKv2Resource) SetResult(ctx context.Context, awsClient *conns.AWSClient, includeResource bool, result *list.ListResult, d *schema.ResourceData) bool {
+	region := awsClient.Region(ctx)
+
+	if !l.identity.IsGlobalResource {
+		if err := d.Set(names.AttrRegion, region); err != nil {
+			result.Diagnostics.AddError("setting region", err.Error())
+			return true
+		}
+	}
+
+	if includeResource {
+		if err := sdk.ReadResource(ctx, l.resourceSchema, d, awsClient); err != nil {
+			result.Diagnostics.AddError("reading resource", err.Error())
+			return true
+		}
+
+		if d.Id() == "" {
+			return false
+		}
+	}
+
+	if err := sdkv2.SetIdentity(d, l.identity, awsClient.AccountID, region); err != nil {
+		result.Diagnostics.AddError("setting resource identity", err.Error())
+		return true
+	}
+
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("converting resource identity", err.Error())
+		return true
+	}
+	if result.Identity != nil {
+		result.Identity.Raw = *identity
+	}
+
+	if includeResource {
+		resource, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("converting resource", err.Error())
+			return true
+		}
+		if result.Resource != nil {
+			result.Resource.Raw = *resource
+		}
+	}
+
+	return true
+}
+
+// NewListResultErrorDiagnostic returns a list result containing a single error diagnostic.
+func NewListResultErrorDiagnostic(summary string, err error) list.ListResult {
+	var result list.ListResult
+	result.Diagnostics.AddError(summary, err.Error())
+
+	return result
+}
@@ -35,7 +35,7 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 		return
 	}
 
-	defaultTagsConfig := r.Meta().DefaultTagsConfig
+	defaultTagsConfig := tftags.DefaultConfigFromContext(ctx, r.Meta().DefaultTagsConfig)
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
 
 	var planTags types.Map
@@ -51,6 +51,29 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 			resourceTags := tftags.New(ctx, planTags)
 			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
+			if policyConfig := r.Meta().TagPolicyConfig; policyConfig != nil {
+				isNewResource := request.State.Raw.IsNull()
+				var stateTagsAll types.Map
+				if !isNewResource {
+					response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+					if response.Diagnostics.HasError() {
+						return
+					}
+				}
+
+				for _, v := range policyConfig.ViolationsForChange(isNewResource, tftags.New(ctx, stateTagsAll), allTags) {
+					if policyConfig.IsWarning() {
+						response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), "Tag policy violation", v)
+					} else {
+						response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Tag policy violation", v)
+					}
+				}
+
+				if response.Diagnostics.HasError() {
+					return
+				}
+			}
+
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
 		} else {
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
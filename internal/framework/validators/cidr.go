@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -50,6 +51,28 @@ func IPv4CIDRNetworkAddress() validator.String {
 	return ipv4CIDRNetworkAddressValidator{}
 }
 
+// ValidateParameterString performs the validation of a provider-defined function parameter.
+func (validator ipv4CIDRNetworkAddressValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	if err := verify.ValidateIPv4CIDRBlock(request.Value.ValueString()); err != nil {
+		response.Error = function.NewArgumentFuncError(request.ArgumentPosition, err.Error())
+		return
+	}
+}
+
+// IPv4CIDRNetworkAddressParameter returns a provider-defined function string parameter validator
+// which ensures that any argument value:
+//
+//   - Is a string, which represents a valid IPv4 CIDR network address.
+//
+// Null and unknown values are skipped.
+func IPv4CIDRNetworkAddressParameter() function.StringParameterValidator {
+	return ipv4CIDRNetworkAddressValidator{}
+}
+
 // ipv6CIDRNetworkAddressValidator validates that a string Attribute's value is a valid IPv6 CIDR that represents a network address.
 type ipv6CIDRNetworkAddressValidator struct{}
 
@@ -89,3 +112,25 @@ func (validator ipv6CIDRNetworkAddressValidator) ValidateString(ctx context.Cont
 func IPv6CIDRNetworkAddress() validator.String {
 	return ipv6CIDRNetworkAddressValidator{}
 }
+
+// ValidateParameterString performs the validation of a provider-defined function parameter.
+func (validator ipv6CIDRNetworkAddressValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	if err := verify.ValidateIPv6CIDRBlock(request.Value.ValueString()); err != nil {
+		response.Error = function.NewArgumentFuncError(request.ArgumentPosition, err.Error())
+		return
+	}
+}
+
+// IPv6CIDRNetworkAddressParameter returns a provider-defined function string parameter validator
+// which ensures that any argument value:
+//
+//   - Is a string, which represents a valid IPv6 CIDR network address.
+//
+// Null and unknown values are skipped.
+func IPv6CIDRNetworkAddressParameter() function.StringParameterValidator {
+	return ipv6CIDRNetworkAddressValidator{}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -156,3 +157,61 @@ func TestIPv6CIDRNetworkAddressValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestCIDRNetworkAddressParameterValidators(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator     function.StringParameterValidator
+		val           types.String
+		expectedError *function.FuncError
+	}
+	tests := map[string]testCase{
+		"IPv4 null String": {
+			validator: fwvalidators.IPv4CIDRNetworkAddressParameter(),
+			val:       types.StringNull(),
+		},
+		"IPv4 valid CIDR": {
+			validator: fwvalidators.IPv4CIDRNetworkAddressParameter(),
+			val:       types.StringValue("10.2.2.0/24"),
+		},
+		"IPv4 invalid CIDR": {
+			validator:     fwvalidators.IPv4CIDRNetworkAddressParameter(),
+			val:           types.StringValue("10.2.2.2/24"),
+			expectedError: function.NewArgumentFuncError(0, `"10.2.2.2/24" is not a valid IPv4 CIDR block; did you mean "10.2.2.0/24"?`),
+		},
+		"IPv6 unknown String": {
+			validator: fwvalidators.IPv6CIDRNetworkAddressParameter(),
+			val:       types.StringUnknown(),
+		},
+		"IPv6 valid CIDR": {
+			validator: fwvalidators.IPv6CIDRNetworkAddressParameter(),
+			val:       types.StringValue("2001:db8::/56"),
+		},
+		"IPv6 IPv4 CIDR": {
+			validator:     fwvalidators.IPv6CIDRNetworkAddressParameter(),
+			val:           types.StringValue("10.2.2.0/24"),
+			expectedError: function.NewArgumentFuncError(0, `"10.2.2.0/24" is not a valid IPv6 CIDR block`),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			test.validator.ValidateParameterString(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Error, test.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
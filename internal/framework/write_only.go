@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Write-only attributes are set in configuration but are never persisted to plan or state.
+// Each write-only attribute, e.g. `password_wo`, is paired with a `*_wo_version` attribute
+// which is persisted and whose change signals that the write-only value should be sent to AWS again.
+
+// WriteOnlyStringAttribute returns a write-only string attribute paired with the specified version attribute.
+func WriteOnlyStringAttribute(versionAttributeName string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:  true,
+		Sensitive: true,
+		WriteOnly: true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot(versionAttributeName)),
+		},
+	}
+}
+
+// WriteOnlyVersionAttribute returns the version attribute paired with the specified write-only attribute.
+func WriteOnlyVersionAttribute(writeOnlyAttributeName string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot(writeOnlyAttributeName)),
+		},
+	}
+}
+
+// GetWriteOnlyStringValue returns the value of the write-only string attribute at the specified path.
+// Write-only values are only available from configuration.
+func GetWriteOnlyStringValue(ctx context.Context, config tfsdk.Config, p path.Path) (types.String, diag.Diagnostics) {
+	var v types.String
+	diags := config.GetAttribute(ctx, p, &v)
+
+	return v, diags
+}
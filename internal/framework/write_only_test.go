@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWriteOnlyAttributes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password_wo":         WriteOnlyStringAttribute("password_wo_version"),
+			"password_wo_version": WriteOnlyVersionAttribute("password_wo"),
+		},
+	}
+
+	if diags := s.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	wo, version := s.Attributes["password_wo"], s.Attributes["password_wo_version"]
+
+	if !wo.IsWriteOnly() || !wo.IsSensitive() || !wo.IsOptional() {
+		t.Errorf("write-only attribute: WriteOnly = %t, Sensitive = %t, Optional = %t", wo.IsWriteOnly(), wo.IsSensitive(), wo.IsOptional())
+	}
+	if version.IsWriteOnly() || !version.IsOptional() {
+		t.Errorf("version attribute: WriteOnly = %t, Optional = %t", version.IsWriteOnly(), version.IsOptional())
+	}
+}
+
+func TestGetWriteOnlyStringValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password_wo":         WriteOnlyStringAttribute("password_wo_version"),
+			"password_wo_version": WriteOnlyVersionAttribute("password_wo"),
+		},
+	}
+	objectType := s.Type().TerraformType(ctx)
+
+	testCases := map[string]struct {
+		config        map[string]tftypes.Value
+		path          path.Path
+		expectedValue types.String
+		expectError   bool
+	}{
+		"configured": {
+			config: map[string]tftypes.Value{
+				"password_wo":         tftypes.NewValue(tftypes.String, "avoid-plaintext-passwords"),
+				"password_wo_version": tftypes.NewValue(tftypes.Number, 1),
+			},
+			path:          path.Root("password_wo"),
+			expectedValue: types.StringValue("avoid-plaintext-passwords"),
+		},
+		"not configured": {
+			config: map[string]tftypes.Value{
+				"password_wo":         tftypes.NewValue(tftypes.String, nil),
+				"password_wo_version": tftypes.NewValue(tftypes.Number, nil),
+			},
+			path:          path.Root("password_wo"),
+			expectedValue: types.StringNull(),
+		},
+		"unknown attribute": {
+			config: map[string]tftypes.Value{
+				"password_wo":         tftypes.NewValue(tftypes.String, nil),
+				"password_wo_version": tftypes.NewValue(tftypes.Number, nil),
+			},
+			path:        path.Root("secret_wo"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.Config{
+				Raw:    tftypes.NewValue(objectType, testCase.config),
+				Schema: s,
+			}
+
+			got, diags := GetWriteOnlyStringValue(ctx, config, testCase.path)
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Fatalf("HasError = %t, want %t: %v", got, want, diags)
+			}
+			if testCase.expectError {
+				return
+			}
+
+			if !got.Equal(testCase.expectedValue) {
+				t.Errorf("value = %s, want %s", got, testCase.expectedValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"encoding/binary"
+	"net/netip"
+)
+
+const (
+	// AWS reserves the first four and the last IPv4 address in each subnet.
+	// See https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html.
+	subnetReservedLeadingIPv4Addresses  = 4
+	subnetReservedTrailingIPv4Addresses = 1
+
+	vpcMinIPv4PrefixLength = 16
+	vpcMaxIPv4PrefixLength = 28
+
+	vpcMinIPv6PrefixLength = 44
+	vpcMaxIPv6PrefixLength = 60
+	subnetIPv6PrefixLength = 64
+)
+
+// ipv4AddrToUint32 returns the numeric value of an IPv4 address.
+func ipv4AddrToUint32(addr netip.Addr) uint32 {
+	b := addr.As4()
+	return binary.BigEndian.Uint32(b[:])
+}
+
+// uint32ToIPv4Addr returns the IPv4 address with the specified numeric value.
+func uint32ToIPv4Addr(v uint32) netip.Addr {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return netip.AddrFrom4(b)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrOverlapsFunction{}
+
+func NewCIDROverlapsFunction() function.Function {
+	return &cidrOverlapsFunction{}
+}
+
+type cidrOverlapsFunction struct{}
+
+func (f cidrOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f cidrOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_overlaps Function",
+		MarkdownDescription: "Checks whether any two of a list of CIDR blocks overlap. " +
+			"IPv4 and IPv6 CIDR blocks may be mixed; CIDR blocks of different address families never overlap.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "cidr_blocks",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IPv4 or IPv6 CIDR blocks",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlocks []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlocks))
+	if resp.Error != nil {
+		return
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(cidrBlocks))
+	for i, cidrBlock := range cidrBlocks {
+		if err := itypes.ValidateCIDRBlock(cidrBlock); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("CIDR block %d: %s", i, err)))
+			return
+		}
+
+		prefix, err := netip.ParsePrefix(cidrBlock)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("CIDR block %d: %s", i, err)))
+			return
+		}
+
+		prefixes = append(prefixes, prefix)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, cidrBlocksOverlap(prefixes)))
+}
+
+// cidrBlocksOverlap returns whether any two of the specified CIDR blocks overlap.
+func cidrBlocksOverlap(prefixes []netip.Prefix) bool {
+	for i := range prefixes {
+		for j := i + 1; j < len(prefixes); j++ {
+			if prefixes[i].Overlaps(prefixes[j]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ function.Function = endpointURLFunction{}
+
+func NewEndpointURLFunction() function.Function {
+	return &endpointURLFunction{}
+}
+
+type endpointURLFunction struct{}
+
+func (f endpointURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "endpoint_url"
+}
+
+func (f endpointURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "endpoint_url Function",
+		MarkdownDescription: "Returns the URL of a service's regional endpoint in an AWS Region. " +
+			"FIPS and dual-stack (IPv4 and IPv6) endpoint variants can be requested.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "Service endpoint prefix, e.g. `ec2` or `monitoring`",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "AWS Region",
+			},
+			function.BoolParameter{
+				Name:                "fips",
+				MarkdownDescription: "Whether to return the FIPS endpoint",
+			},
+			function.BoolParameter{
+				Name:                "dualstack",
+				MarkdownDescription: "Whether to return the dual-stack endpoint",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f endpointURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var service, region string
+	var fips, dualStack bool
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &service, &region, &fips, &dualStack))
+	if resp.Error != nil {
+		return
+	}
+
+	if service == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "service must not be empty"))
+		return
+	}
+
+	if region == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "region must not be empty"))
+		return
+	}
+
+	result, err := endpointURL(service, region, fips, dualStack)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// endpointURL returns the URL of a service's regional endpoint, following the AWS SDKs' default endpoint rules.
+func endpointURL(service, region string, fips, dualStack bool) (string, error) {
+	partition := names.PartitionForRegion(region)
+	dnsSuffix := names.DNSSuffixForPartition(partition)
+
+	if dualStack {
+		dnsSuffix = names.DualStackDNSSuffixForPartition(partition)
+
+		if dnsSuffix == "" {
+			return "", fmt.Errorf("dual-stack endpoints are not supported in partition (%s)", partition)
+		}
+	}
+
+	hostnamePrefix := service
+	if fips {
+		hostnamePrefix += "-fips"
+	}
+
+	return fmt.Sprintf("https://%s.%s.%s", hostnamePrefix, region, dnsSuffix), nil
+}
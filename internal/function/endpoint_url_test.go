@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEndpointURLFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEndpointURLFunctionConfig("sts", "us-west-2", false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://sts.us-west-2.amazonaws.com"),
+				),
+			},
+			{
+				Config: testEndpointURLFunctionConfig("ec2", "us-gov-west-1", true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://ec2-fips.us-gov-west-1.api.aws"),
+				),
+			},
+			{
+				Config: testEndpointURLFunctionConfig("ec2", "cn-north-1", false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://ec2.cn-north-1.amazonaws.com.cn"),
+				),
+			},
+		},
+	})
+}
+
+func TestEndpointURLFunction_dualStackNotSupported(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEndpointURLFunctionConfig("ec2", "us-iso-east-1", false, true),
+				ExpectError: regexache.MustCompile(`dual-stack[\s\n]*endpoints[\s\n]*are[\s\n]*not[\s\n]*supported`),
+			},
+		},
+	})
+}
+
+func testEndpointURLFunctionConfig(service, region string, fips, dualStack bool) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::endpoint_url(%[1]q, %[2]q, %[3]t, %[4]t)
+}
+`, service, region, fips, dualStack)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+var _ function.Function = ipv6SubnetFunction{}
+
+func NewIPv6SubnetFunction() function.Function {
+	return &ipv6SubnetFunction{}
+}
+
+type ipv6SubnetFunction struct{}
+
+func (f ipv6SubnetFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ipv6_subnet"
+}
+
+func (f ipv6SubnetFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ipv6_subnet Function",
+		MarkdownDescription: "Returns the /64 IPv6 subnet CIDR block with the specified index within a VPC IPv6 CIDR block. " +
+			"For example, index 1 of a /56 VPC CIDR block is its second /64 subnet.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "VPC IPv6 CIDR block",
+				Validators: []function.StringParameterValidator{
+					fwvalidators.IPv6CIDRNetworkAddressParameter(),
+				},
+			},
+			function.Int64Parameter{
+				Name:                "netnum",
+				MarkdownDescription: "Index of the /64 subnet within the VPC CIDR block",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ipv6SubnetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var netnum int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &netnum))
+	if resp.Error != nil {
+		return
+	}
+
+	vpc, err := netip.ParsePrefix(cidrBlock)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if bits := vpc.Bits(); bits < vpcMinIPv6PrefixLength || bits > vpcMaxIPv6PrefixLength {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("VPC IPv6 CIDR block prefix length must be between /%d and /%d, got /%d", vpcMinIPv6PrefixLength, vpcMaxIPv6PrefixLength, bits)))
+		return
+	}
+
+	if n := int64(1) << (subnetIPv6PrefixLength - vpc.Bits()); netnum < 0 || netnum >= n {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("netnum must be between 0 and %d for a /%d CIDR block, got %d", n-1, vpc.Bits(), netnum)))
+		return
+	}
+
+	// The subnet number occupies the low-order bits of the /64 routing prefix.
+	b := vpc.Addr().As16()
+	binary.BigEndian.PutUint64(b[:8], binary.BigEndian.Uint64(b[:8])+uint64(netnum))
+	result := netip.PrefixFrom(netip.AddrFrom16(b), subnetIPv6PrefixLength).String()
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
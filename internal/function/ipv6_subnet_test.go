@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIPv6SubnetFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIPv6SubnetFunctionConfig("2600:1f14:abc:de00::/56", 255),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2600:1f14:abc:deff::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestIPv6SubnetFunction_netnumOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIPv6SubnetFunctionConfig("2600:1f14:abc:de00::/56", 256),
+				ExpectError: regexache.MustCompile(`netnum[\s\n]*must[\s\n]*be[\s\n]*between[\s\n]*0[\s\n]*and[\s\n]*255`),
+			},
+		},
+	})
+}
+
+func TestIPv6SubnetFunction_invalidCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIPv6SubnetFunctionConfig("10.0.0.0/16", 0),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*IPv6[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func testIPv6SubnetFunctionConfig(cidrBlock string, netnum int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::ipv6_subnet(%[1]q, %[2]d)
+}
+`, cidrBlock, netnum)
+}
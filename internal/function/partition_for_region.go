@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var partitionForRegionResultAttrTypes = map[string]attr.Type{
+	"partition":            types.StringType,
+	"dns_suffix":           types.StringType,
+	"dualstack_dns_suffix": types.StringType,
+	"reverse_dns_prefix":   types.StringType,
+}
+
+var _ function.Function = partitionForRegionFunction{}
+
+func NewPartitionForRegionFunction() function.Function {
+	return &partitionForRegionFunction{}
+}
+
+type partitionForRegionFunction struct{}
+
+func (f partitionForRegionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "partition_for_region"
+}
+
+func (f partitionForRegionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "partition_for_region Function",
+		MarkdownDescription: "Returns details of the AWS partition that contains an AWS Region",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "AWS Region",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: partitionForRegionResultAttrTypes,
+		},
+	}
+}
+
+func (f partitionForRegionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	if region == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "region must not be empty"))
+		return
+	}
+
+	partition := names.PartitionForRegion(region)
+	dnsSuffix := names.DNSSuffixForPartition(partition)
+
+	value := map[string]attr.Value{
+		"partition":            types.StringValue(partition),
+		"dns_suffix":           types.StringValue(dnsSuffix),
+		"dualstack_dns_suffix": types.StringValue(names.DualStackDNSSuffixForPartition(partition)),
+		"reverse_dns_prefix":   types.StringValue(names.ReverseDNS(dnsSuffix)),
+	}
+
+	result, d := types.ObjectValue(partitionForRegionResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
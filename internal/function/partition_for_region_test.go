@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestPartitionForRegionFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPartitionForRegionFunctionConfig("cn-northwest-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("partition", "aws-cn"),
+					resource.TestCheckOutput("dns_suffix", "amazonaws.com.cn"),
+					resource.TestCheckOutput("reverse_dns_prefix", "cn.com.amazonaws"),
+				),
+			},
+			{
+				Config: testPartitionForRegionFunctionConfig("us-gov-east-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("partition", "aws-us-gov"),
+					resource.TestCheckOutput("dns_suffix", "amazonaws.com"),
+					resource.TestCheckOutput("reverse_dns_prefix", "com.amazonaws"),
+				),
+			},
+		},
+	})
+}
+
+func TestPartitionForRegionFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testPartitionForRegionFunctionConfig(""),
+				ExpectError: regexache.MustCompile(`region[\s\n]*must[\s\n]*not[\s\n]*be[\s\n]*empty`),
+			},
+		},
+	})
+}
+
+func testPartitionForRegionFunctionConfig(region string) string {
+	return fmt.Sprintf(`
+locals {
+  partition = provider::aws::partition_for_region(%[1]q)
+}
+
+output "partition" {
+  value = local.partition.partition
+}
+
+output "dns_suffix" {
+  value = local.partition.dns_suffix
+}
+
+output "reverse_dns_prefix" {
+  value = local.partition.reverse_dns_prefix
+}
+`, region)
+}
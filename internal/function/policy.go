@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+// parsePolicyDocument parses an IAM policy document.
+// A single statement object is accepted in place of a list of statements.
+func parsePolicyDocument(policy string) (*tfiam.IAMPolicyDoc, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, fmt.Errorf("parsing policy document: %w", err)
+	}
+
+	if v, ok := raw["Statement"]; ok {
+		var statement map[string]any
+		if err := json.Unmarshal(v, &statement); err == nil {
+			raw["Statement"] = append(append([]byte("["), v...), ']')
+		}
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc tfiam.IAMPolicyDoc
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("parsing policy document: %w", err)
+	}
+
+	for _, statement := range doc.Statements {
+		statement.Actions = normalizePolicyStringList(statement.Actions)
+		statement.NotActions = normalizePolicyStringList(statement.NotActions)
+		statement.Resources = normalizePolicyStringList(statement.Resources)
+		statement.NotResources = normalizePolicyStringList(statement.NotResources)
+	}
+
+	return &doc, nil
+}
+
+// formatPolicyDocument returns the JSON representation of an IAM policy document,
+// in the same format as the aws_iam_policy_document data source's `json` attribute.
+func formatPolicyDocument(doc *tfiam.IAMPolicyDoc) (string, error) {
+	b, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("formatting policy document: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// normalizePolicyStringList returns a single string for a one-element list
+// and a reverse-sorted list otherwise, matching the aws_iam_policy_document data source.
+func normalizePolicyStringList(v any) any {
+	l, ok := v.([]any)
+	if !ok {
+		return v
+	}
+
+	if len(l) == 1 {
+		return l[0]
+	}
+
+	s := make([]string, len(l))
+	for i, v := range l {
+		s[i] = fmt.Sprint(v)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(s)))
+
+	return s
+}
+
+// policyStringList returns a string or list of strings as a list of strings.
+func policyStringList(v any) []string {
+	switch v := v.(type) {
+	case nil:
+		return []string{}
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		s := make([]string, len(v))
+		for i, v := range v {
+			s[i] = fmt.Sprint(v)
+		}
+		return s
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = policyEquivalentFunction{}
+
+func NewPolicyEquivalentFunction() function.Function {
+	return &policyEquivalentFunction{}
+}
+
+type policyEquivalentFunction struct{}
+
+func (f policyEquivalentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_equivalent"
+}
+
+func (f policyEquivalentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "policy_equivalent Function",
+		MarkdownDescription: "Checks whether two IAM policy documents are semantically equivalent, " +
+			"ignoring differences such as whitespace, element order and single-element lists.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy1",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+			function.StringParameter{
+				Name:                "policy2",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f policyEquivalentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy1, policy2 string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policy1, &policy2))
+	if resp.Error != nil {
+		return
+	}
+
+	// PolicyStringsEquivalent reports invalid JSON as not equivalent.
+	for i, v := range []string{policy1, policy2} {
+		if v := strings.TrimSpace(v); v != "" && !json.Valid([]byte(v)) {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), "policy document is not valid JSON"))
+		}
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, verify.PolicyStringsEquivalent(policy1, policy2)))
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+var _ function.Function = policyMergeFunction{}
+
+func NewPolicyMergeFunction() function.Function {
+	return &policyMergeFunction{}
+}
+
+type policyMergeFunction struct{}
+
+func (f policyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_merge"
+}
+
+func (f policyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "policy_merge Function",
+		MarkdownDescription: "Merges a list of IAM policy documents into a single policy document. " +
+			"Documents are merged in order and a statement replaces any earlier statement with the same `Sid`. " +
+			"The result is formatted in the same way as the `json` attribute of the `aws_iam_policy_document` data source.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IAM policy documents in JSON format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f policyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []*string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies))
+	if resp.Error != nil {
+		return
+	}
+
+	merged := &tfiam.IAMPolicyDoc{}
+
+	for i, policy := range policies {
+		if policy == nil {
+			continue
+		}
+
+		doc, err := parsePolicyDocument(*policy)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("policy document %d: %s", i, err)))
+			return
+		}
+
+		merged.Merge(doc)
+	}
+
+	result, err := formatPolicyDocument(merged)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
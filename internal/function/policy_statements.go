@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+var (
+	policyStatementConditionAttrTypes = map[string]attr.Type{
+		"test":     types.StringType,
+		"variable": types.StringType,
+		"values":   types.ListType{ElemType: types.StringType},
+	}
+	policyStatementAttrTypes = map[string]attr.Type{
+		"sid":            types.StringType,
+		"effect":         types.StringType,
+		"actions":        types.ListType{ElemType: types.StringType},
+		"not_actions":    types.ListType{ElemType: types.StringType},
+		"resources":      types.ListType{ElemType: types.StringType},
+		"not_resources":  types.ListType{ElemType: types.StringType},
+		"principals":     types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+		"not_principals": types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+		"conditions":     types.ListType{ElemType: types.ObjectType{AttrTypes: policyStatementConditionAttrTypes}},
+		"json":           types.StringType,
+	}
+)
+
+var _ function.Function = policyStatementsFunction{}
+
+func NewPolicyStatementsFunction() function.Function {
+	return &policyStatementsFunction{}
+}
+
+type policyStatementsFunction struct{}
+
+func (f policyStatementsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_statements"
+}
+
+func (f policyStatementsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "policy_statements Function",
+		MarkdownDescription: "Returns the statements of an IAM policy document as a list of objects",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: policyStatementAttrTypes,
+			},
+		},
+	}
+}
+
+func (f policyStatementsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	doc, err := parsePolicyDocument(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	var diags diag.Diagnostics
+	statements := make([]attr.Value, 0, len(doc.Statements))
+
+	for _, statement := range doc.Statements {
+		v, d := flattenPolicyStatement(ctx, statement)
+		diags.Append(d...)
+		if diags.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+			return
+		}
+
+		statements = append(statements, v)
+	}
+
+	result, d := types.ListValue(types.ObjectType{AttrTypes: policyStatementAttrTypes}, statements)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func flattenPolicyStatement(ctx context.Context, statement *tfiam.IAMPolicyStatement) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	b, err := json.Marshal(statement)
+	if err != nil {
+		diags.AddError("formatting policy statement", err.Error())
+		return nil, diags
+	}
+
+	principals, d := flattenPolicyStatementPrincipals(ctx, statement.Principals)
+	diags.Append(d...)
+	notPrincipals, d := flattenPolicyStatementPrincipals(ctx, statement.NotPrincipals)
+	diags.Append(d...)
+	conditions, d := flattenPolicyStatementConditions(ctx, statement.Conditions)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	value := map[string]attr.Value{
+		"sid":            types.StringValue(statement.Sid),
+		"effect":         types.StringValue(statement.Effect),
+		"actions":        flattenPolicyStringList(statement.Actions),
+		"not_actions":    flattenPolicyStringList(statement.NotActions),
+		"resources":      flattenPolicyStringList(statement.Resources),
+		"not_resources":  flattenPolicyStringList(statement.NotResources),
+		"principals":     principals,
+		"not_principals": notPrincipals,
+		"conditions":     conditions,
+		"json":           types.StringValue(string(b)),
+	}
+
+	return types.ObjectValue(policyStatementAttrTypes, value)
+}
+
+func flattenPolicyStatementPrincipals(ctx context.Context, principals tfiam.IAMPolicyStatementPrincipalSet) (attr.Value, diag.Diagnostics) {
+	m := make(map[string][]string)
+
+	for _, principal := range principals {
+		m[principal.Type] = append(m[principal.Type], policyStringList(principal.Identifiers)...)
+	}
+
+	for _, v := range m {
+		sort.Strings(v)
+	}
+
+	return types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, m)
+}
+
+func flattenPolicyStatementConditions(ctx context.Context, conditions tfiam.IAMPolicyStatementConditionSet) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elems := make([]attr.Value, 0, len(conditions))
+
+	// Condition sets are unordered.
+	conditions = slices.Clone(conditions)
+	slices.SortFunc(conditions, func(a, b tfiam.IAMPolicyStatementCondition) int {
+		return cmp.Or(cmp.Compare(a.Test, b.Test), cmp.Compare(a.Variable, b.Variable))
+	})
+
+	for _, condition := range conditions {
+		v, d := types.ObjectValue(policyStatementConditionAttrTypes, map[string]attr.Value{
+			"test":     types.StringValue(condition.Test),
+			"variable": types.StringValue(condition.Variable),
+			"values":   flattenPolicyStringList(condition.Values),
+		})
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		elems = append(elems, v)
+	}
+
+	return types.ListValue(types.ObjectType{AttrTypes: policyStatementConditionAttrTypes}, elems)
+}
+
+func flattenPolicyStringList(v any) attr.Value {
+	elems := make([]attr.Value, 0)
+
+	for _, v := range policyStringList(v) {
+		elems = append(elems, types.StringValue(v))
+	}
+
+	return types.ListValueMust(types.StringType, elems)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestPolicyStatementsFunction_valid(t *testing.T) {
+	t.Parallel()
+	arg := `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"*","Principal":{"AWS":"arn:aws:iam::123456789012:root"}},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPolicyStatementsFunctionConfig(arg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "2"),
+					resource.TestCheckOutput("sid", "Read"),
+					resource.TestCheckOutput("actions", "s3:ListBucket,s3:GetObject"),
+					resource.TestCheckOutput("principal", "arn:aws:iam::123456789012:root"),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyStatementsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testPolicyStatementsFunctionConfig("invalid"),
+				ExpectError: regexache.MustCompile(`parsing[\s\n]*policy[\s\n]*document`),
+			},
+		},
+	})
+}
+
+func testPolicyStatementsFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  statements = provider::aws::policy_statements(%[1]q)
+}
+
+output "count" {
+  value = length(local.statements)
+}
+
+output "sid" {
+  value = local.statements[0].sid
+}
+
+output "actions" {
+  value = join(",", local.statements[0].actions)
+}
+
+output "principal" {
+  value = local.statements[0].principals["AWS"][0]
+}
+`, arg)
+}
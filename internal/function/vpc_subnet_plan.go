@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+var vpcSubnetPlanSubnetAttrTypes = map[string]attr.Type{
+	"name":          types.StringType,
+	"prefix_length": types.Int64Type,
+}
+
+var vpcSubnetPlanResultAttrTypes = map[string]attr.Type{
+	"name":                 types.StringType,
+	"cidr_block":           types.StringType,
+	"prefix_length":        types.Int64Type,
+	"network_address":      types.StringType,
+	"broadcast_address":    types.StringType,
+	"first_usable_address": types.StringType,
+	"last_usable_address":  types.StringType,
+	"usable_address_count": types.Int64Type,
+}
+
+var _ function.Function = vpcSubnetPlanFunction{}
+
+func NewVPCSubnetPlanFunction() function.Function {
+	return &vpcSubnetPlanFunction{}
+}
+
+type vpcSubnetPlanFunction struct{}
+
+type vpcSubnetPlanSubnet struct {
+	Name         string `tfsdk:"name"`
+	PrefixLength int64  `tfsdk:"prefix_length"`
+}
+
+func (f vpcSubnetPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vpc_subnet_plan"
+}
+
+func (f vpcSubnetPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "vpc_subnet_plan Function",
+		MarkdownDescription: "Allocates IPv4 subnet CIDR blocks from a VPC CIDR block. " +
+			"Subnets are allocated in order, each at the lowest available address aligned to its size. " +
+			"AWS VPC and subnet size limits and reserved addresses are taken into account.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "VPC IPv4 CIDR block",
+				Validators: []function.StringParameterValidator{
+					fwvalidators.IPv4CIDRNetworkAddressParameter(),
+				},
+			},
+			function.ListParameter{
+				Name:                "subnets",
+				ElementType:         types.ObjectType{AttrTypes: vpcSubnetPlanSubnetAttrTypes},
+				MarkdownDescription: "Subnets to allocate, each with a unique `name` and a `prefix_length`",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: vpcSubnetPlanResultAttrTypes},
+		},
+	}
+}
+
+func (f vpcSubnetPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var subnets []vpcSubnetPlanSubnet
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &subnets))
+	if resp.Error != nil {
+		return
+	}
+
+	vpc, err := netip.ParsePrefix(cidrBlock)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if bits := vpc.Bits(); bits < vpcMinIPv4PrefixLength || bits > vpcMaxIPv4PrefixLength {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("VPC CIDR block prefix length must be between /%d and /%d, got /%d", vpcMinIPv4PrefixLength, vpcMaxIPv4PrefixLength, bits)))
+		return
+	}
+
+	planned, err := planVPCSubnets(vpc, subnets)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	elems := make([]attr.Value, 0, len(planned))
+	for i, subnet := range planned {
+		v, d := types.ObjectValue(vpcSubnetPlanResultAttrTypes, flattenVPCSubnetPlanResult(subnets[i].Name, subnet))
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+
+		elems = append(elems, v)
+	}
+
+	result, d := types.ListValue(types.ObjectType{AttrTypes: vpcSubnetPlanResultAttrTypes}, elems)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// planVPCSubnets allocates the specified subnets, in order, from the VPC CIDR block.
+// Each subnet is placed at the lowest unallocated address that is aligned to its size.
+func planVPCSubnets(vpc netip.Prefix, subnets []vpcSubnetPlanSubnet) ([]netip.Prefix, error) {
+	planned := make([]netip.Prefix, 0, len(subnets))
+	seen := make(map[string]bool, len(subnets))
+	next := uint64(ipv4AddrToUint32(vpc.Addr()))
+	end := next + uint64(1)<<(32-vpc.Bits())
+
+	for _, subnet := range subnets {
+		if subnet.Name == "" {
+			return nil, fmt.Errorf("subnet name must not be empty")
+		}
+
+		if seen[subnet.Name] {
+			return nil, fmt.Errorf("duplicate subnet name (%s)", subnet.Name)
+		}
+		seen[subnet.Name] = true
+
+		if bits := subnet.PrefixLength; bits < int64(vpc.Bits()) || bits > vpcMaxIPv4PrefixLength {
+			return nil, fmt.Errorf("subnet (%s) prefix length must be between /%d and /%d, got /%d", subnet.Name, vpc.Bits(), vpcMaxIPv4PrefixLength, bits)
+		}
+
+		size := uint64(1) << (32 - subnet.PrefixLength)
+		start := (next + size - 1) &^ (size - 1)
+
+		if start+size > end {
+			return nil, fmt.Errorf("subnet (%s) /%d does not fit in the remaining address space of %s", subnet.Name, subnet.PrefixLength, vpc)
+		}
+
+		planned = append(planned, netip.PrefixFrom(uint32ToIPv4Addr(uint32(start)), int(subnet.PrefixLength)))
+		next = start + size
+	}
+
+	return planned, nil
+}
+
+func flattenVPCSubnetPlanResult(name string, subnet netip.Prefix) map[string]attr.Value {
+	network := ipv4AddrToUint32(subnet.Addr())
+	size := uint32(1) << (32 - subnet.Bits())
+	broadcast := network + size - 1
+
+	return map[string]attr.Value{
+		"name":                 types.StringValue(name),
+		"cidr_block":           types.StringValue(subnet.String()),
+		"prefix_length":        types.Int64Value(int64(subnet.Bits())),
+		"network_address":      types.StringValue(subnet.Addr().String()),
+		"broadcast_address":    types.StringValue(uint32ToIPv4Addr(broadcast).String()),
+		"first_usable_address": types.StringValue(uint32ToIPv4Addr(network + subnetReservedLeadingIPv4Addresses).String()),
+		"last_usable_address":  types.StringValue(uint32ToIPv4Addr(broadcast - subnetReservedTrailingIPv4Addresses).String()),
+		"usable_address_count": types.Int64Value(int64(size - subnetReservedLeadingIPv4Addresses - subnetReservedTrailingIPv4Addresses)),
+	}
+}
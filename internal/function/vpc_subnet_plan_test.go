@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestVPCSubnetPlanFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetPlanFunctionConfig("10.0.0.0/16", `[
+    { name = "public-a", prefix_length = 24 },
+    { name = "private-a", prefix_length = 20 },
+    { name = "db-a", prefix_length = 28 },
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("cidr_blocks", "10.0.0.0/24,10.0.16.0/20,10.0.32.0/28"),
+					resource.TestCheckOutput("first_usable_address", "10.0.0.4"),
+					resource.TestCheckOutput("last_usable_address", "10.0.0.254"),
+					resource.TestCheckOutput("usable_address_count", "251"),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_vpcTooLarge(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/8", `[{ name = "a", prefix_length = 24 }]`),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length[\s\n]*must[\s\n]*be[\s\n]*between[\s\n]*/16[\s\n]*and[\s\n]*/28`),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_doesNotFit(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/24", `[{ name = "a", prefix_length = 25 }, { name = "b", prefix_length = 26 }, { name = "c", prefix_length = 25 }]`),
+				ExpectError: regexache.MustCompile(`subnet[\s\n]*\(c\)[\s\n]*/25[\s\n]*does[\s\n]*not[\s\n]*fit`),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_invalidCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.1/16", `[]`),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*IPv4[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func testVPCSubnetPlanFunctionConfig(cidrBlock, subnets string) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::vpc_subnet_plan(%[1]q, %[2]s)
+}
+
+output "cidr_blocks" {
+  value = join(",", local.subnets[*].cidr_block)
+}
+
+output "first_usable_address" {
+  value = try(local.subnets[0].first_usable_address, "")
+}
+
+output "last_usable_address" {
+  value = try(local.subnets[0].last_usable_address, "")
+}
+
+output "usable_address_count" {
+  value = try(local.subnets[0].usable_address_count, 0)
+}
+`, cidrBlock, subnets)
+}
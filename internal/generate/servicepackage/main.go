@@ -16,6 +16,7 @@ import (
 	"os"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -26,7 +27,8 @@ import (
 
 func main() {
 	const (
-		filename = `service_package_gen.go`
+		filename             = `service_package_gen.go`
+		identityTestFilename = `service_identity_gen_test.go`
 	)
 	g := common.NewGenerator()
 
@@ -48,14 +50,18 @@ func main() {
 			continue
 		}
 
-		// Look for Terraform Plugin Framework and SDK resource and data source annotations.
+		// Look for Terraform Plugin Framework and SDK resource and data source annotations,
+		// and Terraform Plugin Framework ephemeral and list resource annotations.
 		// These annotations are implemented as comments on factory functions.
 		v := &visitor{
-			g: g,
+			g:        g,
+			isGlobal: names.IsGlobalService(p),
 
+			ephemeralResources:   make([]ResourceDatum, 0),
 			frameworkDataSources: make([]ResourceDatum, 0),
 			frameworkResources:   make([]ResourceDatum, 0),
 			sdkDataSources:       make(map[string]ResourceDatum),
+			sdkListResources:     make(map[string]ResourceDatum),
 			sdkResources:         make(map[string]ResourceDatum),
 		}
 
@@ -71,9 +77,11 @@ func main() {
 			GoV2Package:          l.GoV2Package(),
 			ProviderPackage:      p,
 			ProviderNameUpper:    l.ProviderNameUpper(),
+			EphemeralResources:   v.ephemeralResources,
 			FrameworkDataSources: v.frameworkDataSources,
 			FrameworkResources:   v.frameworkResources,
 			SDKDataSources:       v.sdkDataSources,
+			SDKListResources:     v.sdkListResources,
 			SDKResources:         v.sdkResources,
 		}
 
@@ -82,6 +90,9 @@ func main() {
 			s.GoV1ClientTypeName = l.GoV1ClientTypeName()
 		}
 
+		sort.SliceStable(s.EphemeralResources, func(i, j int) bool {
+			return s.EphemeralResources[i].FactoryName < s.EphemeralResources[j].FactoryName
+		})
 		sort.SliceStable(s.FrameworkDataSources, func(i, j int) bool {
 			return s.FrameworkDataSources[i].FactoryName < s.FrameworkDataSources[j].FactoryName
 		})
@@ -99,6 +110,23 @@ func main() {
 			g.Fatalf("generating file (%s): %s", filename, err)
 		}
 
+		// Verify that resource identities round-trip through resource IDs and state.
+		if s.FrameworkResourcesWithIdentity() || s.SDKResourcesWithIdentity() {
+			g.Infof("Generating internal/service/%s/%s", servicePackage, identityTestFilename)
+
+			d := g.NewGoFileDestination(identityTestFilename)
+
+			if err := d.WriteTemplate("identitytest", identityTestTmpl, s); err != nil {
+				g.Fatalf("error generating %s resource identity tests: %s", p, err)
+			}
+
+			if err := d.Write(); err != nil {
+				g.Fatalf("generating file (%s): %s", identityTestFilename, err)
+			}
+		} else if err := os.Remove(identityTestFilename); err != nil && !errors.Is(err, os.ErrNotExist) {
+			g.Fatalf("removing file (%s): %s", identityTestFilename, err)
+		}
+
 		break
 	}
 }
@@ -109,6 +137,35 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	IdentityAttributes      []IdentityAttributeDatum
+	IdentityIDSeparator     string
+	IsGlobal                bool // Global (not regional) resource?
+	IAMActions              *IAMActionsDatum
+}
+
+// IAMActionsDatum represents the IAM actions required by a resource's CRUD handlers.
+type IAMActionsDatum struct {
+	Create []string
+	Read   []string
+	Update []string
+	Delete []string
+}
+
+// IdentityAttributeDatum represents a resource identity attribute.
+type IdentityAttributeDatum struct {
+	name     string // Attribute name
+	Name     string // Attribute name constant or quoted attribute name, for use in templates
+	Required bool   // Required attributes form the resource's ID
+}
+
+// HasIdentity returns whether the resource has a resource identity.
+func (d ResourceDatum) HasIdentity() bool {
+	return len(d.IdentityAttributes) > 0
+}
+
+// IsSingleParameterIdentity returns whether the resource's ID is the value of a single identity attribute.
+func (d ResourceDatum) IsSingleParameterIdentity() bool {
+	return len(d.IdentityAttributes) == 1 && d.IdentityAttributes[0].Required && d.IdentityIDSeparator == ""
 }
 
 type ServiceDatum struct {
@@ -119,31 +176,55 @@ type ServiceDatum struct {
 	GoV2Package          string // AWS SDK for Go v2 package name
 	ProviderPackage      string
 	ProviderNameUpper    string
+	EphemeralResources   []ResourceDatum
 	FrameworkDataSources []ResourceDatum
 	FrameworkResources   []ResourceDatum
 	SDKDataSources       map[string]ResourceDatum
+	SDKListResources     map[string]ResourceDatum
 	SDKResources         map[string]ResourceDatum
 }
 
+// FrameworkResourcesWithIdentity returns whether any Plugin Framework resource has a resource identity.
+func (d ServiceDatum) FrameworkResourcesWithIdentity() bool {
+	return slices.ContainsFunc(d.FrameworkResources, ResourceDatum.HasIdentity)
+}
+
+// SDKResourcesWithIdentity returns whether any Plugin SDK resource has a resource identity.
+func (d ServiceDatum) SDKResourcesWithIdentity() bool {
+	for _, v := range d.SDKResources {
+		if v.HasIdentity() {
+			return true
+		}
+	}
+
+	return false
+}
+
 //go:embed file.tmpl
 var tmpl string
 
+//go:embed identity_test.tmpl
+var identityTestTmpl string
+
 // Annotation processing.
 var (
 	annotation = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
 )
 
 type visitor struct {
-	errs []error
-	g    *common.Generator
+	errs     []error
+	g        *common.Generator
+	isGlobal bool // Global (not regional) service?
 
 	fileName     string
 	functionName string
 	packageName  string
 
+	ephemeralResources   []ResourceDatum
 	frameworkDataSources []ResourceDatum
 	frameworkResources   []ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
+	sdkListResources     map[string]ResourceDatum
 	sdkResources         map[string]ResourceDatum
 }
 
@@ -182,16 +263,80 @@ func (v *visitor) processFile(file *ast.File) {
 }
 
 // processFuncDecl processes a single Go function.
-// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source.
+// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source,
+// or a Plugin Framework ephemeral or list resource.
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
-	d := ResourceDatum{}
+	// Look first for tagging and identity annotations.
+	d := ResourceDatum{
+		IsGlobal: v.isGlobal,
+	}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "IdentityAttribute" {
+			args := common.ParseArgs(m[3])
+
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no identity attribute name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			name := args.Positional[0]
+
+			if slices.ContainsFunc(d.IdentityAttributes, func(attr IdentityAttributeDatum) bool { return attr.name == name }) {
+				v.errs = append(v.errs, fmt.Errorf("duplicate IdentityAttribute (%s): %s", name, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			attr := IdentityAttributeDatum{
+				name:     name,
+				Name:     names.ConstOrQuote(name),
+				Required: true,
+			}
+
+			if s, ok := args.Keyword["required"]; ok {
+				b, err := strconv.ParseBool(s)
+				if err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid required value (%s): %s: %w", name, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+					continue
+				}
+				attr.Required = b
+			}
+
+			d.IdentityAttributes = append(d.IdentityAttributes, attr)
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "IdentityIDSeparator" {
+			args := common.ParseArgs(m[3])
+
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no identity ID separator: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			d.IdentityIDSeparator = args.Positional[0]
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "IAMActions" {
+			args := common.ParseArgs(m[3])
+
+			if d.IAMActions != nil {
+				v.errs = append(v.errs, fmt.Errorf("multiple IAMActions annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			// Multiple actions are separated by semicolons, e.g. create="sqs:CreateQueue;sqs:TagQueue".
+			d.IAMActions = &IAMActionsDatum{
+				Create: iamActions(args.Keyword["create"]),
+				Read:   iamActions(args.Keyword["read"]),
+				Update: iamActions(args.Keyword["update"]),
+				Delete: iamActions(args.Keyword["delete"]),
+			}
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -224,6 +369,12 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			}
 
 			switch annotationName := m[1]; annotationName {
+			case "EphemeralResource":
+				if slices.ContainsFunc(v.ephemeralResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
+					v.errs = append(v.errs, fmt.Errorf("duplicate Ephemeral Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.ephemeralResources = append(v.ephemeralResources, d)
+				}
 			case "FrameworkDataSource":
 				if slices.ContainsFunc(v.frameworkDataSources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Framework Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -249,6 +400,19 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkDataSources[typeName] = d
 				}
+			case "SDKListResource":
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if _, ok := v.sdkListResources[typeName]; ok {
+					v.errs = append(v.errs, fmt.Errorf("duplicate SDK List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.sdkListResources[typeName] = d
+				}
 			case "SDKResource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -262,7 +426,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "IAMActions", "IdentityAttribute", "IdentityIDSeparator", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
@@ -275,6 +439,19 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = ""
 }
 
+// iamActions returns the semicolon-separated IAM actions in an annotation argument.
+func iamActions(s string) []string {
+	var actions []string
+
+	for _, action := range strings.Split(s, ";") {
+		if action = strings.TrimSpace(action); action != "" {
+			actions = append(actions, action)
+		}
+	}
+
+	return actions
+}
+
 // Visit is called for each node visited by ast.Walk.
 func (v *visitor) Visit(node ast.Node) ast.Visitor {
 	// Look at functions (not methods) with comments.
@@ -35,14 +35,14 @@ func main() {
 
 	projectRoot, err := filepath.Abs(`../../../`)
 	if err != nil {
-		g.Fatalf(err.Error())
+		g.Fatalf("%s", err.Error())
 	}
 
 	internalDir := filepath.Join(projectRoot, "internal")
 
 	dirs, err := os.ReadDir(internalDir)
 	if err != nil {
-		g.Fatalf(err.Error())
+		g.Fatalf("%s", err.Error())
 	}
 
 	generator := generator{
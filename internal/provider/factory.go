@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 )
 
 // ProtoV5ProviderServerFactory returns a muxed terraform-plugin-go protocol v5 provider factory function.
@@ -34,5 +35,10 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	iamActions := resourceIAMActions(ctx)
+
+	// Spans are only exported once the provider block's tracing configuration has been applied.
+	return func() tfprotov5.ProviderServer {
+		return tracing.NewProviderServer(newIAMPermissionPreflightServer(newPlanWarningsServer(muxServer.ProviderServer()), primary, iamActions))
+	}, primary, nil
 }
@@ -9,18 +9,28 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type interceptorFunc[Request, Response any] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
@@ -116,6 +126,11 @@ const (
 func interceptedDataSourceReadHandler(interceptors []dataSourceInterceptorReadFunc, f func(context.Context, datasource.ReadRequest, *datasource.ReadResponse) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, datasource.ReadRequest, *datasource.ReadResponse) diag.Diagnostics {
 	return func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
 		var diags diag.Diagnostics
+		ctx, span := startHandlerSpan(ctx, "Read")
+		defer func() {
+			tracing.End(span, fwdiag.DiagnosticsError(diags))
+		}()
+
 		// Before interceptors are run first to last.
 		forward := interceptors
 
@@ -155,6 +170,11 @@ func interceptedDataSourceReadHandler(interceptors []dataSourceInterceptorReadFu
 func interceptedResourceHandler[Request resourceCRUDRequest, Response resourceCRUDResponse](interceptors []resourceInterceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
 	return func(ctx context.Context, request Request, response *Response) diag.Diagnostics {
 		var diags diag.Diagnostics
+		ctx, span := startHandlerSpan(ctx, resourceCRUDOperation(request))
+		defer func() {
+			tracing.End(span, fwdiag.DiagnosticsError(diags))
+		}()
+
 		// Before interceptors are run first to last.
 		forward := interceptors
 
@@ -190,6 +210,38 @@ func interceptedResourceHandler[Request resourceCRUDRequest, Response resourceCR
 	}
 }
 
+// resourceCRUDOperation returns the name of the CRUD operation for the specified request.
+func resourceCRUDOperation[Request resourceCRUDRequest](request Request) string {
+	switch any(request).(type) {
+	case resource.CreateRequest:
+		return "Create"
+	case resource.ReadRequest:
+		return "Read"
+	case resource.UpdateRequest:
+		return "Update"
+	case resource.DeleteRequest:
+		return "Delete"
+	default:
+		return "Unknown"
+	}
+}
+
+// startHandlerSpan starts a span for a CRUD handler.
+// The span is annotated with the service package and resource names from the bootstrapped Context.
+func startHandlerSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		tracing.AttrOperation.String(operation),
+	}
+	if v, ok := conns.FromContext(ctx); ok {
+		attrs = append(attrs,
+			tracing.AttrResourceName.String(v.ResourceName),
+			tracing.AttrServicePackage.String(v.ServicePackageName),
+		)
+	}
+
+	return tracing.Start(ctx, operation, attrs...)
+}
+
 // contextFunc augments Context.
 type contextFunc func(context.Context, *conns.AWSClient) context.Context
 
@@ -200,13 +252,16 @@ type wrappedDataSource struct {
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	// regionSchemas is non-nil if a top-level `region` attribute has been injected.
+	regionSchemas *dataSourceRegionSchemas
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, regionSchemas *dataSourceRegionSchemas) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		regionSchemas:    regionSchemas,
 	}
 }
 
@@ -218,11 +273,33 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionSchemas != nil {
+		response.Schema = w.regionSchemas.outer
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
+		if w.regionSchemas == nil {
+			w.inner.Read(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		request.Config, diags = w.regionSchemas.innerConfig(request.Config)
+		response.Diagnostics.Append(diags...)
+		response.State, diags = w.regionSchemas.innerState(response.State)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
 		w.inner.Read(ctx, request, response)
+
+		response.State, diags = w.regionSchemas.outerState(response.State, tftypes.NewValue(tftypes.String, w.meta.Region(ctx)))
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -255,14 +332,30 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// regionSchemas is non-nil if a top-level `region` attribute has been injected.
+	regionSchemas *resourceRegionSchemas
+	identity      types.Identity
+	// protectFromDestroy is true if the resource can be protected from destroy by its tags.
+	protectFromDestroy bool
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
-	return &wrappedResource{
-		bootstrapContext: bootstrapContext,
-		inner:            inner,
-		interceptors:     interceptors,
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, regionSchemas *resourceRegionSchemas, identity types.Identity, protectFromDestroy bool) resource.ResourceWithConfigure {
+	w := &wrappedResource{
+		bootstrapContext:   bootstrapContext,
+		inner:              inner,
+		interceptors:       interceptors,
+		regionSchemas:      regionSchemas,
+		identity:           identity,
+		protectFromDestroy: protectFromDestroy,
+	}
+
+	if identity.HasAttributes() {
+		return &wrappedResourceWithIdentity{
+			wrappedResource: w,
+		}
 	}
+
+	return w
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -273,11 +366,35 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionSchemas != nil {
+		response.Schema = w.regionSchemas.outer
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+		if w.regionSchemas == nil {
+			w.inner.Create(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		request.Config, diags = w.regionSchemas.innerConfig(request.Config)
+		response.Diagnostics.Append(diags...)
+		request.Plan, _, diags = w.regionSchemas.innerPlan(request.Plan)
+		response.Diagnostics.Append(diags...)
+		response.State, _, diags = w.regionSchemas.innerState(response.State)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
 		w.inner.Create(ctx, request, response)
+
+		response.State, diags = w.regionSchemas.outerState(response.State, tftypes.NewValue(tftypes.String, w.meta.Region(ctx)))
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -287,7 +404,25 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+		if w.regionSchemas == nil {
+			w.inner.Read(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		request.State, _, diags = w.regionSchemas.innerState(request.State)
+		response.Diagnostics.Append(diags...)
+		response.State, _, diags = w.regionSchemas.innerState(response.State)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
 		w.inner.Read(ctx, request, response)
+
+		response.State, diags = w.regionSchemas.outerState(response.State, tftypes.NewValue(tftypes.String, w.meta.Region(ctx)))
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -297,7 +432,29 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+		if w.regionSchemas == nil {
+			w.inner.Update(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		request.Config, diags = w.regionSchemas.innerConfig(request.Config)
+		response.Diagnostics.Append(diags...)
+		request.Plan, _, diags = w.regionSchemas.innerPlan(request.Plan)
+		response.Diagnostics.Append(diags...)
+		request.State, _, diags = w.regionSchemas.innerState(request.State)
+		response.Diagnostics.Append(diags...)
+		response.State, _, diags = w.regionSchemas.innerState(response.State)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
 		w.inner.Update(ctx, request, response)
+
+		response.State, diags = w.regionSchemas.outerState(response.State, tftypes.NewValue(tftypes.String, w.meta.Region(ctx)))
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -307,7 +464,26 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+		if w.regionSchemas == nil {
+			w.inner.Delete(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		var region tftypes.Value
+		request.State, region, diags = w.regionSchemas.innerState(request.State)
+		response.Diagnostics.Append(diags...)
+		response.State, _, diags = w.regionSchemas.innerState(response.State)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
 		w.inner.Delete(ctx, request, response)
+
+		response.State, diags = w.regionSchemas.outerState(response.State, region)
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -326,8 +502,46 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		// Import by resource identity, e.g. from an `import` block with an `identity` argument.
+		// The import ID is formed from the identity before the resource's own import handler is called.
+		if request.ID == "" && request.Identity != nil && w.identity.HasAttributes() {
+			id, diags := w.importIDFromIdentity(ctx, request.Identity)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			request.ID = id
+		}
+
+		if w.regionSchemas == nil {
+			v.ImportState(ctx, request, response)
+
+			return
+		}
+
+		// Handle an optional `@region` suffix on the import ID.
+		if id, region, ok := verify.ParseImportIDRegion(request.ID); ok {
+			request.ID = id
+			response.Diagnostics.Append(setOverrideRegion(ctx, w.meta, fwtypes.StringValue(region))...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		var diags diag.Diagnostics
+		response.State, _, diags = w.regionSchemas.innerState(response.State)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
 		v.ImportState(ctx, request, response)
 
+		response.State, diags = w.regionSchemas.outerState(response.State, tftypes.NewValue(tftypes.String, w.meta.Region(ctx)))
+		response.Diagnostics.Append(diags...)
+
 		return
 	}
 
@@ -337,11 +551,123 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 	)
 }
 
+// importIDFromIdentity returns the import ID formed from the specified resource identity.
+func (w *wrappedResource) importIDFromIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var accountID fwtypes.String
+	diags.Append(identity.GetAttribute(ctx, path.Root(names.AttrAccountID), &accountID)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if v := accountID.ValueString(); v != "" && v != w.meta.AccountID {
+		diags.AddError("Invalid resource identity", fmt.Sprintf("identity %s (%s) does not match the provider's AWS account ID (%s)", names.AttrAccountID, v, w.meta.AccountID))
+		return "", diags
+	}
+
+	if !w.identity.IsGlobalResource {
+		var region fwtypes.String
+		diags.Append(identity.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if diags.HasError() {
+			return "", diags
+		}
+
+		diags.Append(setOverrideRegion(ctx, w.meta, region)...)
+		if diags.HasError() {
+			return "", diags
+		}
+	}
+
+	values, d := framework.IdentityValues(ctx, identity, w.identity)
+	diags.Append(d...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	id, err := w.identity.FormatID(values)
+	if err != nil {
+		diags.AddError("Invalid resource identity", err.Error())
+		return "", diags
+	}
+
+	return id, diags
+}
+
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	// Refuse to replace a resource protected by its current tags.
+	// Attribute plan modifiers have already populated RequiresReplace.
+	if w.protectFromDestroy {
+		state := request.State
+		defer func() {
+			if state.Raw.IsNull() || response.Plan.Raw.IsNull() || len(response.RequiresReplace) == 0 || response.Diagnostics.HasError() {
+				return
+			}
+
+			response.Diagnostics.Append(verifyNotProtected(ctx, w.meta, state)...)
+		}()
+	}
+
+	if w.regionSchemas == nil {
+		if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+			v.ModifyPlan(ctx, request, response)
+		}
+
+		return
+	}
+
+	// Plan the provider's configured Region for new resources with no `region` value configured.
+	if !request.Plan.Raw.IsNull() && request.State.Raw.IsNull() && w.meta != nil && w.meta.Region(ctx) != "" {
+		var region fwtypes.String
+		response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if region.IsNull() {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), w.meta.Region(ctx))...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	v, ok := w.inner.(resource.ResourceWithModifyPlan)
+	if !ok {
+		return
+	}
+
+	var diags diag.Diagnostics
+	var region tftypes.Value
+	request.Config, diags = w.regionSchemas.innerConfig(request.Config)
+	response.Diagnostics.Append(diags...)
+	request.Plan, _, diags = w.regionSchemas.innerPlan(request.Plan)
+	response.Diagnostics.Append(diags...)
+	request.State, _, diags = w.regionSchemas.innerState(request.State)
+	response.Diagnostics.Append(diags...)
+	response.Plan, region, diags = w.regionSchemas.innerPlan(response.Plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Any planned region is used for API calls made during plan modification.
+	if region.IsKnown() && !region.IsNull() {
+		var s string
+		if err := region.As(&s); err == nil {
+			response.Diagnostics.Append(setOverrideRegion(ctx, w.meta, fwtypes.StringValue(s))...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
 	}
+
+	v.ModifyPlan(ctx, request, response)
+
+	response.Plan, diags = w.regionSchemas.outerPlan(response.Plan, region)
+	response.Diagnostics.Append(diags...)
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -356,6 +682,16 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		if w.regionSchemas != nil {
+			var diags diag.Diagnostics
+			request.Config, diags = w.regionSchemas.innerConfig(request.Config)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -363,8 +699,32 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		upgraders := v.UpgradeState(ctx)
+
+		if w.regionSchemas == nil {
+			return upgraders
+		}
+
+		// Upgraded state has no `region` value. It is set on the subsequent Read.
+		for k, upgrader := range upgraders {
+			f := upgrader.StateUpgrader
+			upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				var diags diag.Diagnostics
+				response.State, _, diags = w.regionSchemas.innerState(response.State)
+				response.Diagnostics.Append(diags...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				f(ctx, request, response)
+
+				response.State, diags = w.regionSchemas.outerState(response.State, tftypes.NewValue(tftypes.String, nil))
+				response.Diagnostics.Append(diags...)
+			}
+			upgraders[k] = upgrader
+		}
 
-		return v.UpgradeState(ctx)
+		return upgraders
 	}
 
 	return nil
@@ -373,13 +733,95 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		movers := v.MoveState(ctx)
+
+		if w.regionSchemas == nil {
+			return movers
+		}
+
+		// Moved state has no `region` value. It is set on the subsequent Read.
+		for i, mover := range movers {
+			f := mover.StateMover
+			mover.StateMover = func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+				var diags diag.Diagnostics
+				response.TargetState, _, diags = w.regionSchemas.innerState(response.TargetState)
+				response.Diagnostics.Append(diags...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				f(ctx, request, response)
+
+				response.TargetState, diags = w.regionSchemas.outerState(response.TargetState, tftypes.NewValue(tftypes.String, nil))
+				response.Diagnostics.Append(diags...)
+			}
+			movers[i] = mover
+		}
 
-		return v.MoveState(ctx)
+		return movers
 	}
 
 	return nil
 }
 
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource with a resource identity.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = framework.IdentitySchema(w.identity)
+}
+
+// identityResourceInterceptor sets a resource's identity after Create, Read and Update.
+type identityResourceInterceptor struct {
+	identity types.Identity
+}
+
+func (r identityResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		if response.Identity == nil {
+			return ctx, diags
+		}
+
+		diags.Append(framework.SetIdentity(ctx, response.State, response.Identity, r.identity, meta.AccountID, meta.Region(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.Identity == nil || response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		diags.Append(framework.SetIdentity(ctx, response.State, response.Identity, r.identity, meta.AccountID, meta.Region(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		if response.Identity == nil {
+			return ctx, diags
+		}
+
+		diags.Append(framework.SetIdentity(ctx, response.State, response.Identity, r.identity, meta.AccountID, meta.Region(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
@@ -653,3 +1095,322 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// protectFromDestroyResourceInterceptor refuses to delete resources whose tags match the provider's `protect_from_destroy` configuration.
+type protectFromDestroyResourceInterceptor struct{}
+
+func (r protectFromDestroyResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r protectFromDestroyResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r protectFromDestroyResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r protectFromDestroyResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		diags.Append(verifyNotProtected(ctx, meta, request.State)...)
+	}
+
+	return ctx, diags
+}
+
+// verifyNotProtected returns an error diagnostic if the resource's tags in the specified state match the provider's `protect_from_destroy` configuration.
+func verifyNotProtected(ctx context.Context, meta *conns.AWSClient, state tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if meta == nil || meta.ProtectConfig == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	var tagsAll fwtypes.Map
+	diags.Append(state.GetAttribute(ctx, path.Root(names.AttrTagsAll), &tagsAll)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	match, ok := meta.ProtectConfig.Match(tftags.New(ctx, tagsAll))
+	if !ok {
+		return diags
+	}
+
+	resourceName := "resource"
+	if inContext, ok := conns.FromContext(ctx); ok && inContext.ResourceName != "" {
+		resourceName = inContext.ResourceName
+	}
+
+	diags.AddError(
+		"Resource protected from destroy",
+		fmt.Sprintf("%s is protected from destroy by tag (%s). Remove the tag, or update the provider's protect_from_destroy configuration, to allow it to be destroyed or replaced.", resourceName, match),
+	)
+
+	return diags
+}
+
+// regionDataSourceInterceptor implements per-resource region override for regional data sources.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(setOverrideRegion(ctx, meta, region)...)
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor implements per-resource region override for regional resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(setOverrideRegion(ctx, meta, region)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(setOverrideRegion(ctx, meta, region)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(setOverrideRegion(ctx, meta, region)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(setOverrideRegion(ctx, meta, region)...)
+	}
+
+	return ctx, diags
+}
+
+// setOverrideRegion records any known, non-empty `region` value in Context.
+// An error diagnostic is returned if the value is excluded by the provider's allowed or forbidden Regions.
+func setOverrideRegion(ctx context.Context, meta *conns.AWSClient, region fwtypes.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if region.IsNull() || region.IsUnknown() || region.ValueString() == "" {
+		return diags
+	}
+
+	if meta != nil {
+		if err := meta.VerifyRegionAllowed(ctx, region.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region", err.Error())
+			return diags
+		}
+	}
+
+	if inContext, ok := conns.FromContext(ctx); ok {
+		inContext.OverrideRegion = region.ValueString()
+	}
+
+	return diags
+}
+
+// wrappedEphemeralResource represents a dispatcher for a Plugin Framework ephemeral resource.
+// Ephemeral resources have no state, so no interceptors are run.
+type wrappedEphemeralResource struct {
+	// bootstrapContext is run on all wrapped methods.
+	bootstrapContext contextFunc
+	inner            ephemeral.EphemeralResourceWithConfigure
+	meta             *conns.AWSClient
+}
+
+func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure) ephemeral.EphemeralResourceWithConfigure {
+	return &wrappedEphemeralResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+	}
+}
+
+func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Schema(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Open(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Renew(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithClose); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Close(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ValidateConfig(ctx, request, response)
+	}
+}
+
+// wrappedListResource represents a dispatcher for a Plugin Framework list resource.
+// List resources have no state, so no interceptors are run.
+type wrappedListResource struct {
+	// bootstrapContext is run on all wrapped methods.
+	bootstrapContext contextFunc
+	inner            types.ListResourceForSDK
+	meta             *conns.AWSClient
+	regionSchemas    *listResourceRegionSchemas
+}
+
+func newWrappedListResource(bootstrapContext contextFunc, inner types.ListResourceForSDK, regionSchemas *listResourceRegionSchemas) list.ListResourceWithConfigure {
+	return &wrappedListResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+		regionSchemas:    regionSchemas,
+	}
+}
+
+func (w *wrappedListResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if w.regionSchemas == nil {
+		w.inner.ListResourceConfigSchema(ctx, request, response)
+
+		return
+	}
+
+	response.Schema = w.regionSchemas.outer
+}
+
+func (w *wrappedListResource) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.RawV5Schemas(ctx, request, response)
+}
+
+func (w *wrappedListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if w.regionSchemas == nil {
+		w.inner.List(ctx, request, stream)
+
+		return
+	}
+
+	config, region, diags := w.regionSchemas.innerConfig(request.Config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	if region.IsKnown() && !region.IsNull() {
+		var v string
+		if err := region.As(&v); err != nil {
+			diags.AddError("reading region", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+			return
+		}
+
+		diags.Append(setOverrideRegion(ctx, w.meta, fwtypes.StringValue(v))...)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+			return
+		}
+	}
+
+	request.Config = config
+	w.inner.List(ctx, request, stream)
+}
+
+func (w *wrappedListResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
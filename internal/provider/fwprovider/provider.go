@@ -10,22 +10,28 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
+var _ provider.ProviderWithEphemeralResources = &fwprovider{}
+var _ provider.ProviderWithListResources = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
@@ -56,6 +62,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"allowed_regions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of allowed AWS Regions, to prevent you from mistakenly managing resources in an incorrect Region.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -72,6 +83,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"forbidden_regions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of forbidden AWS Regions, to prevent you from mistakenly managing resources in the wrong Region.",
+			},
 			"http_proxy": schema.StringAttribute{
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTP requests when accessing the AWS API. Can also be set using the `HTTP_PROXY` or `http_proxy` environment variables.",
@@ -80,6 +96,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"iam_policy_validation": schema.StringAttribute{
+				Optional:    true,
+				Description: "Specifies whether IAM policy documents are validated by an offline linter during planning. Valid values are `off`, `warn` and `error`. Defaults to `off`.",
+			},
 			"insecure": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
@@ -167,9 +187,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+				Description: "Configuration blocks for IAM Roles to assume prior to making API calls. Roles are assumed in order, each using the previous role's credentials.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -265,9 +283,54 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Description: "Resource tags to default across all resources",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Configuration blocks with settings to default resource tags for matching resources. Rules are applied in order.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_keys": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tag keys to not default on matching resources",
+									},
+									"resource_type": schema.StringAttribute{
+										Optional:    true,
+										Description: "Resource type name glob, e.g. `aws_iam_*`, of the resources to match",
+									},
+									"service": schema.StringAttribute{
+										Optional:    true,
+										Description: "Service package name, e.g. `ec2`, of the resources to match",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tags to default on matching resources",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
+			"iam_permission_preflight": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block for checking, during planning, that the provider's IAM principal is allowed to perform the IAM actions required to apply resource changes.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enforcement": schema.StringAttribute{
+							Optional:    true,
+							Description: "Whether missing IAM permissions are plan errors or warnings. Valid values are `error` and `warn`. Defaults to `error`.",
+						},
+						"principal_arn": schema.StringAttribute{
+							Optional:    true,
+							Description: "ARN of the IAM user or role whose permissions are checked. Defaults to the IAM principal of the provider's credentials.",
+						},
+					},
+				},
+			},
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -288,6 +351,158 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"protect_from_destroy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with resource tags that protect resources from being destroyed or replaced.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tag_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys that protect a resource whatever their value.",
+						},
+						names.AttrTags: schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tags that protect a resource when both key and value match.",
+						},
+					},
+				},
+			},
+			"rate_limits": schema.ListNestedBlock{
+				Description: "Configuration block for client-side rate and concurrency limits on a service's API calls. Can be specified multiple times, once per service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_in_flight": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum number of the service's API calls in flight at any time. Defaults to no limit.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "Maximum sustained rate, in requests per second, of the service's API calls. Defaults to no limit.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "Service identifier, as used in the `endpoints` configuration block, for example `route53`.",
+						},
+					},
+				},
+			},
+			"retry": schema.ListNestedBlock{
+				Description: "Configuration block for the retry policy of a service's API calls. Can be specified multiple times, once per service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum number of attempts for each of the service's API calls. Defaults to the provider's `max_retries`.",
+						},
+						"max_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "Maximum backoff between attempts, as a duration string, for example `30s`. Defaults to `300s`.",
+						},
+						"retry_mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "Specifies how the service's retries are attempted. Valid values are `standard` and `adaptive`. Defaults to the provider's `retry_mode`.",
+						},
+						"retryable_error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "API error codes, in addition to the AWS SDK's defaults, that are retried.",
+						},
+						"retryable_error_messages": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Substrings of API error messages, in addition to the AWS SDK's defaults, that are retried.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "Service identifier, as used in the `endpoints` configuration block, for example `route53`.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with rules that resource tags must satisfy during planning.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enforcement": schema.StringAttribute{
+							Optional:    true,
+							Description: "Whether tag policy violations are plan errors or warnings. Valid values are `error` and `warn`. Defaults to `error`.",
+						},
+						"key_case": schema.StringAttribute{
+							Optional:    true,
+							Description: "Case that all resource tag keys must use. Valid values are `lower`, `pascal` and `upper`.",
+						},
+						"organizations_policy": schema.StringAttribute{
+							Optional:    true,
+							Description: "AWS Organizations tag policy JSON document from which to import tag key and value rules.",
+						},
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys that all resources must have.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Configuration blocks with rules for individual resource tag keys.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Allowed values for the resource tag. A trailing `*` matches any suffix.",
+									},
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "Resource tag key, in the required case.",
+									},
+									"value_regex": schema.StringAttribute{
+										Optional:    true,
+										Description: "Regular expression that the resource tag value must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tracing": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block for OpenTelemetry tracing of Terraform RPCs, resource operations and AWS API calls.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrEndpoint: schema.StringAttribute{
+							Optional:    true,
+							Description: "OTLP/HTTP endpoint URL to which spans are exported. Defaults to the value of the `OTEL_EXPORTER_OTLP_TRACES_ENDPOINT` or `OTEL_EXPORTER_OTLP_ENDPOINT` environment variable.",
+						},
+						"exporter": schema.StringAttribute{
+							Optional:    true,
+							Description: "Span exporter. Valid values are `file` and `otlp`. Defaults to `otlp`.",
+						},
+						"file_path": schema.StringAttribute{
+							Optional:    true,
+							Description: "Path of the file to which spans are appended as JSON by the `file` exporter.",
+						},
+						"headers": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Additional HTTP headers sent by the `otlp` exporter.",
+						},
+						"insecure": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether the `otlp` exporter uses HTTP rather than HTTPS.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -299,6 +514,8 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	// Provider's parsed configuration (its instance state) is available through the primary provider's Meta() method.
 	v := p.Primary.Meta()
 	response.DataSourceData = v
+	response.EphemeralResourceData = v
+	response.ListResourceData = v
 	response.ResourceData = v
 }
 
@@ -335,13 +552,26 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResource(typeName, servicePackageName), meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
 			interceptors := dataSourceInterceptors{}
+			var regionSchemas *dataSourceRegionSchemas
+
+			if !names.IsGlobalService(servicePackageName) {
+				schemaResponse := datasource.SchemaResponse{}
+				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+				// The data source is regional.
+				// Inject a top-level `region` attribute unless the schema already defines one.
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+					regionSchemas = newDataSourceRegionSchemas(ctx, schemaResponse.Schema)
+					interceptors = append(interceptors, regionDataSourceInterceptor{})
+				}
+			}
 
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
@@ -363,7 +593,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, regionSchemas)
 			})
 		}
 	}
@@ -406,13 +636,26 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResource(typeName, servicePackageName), meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
 			interceptors := resourceInterceptors{}
+			var regionSchemas *resourceRegionSchemas
+
+			if !names.IsGlobalService(servicePackageName) {
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				// The resource is regional.
+				// Inject a top-level `region` attribute unless the schema already defines one.
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+					regionSchemas = newResourceRegionSchemas(ctx, schemaResponse.Schema)
+					interceptors = append(interceptors, regionResourceInterceptor{})
+				}
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -442,8 +685,20 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if v.Identity.HasAttributes() {
+				interceptors = append(interceptors, identityResourceInterceptor{identity: v.Identity})
+			}
+
+			// The resource can be protected from destroy by its tags.
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+			_, protectFromDestroy := schemaResponse.Schema.Attributes[names.AttrTagsAll]
+			if protectFromDestroy {
+				interceptors = append(interceptors, protectFromDestroyResourceInterceptor{})
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, regionSchemas, v.Identity, protectFromDestroy)
 			})
 		}
 	}
@@ -457,6 +712,141 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// EphemeralResources returns a slice of functions to instantiate each EphemeralResource
+// implementation.
+//
+// The ephemeral resource type name is determined by the EphemeralResource implementing
+// the Metadata method. All ephemeral resources must have unique names.
+func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	var errs []error
+	var ephemeralResources []func() ephemeral.EphemeralResource
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		sp, ok := sp.(conns.ServicePackageWithEphemeralResources)
+		if !ok {
+			continue
+		}
+
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range sp.EphemeralResources(ctx) {
+			v := v
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("creating ephemeral resource: %w", err))
+				continue
+			}
+
+			// bootstrapContext is run on all wrapped methods.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+				if meta != nil {
+					ctx = meta.RegisterLogger(ctx)
+				}
+
+				return ctx
+			}
+
+			ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
+				return newWrappedEphemeralResource(bootstrapContext, inner)
+			})
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering ephemeral resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return ephemeralResources
+}
+
+// ListResources returns a slice of functions to instantiate each ListResource
+// implementation.
+//
+// The list resource type name is determined by the ListResource implementing
+// the Metadata method and must match the type name of the listed resource.
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	var errs []error
+	var listResources []func() list.ListResource
+
+	primary, ok := p.Primary.(*sdkschema.Provider)
+	if !ok {
+		return listResources
+	}
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		sp, ok := sp.(conns.ServicePackageWithSDKListResources)
+		if !ok {
+			continue
+		}
+
+		servicePackageName := sp.ServicePackageName()
+		identities := make(map[string]inttypes.Identity)
+		for _, v := range sp.SDKResources(ctx) {
+			identities[v.TypeName] = v.Identity
+		}
+
+		for _, v := range sp.SDKListResources(ctx) {
+			v := v
+			typeName := v.TypeName
+
+			r, ok := primary.ResourcesMap[typeName]
+			if !ok {
+				errs = append(errs, fmt.Errorf("no resource registered for list resource: %s", typeName))
+				continue
+			}
+
+			identity := identities[typeName]
+			if !identity.HasAttributes() {
+				errs = append(errs, fmt.Errorf("no identity defined for list resource: %s", typeName))
+				continue
+			}
+
+			inner := v.Factory()
+			inner.SetIdentitySpec(identity)
+			inner.SetResourceSchema(r)
+
+			// bootstrapContext is run on all wrapped methods.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				if meta != nil {
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResource(typeName, servicePackageName), meta.IgnoreTagsConfig)
+					ctx = meta.RegisterLogger(ctx)
+				}
+
+				return ctx
+			}
+			var regionSchemas *listResourceRegionSchemas
+
+			if !identity.IsGlobalResource {
+				schemaResponse := list.ListResourceSchemaResponse{}
+				inner.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResponse)
+
+				// The listed resource is regional.
+				// Inject a top-level `region` attribute unless the schema already defines one.
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+					regionSchemas = newListResourceRegionSchemas(ctx, schemaResponse.Schema)
+				}
+			}
+
+			listResources = append(listResources, func() list.ListResource {
+				return newWrappedListResource(bootstrapContext, inner, regionSchemas)
+			})
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering list resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return listResources
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -466,7 +856,17 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDROverlapsFunction,
+		tffunction.NewEndpointURLFunction,
+		tffunction.NewIPv6SubnetFunction,
+		tffunction.NewPartitionForRegionFunction,
+		tffunction.NewPolicyEquivalentFunction,
+		tffunction.NewPolicyMergeFunction,
+		tffunction.NewPolicyNormalizeFunction,
+		tffunction.NewPolicyStatementsFunction,
+		tffunction.NewServicePrincipalFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewVPCSubnetPlanFunction,
 	}
 }
 
@@ -0,0 +1,337 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"maps"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionDataSourceSchemaAttribute returns the `region` attribute injected into regional data sources.
+func regionDataSourceSchemaAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration.",
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+	}
+}
+
+// regionResourceSchemaAttribute returns the `region` attribute injected into regional resources.
+func regionResourceSchemaAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplaceIf(regionRequiresReplace, "Changing the configured Region requires replacement.", "Changing the configured Region requires replacement."),
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+	}
+}
+
+// regionRequiresReplace requires replacement only when a configured Region differs from the Region in state.
+// State written before `region` was added has a null Region, which must not force replacement.
+func regionRequiresReplace(_ context.Context, request planmodifier.StringRequest, response *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() || request.StateValue.IsNull() {
+		return
+	}
+
+	response.RequiresReplace = !request.ConfigValue.Equal(request.StateValue)
+}
+
+// regionListResourceSchemaAttribute returns the `region` attribute injected into the configuration of list resources for regional resources.
+func regionListResourceSchemaAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Optional:    true,
+		Description: "The AWS Region in which to list resources. Defaults to the Region set in the provider configuration.",
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+	}
+}
+
+// The inner (wrapped) resource or data source has no knowledge of the injected `region` attribute.
+// Configuration, plan and state values are translated between the outer schema (with `region`)
+// and the inner schema (without `region`) around each call to the inner implementation.
+
+// withoutRegion returns the specified object value with any top-level `region` attribute removed,
+// and the value of the removed attribute.
+func withoutRegion(v tftypes.Value, typ tftypes.Type) (tftypes.Value, tftypes.Value, error) {
+	nullRegion := tftypes.NewValue(tftypes.String, nil)
+
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nullRegion, nil
+	}
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return tftypes.Value{}, nullRegion, err
+	}
+
+	region, ok := attributes[names.AttrRegion]
+	if !ok {
+		region = nullRegion
+	}
+	delete(attributes, names.AttrRegion)
+
+	return tftypes.NewValue(typ, attributes), region, nil
+}
+
+// withRegion returns the specified object value with the top-level `region` attribute added.
+func withRegion(v tftypes.Value, region tftypes.Value, typ tftypes.Type) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	attributes[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, attributes), nil
+}
+
+// resourceRegionSchemas holds a regional resource's inner and outer schemas.
+type resourceRegionSchemas struct {
+	inner     resourceschema.Schema
+	innerType tftypes.Type
+	outer     resourceschema.Schema
+	outerType tftypes.Type
+}
+
+// newResourceRegionSchemas returns the schemas of a regional resource whose inner schema is specified.
+// The outer schema is the inner schema plus the top-level `region` attribute.
+func newResourceRegionSchemas(ctx context.Context, inner resourceschema.Schema) *resourceRegionSchemas {
+	outer := inner
+	outer.Attributes = make(map[string]resourceschema.Attribute, len(inner.Attributes)+1)
+	maps.Copy(outer.Attributes, inner.Attributes)
+	outer.Attributes[names.AttrRegion] = regionResourceSchemaAttribute()
+
+	return &resourceRegionSchemas{
+		inner:     inner,
+		innerType: inner.Type().TerraformType(ctx),
+		outer:     outer,
+		outerType: outer.Type().TerraformType(ctx),
+	}
+}
+
+// innerConfig translates configuration from the outer to the inner schema.
+func (r *resourceRegionSchemas) innerConfig(config tfsdk.Config) (tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, _, err := withoutRegion(config.Raw, r.innerType)
+	if err != nil {
+		diags.AddError("translating configuration", err.Error())
+		return config, diags
+	}
+
+	config.Raw = raw
+	config.Schema = r.inner
+
+	return config, diags
+}
+
+// innerPlan translates a plan from the outer to the inner schema, returning the planned region.
+func (r *resourceRegionSchemas) innerPlan(plan tfsdk.Plan) (tfsdk.Plan, tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, region, err := withoutRegion(plan.Raw, r.innerType)
+	if err != nil {
+		diags.AddError("translating plan", err.Error())
+		return plan, region, diags
+	}
+
+	plan.Raw = raw
+	plan.Schema = r.inner
+
+	return plan, region, diags
+}
+
+// outerPlan translates a plan from the inner to the outer schema.
+func (r *resourceRegionSchemas) outerPlan(plan tfsdk.Plan, region tftypes.Value) (tfsdk.Plan, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := withRegion(plan.Raw, region, r.outerType)
+	if err != nil {
+		diags.AddError("translating plan", err.Error())
+		return plan, diags
+	}
+
+	plan.Raw = raw
+	plan.Schema = r.outer
+
+	return plan, diags
+}
+
+// innerState translates state from the outer to the inner schema, returning the region in state.
+func (r *resourceRegionSchemas) innerState(state tfsdk.State) (tfsdk.State, tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, region, err := withoutRegion(state.Raw, r.innerType)
+	if err != nil {
+		diags.AddError("translating state", err.Error())
+		return state, region, diags
+	}
+
+	state.Raw = raw
+	state.Schema = r.inner
+
+	return state, region, diags
+}
+
+// outerState translates state from the inner to the outer schema.
+func (r *resourceRegionSchemas) outerState(state tfsdk.State, region tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := withRegion(state.Raw, region, r.outerType)
+	if err != nil {
+		diags.AddError("translating state", err.Error())
+		return state, diags
+	}
+
+	state.Raw = raw
+	state.Schema = r.outer
+
+	return state, diags
+}
+
+// dataSourceRegionSchemas holds a regional data source's inner and outer schemas.
+type dataSourceRegionSchemas struct {
+	inner     datasourceschema.Schema
+	innerType tftypes.Type
+	outer     datasourceschema.Schema
+	outerType tftypes.Type
+}
+
+// newDataSourceRegionSchemas returns the schemas of a regional data source whose inner schema is specified.
+// The outer schema is the inner schema plus the top-level `region` attribute.
+func newDataSourceRegionSchemas(ctx context.Context, inner datasourceschema.Schema) *dataSourceRegionSchemas {
+	outer := inner
+	outer.Attributes = make(map[string]datasourceschema.Attribute, len(inner.Attributes)+1)
+	maps.Copy(outer.Attributes, inner.Attributes)
+	outer.Attributes[names.AttrRegion] = regionDataSourceSchemaAttribute()
+
+	return &dataSourceRegionSchemas{
+		inner:     inner,
+		innerType: inner.Type().TerraformType(ctx),
+		outer:     outer,
+		outerType: outer.Type().TerraformType(ctx),
+	}
+}
+
+// innerConfig translates configuration from the outer to the inner schema.
+func (r *dataSourceRegionSchemas) innerConfig(config tfsdk.Config) (tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, _, err := withoutRegion(config.Raw, r.innerType)
+	if err != nil {
+		diags.AddError("translating configuration", err.Error())
+		return config, diags
+	}
+
+	config.Raw = raw
+	config.Schema = r.inner
+
+	return config, diags
+}
+
+// innerState translates state from the outer to the inner schema.
+func (r *dataSourceRegionSchemas) innerState(state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, _, err := withoutRegion(state.Raw, r.innerType)
+	if err != nil {
+		diags.AddError("translating state", err.Error())
+		return state, diags
+	}
+
+	state.Raw = raw
+	state.Schema = r.inner
+
+	return state, diags
+}
+
+// outerState translates state from the inner to the outer schema.
+func (r *dataSourceRegionSchemas) outerState(state tfsdk.State, region tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := withRegion(state.Raw, region, r.outerType)
+	if err != nil {
+		diags.AddError("translating state", err.Error())
+		return state, diags
+	}
+
+	state.Raw = raw
+	state.Schema = r.outer
+
+	return state, diags
+}
+
+// listResourceRegionSchemas holds the inner and outer configuration schemas of a list resource for a regional resource.
+type listResourceRegionSchemas struct {
+	inner     listschema.Schema
+	innerType tftypes.Type
+	outer     listschema.Schema
+	outerType tftypes.Type
+}
+
+// newListResourceRegionSchemas returns the configuration schemas of a list resource whose inner schema is specified.
+// The outer schema is the inner schema plus the top-level `region` attribute.
+func newListResourceRegionSchemas(ctx context.Context, inner listschema.Schema) *listResourceRegionSchemas {
+	outer := inner
+	outer.Attributes = make(map[string]listschema.Attribute, len(inner.Attributes)+1)
+	maps.Copy(outer.Attributes, inner.Attributes)
+	outer.Attributes[names.AttrRegion] = regionListResourceSchemaAttribute()
+
+	return &listResourceRegionSchemas{
+		inner:     inner,
+		innerType: inner.Type().TerraformType(ctx),
+		outer:     outer,
+		outerType: outer.Type().TerraformType(ctx),
+	}
+}
+
+// innerConfig translates configuration from the outer to the inner schema, returning the configured region.
+func (r *listResourceRegionSchemas) innerConfig(config tfsdk.Config) (tfsdk.Config, tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, region, err := withoutRegion(config.Raw, r.innerType)
+	if err != nil {
+		diags.AddError("translating configuration", err.Error())
+		return config, region, diags
+	}
+
+	config.Raw = raw
+	config.Schema = r.inner
+
+	return config, region, diags
+}
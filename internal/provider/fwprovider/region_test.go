@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRegionRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configValue types.String
+		stateValue  types.String
+		expected    bool
+	}{
+		"config null": {
+			configValue: types.StringNull(),
+			stateValue:  types.StringValue("us-west-2"),
+		},
+		"config unknown": {
+			configValue: types.StringUnknown(),
+			stateValue:  types.StringValue("us-west-2"),
+		},
+		"state null": {
+			configValue: types.StringValue("us-west-2"),
+			stateValue:  types.StringNull(),
+		},
+		"unchanged": {
+			configValue: types.StringValue("us-west-2"),
+			stateValue:  types.StringValue("us-west-2"),
+		},
+		"changed": {
+			configValue: types.StringValue("us-east-1"),
+			stateValue:  types.StringValue("us-west-2"),
+			expected:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := planmodifier.StringRequest{
+				ConfigValue: testCase.configValue,
+				StateValue:  testCase.stateValue,
+			}
+			var response stringplanmodifier.RequiresReplaceIfFuncResponse
+
+			regionRequiresReplace(context.Background(), request, &response)
+
+			if got, want := response.RequiresReplace, testCase.expected; got != want {
+				t.Errorf("RequiresReplace = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// fullProviderServer is a terraform-plugin-go protocol v5 provider server that supports all RPCs.
+type fullProviderServer interface {
+	tfprotov5.ProviderServerWithActions
+	tfprotov5.ProviderServerWithListResource
+}
+
+// iamPermissionPreflightServer is a provider server that, when the provider's `iam_permission_preflight` is configured,
+// checks during planning that the provider's IAM principal is allowed to perform the IAM actions required to apply each resource change.
+// Terraform plans each resource change separately, so the missing IAM actions are aggregated across the plan
+// and each is reported once, for the first planned change that requires it.
+// Likewise, each planned resource type that doesn't declare its IAM actions is reported once.
+type iamPermissionPreflightServer struct {
+	fullProviderServer
+	primary    *schema.Provider
+	iamActions map[string]*types.ServicePackageResourceIAMActions // Keyed by resource type name.
+
+	mu            sync.Mutex
+	resourceTypes map[string]tftypes.Type // Keyed by resource type name.
+	reported      map[string]bool         // Keyed by IAM action and resource ARN.
+	unchecked     map[string]bool         // Keyed by resource type name.
+}
+
+// newIAMPermissionPreflightServer returns a provider server that checks planned resource changes' IAM permissions
+// before delegating to the specified server.
+func newIAMPermissionPreflightServer(server tfprotov5.ProviderServer, primary *schema.Provider, iamActions map[string]*types.ServicePackageResourceIAMActions) tfprotov5.ProviderServer {
+	v, ok := server.(fullProviderServer)
+	if !ok {
+		return server
+	}
+
+	return &iamPermissionPreflightServer{
+		fullProviderServer: v,
+		primary:            primary,
+		iamActions:         iamActions,
+		reported:           make(map[string]bool),
+		unchecked:          make(map[string]bool),
+	}
+}
+
+func (s *iamPermissionPreflightServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.fullProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil || hasErrorDiagnostic(response.Diagnostics) {
+		return response, err
+	}
+
+	meta, ok := s.primary.Meta().(*conns.AWSClient)
+	if !ok {
+		return response, nil
+	}
+
+	config := meta.IAMPermissionPreflight(ctx)
+	if config == nil {
+		return response, nil
+	}
+
+	iamActions, ok := s.iamActions[request.TypeName]
+	if !ok {
+		if isPlannedChange(request, response) && s.unreportedUncheckedResourceType(request.TypeName) {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  fmt.Sprintf("IAM permissions not checked for %s", request.TypeName),
+				Detail:   fmt.Sprintf("The %s resource type does not declare the IAM actions it requires, so IAM permission preflight cannot check its planned changes. Applying them may still fail because of missing IAM permissions.", request.TypeName),
+			})
+		}
+
+		return response, nil
+	}
+
+	actions := plannedIAMActions(iamActions, request, response)
+	if len(actions) == 0 {
+		return response, nil
+	}
+
+	resourceARN := plannedResourceARN(s.resourceType(ctx, request.TypeName), request, response)
+	missing, possiblyMissing, err := meta.MissingIAMActions(ctx, actions, resourceARN)
+
+	if err != nil {
+		// Don't block planning if the permissions themselves can't be evaluated.
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "IAM permission preflight skipped",
+			Detail:   err.Error(),
+		})
+
+		return response, nil
+	}
+
+	if missing := s.unreportedIAMActions(missing, resourceARN); len(missing) > 0 {
+		severity := tfprotov5.DiagnosticSeverityError
+		if config.IsWarning() {
+			severity = tfprotov5.DiagnosticSeverityWarning
+		}
+
+		var on string
+		if resourceARN != "" {
+			on = fmt.Sprintf(" on %s", resourceARN)
+		}
+
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("Missing IAM permissions for %s", request.TypeName),
+			Detail:   fmt.Sprintf("Applying this change requires the following IAM actions%s, which the provider's IAM principal is not allowed to perform:\n\n  %s", on, strings.Join(missing, "\n  ")),
+		})
+	}
+
+	if possiblyMissing := s.unreportedIAMActions(possiblyMissing, resourceARN); len(possiblyMissing) > 0 {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  fmt.Sprintf("Possibly missing IAM permissions for %s", request.TypeName),
+			Detail:   fmt.Sprintf("Applying this change requires the following IAM actions, which the provider's IAM principal is not allowed to perform on all resources. The resource's ARN is not known until apply, so permissions that are only allowed on specific resources could not be evaluated:\n\n  %s", strings.Join(possiblyMissing, "\n  ")),
+		})
+	}
+
+	return response, nil
+}
+
+// unreportedIAMActions returns those of the specified missing IAM actions that haven't already been reported for the resource, and records them as reported.
+func (s *iamPermissionPreflightServer) unreportedIAMActions(actions []string, resourceARN string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var unreported []string
+	for _, action := range actions {
+		if key := action + " " + resourceARN; !s.reported[key] {
+			s.reported[key] = true
+			unreported = append(unreported, action)
+		}
+	}
+
+	return unreported
+}
+
+// unreportedUncheckedResourceType returns whether the specified resource type, which doesn't declare its IAM actions, hasn't already been reported, and records it as reported.
+func (s *iamPermissionPreflightServer) unreportedUncheckedResourceType(typeName string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.unchecked[typeName] {
+		return false
+	}
+	s.unchecked[typeName] = true
+
+	return true
+}
+
+// resourceType returns the type of the specified resource's state, or nil if it can't be determined.
+func (s *iamPermissionPreflightServer) resourceType(ctx context.Context, typeName string) tftypes.Type {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.resourceTypes == nil {
+		s.resourceTypes = make(map[string]tftypes.Type)
+
+		response, err := s.fullProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+		if err != nil || response == nil {
+			return nil
+		}
+
+		for name, v := range response.ResourceSchemas {
+			if _, ok := s.iamActions[name]; ok {
+				s.resourceTypes[name] = v.ValueType()
+			}
+		}
+	}
+
+	return s.resourceTypes[typeName]
+}
+
+// plannedIAMActions returns the IAM actions required to apply a planned resource change.
+func plannedIAMActions(iamActions *types.ServicePackageResourceIAMActions, request *tfprotov5.PlanResourceChangeRequest, response *tfprotov5.PlanResourceChangeResponse) []string {
+	var actions []string
+
+	switch create, destroy := isNullDynamicValue(request.PriorState), isNullDynamicValue(response.PlannedState); {
+	case create && destroy:
+	case create:
+		actions = append(actions, iamActions.Create...)
+		actions = append(actions, iamActions.Read...)
+	case destroy:
+		actions = append(actions, iamActions.Delete...)
+	case len(response.RequiresReplace) > 0:
+		actions = append(actions, iamActions.Delete...)
+		actions = append(actions, iamActions.Create...)
+		actions = append(actions, iamActions.Read...)
+	case !bytes.Equal(request.PriorState.MsgPack, response.PlannedState.MsgPack):
+		actions = append(actions, iamActions.Update...)
+		actions = append(actions, iamActions.Read...)
+	}
+
+	return actions
+}
+
+// isPlannedChange returns whether a planned resource change creates, updates, replaces or destroys the resource.
+func isPlannedChange(request *tfprotov5.PlanResourceChangeRequest, response *tfprotov5.PlanResourceChangeResponse) bool {
+	switch create, destroy := isNullDynamicValue(request.PriorState), isNullDynamicValue(response.PlannedState); {
+	case create && destroy:
+		return false
+	case create, destroy, len(response.RequiresReplace) > 0:
+		return true
+	default:
+		return !bytes.Equal(request.PriorState.MsgPack, response.PlannedState.MsgPack)
+	}
+}
+
+// plannedResourceARN returns the ARN of the resource that a planned change applies to, or "" if it isn't known.
+// The ARN of a resource being destroyed is taken from its prior state.
+func plannedResourceARN(typ tftypes.Type, request *tfprotov5.PlanResourceChangeRequest, response *tfprotov5.PlanResourceChangeResponse) string {
+	if typ == nil {
+		return ""
+	}
+
+	state := response.PlannedState
+	if isNullDynamicValue(state) {
+		state = request.PriorState
+	}
+
+	if isNullDynamicValue(state) {
+		return ""
+	}
+
+	value, err := state.Unmarshal(typ)
+	if err != nil || !value.IsKnown() || value.IsNull() {
+		return ""
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := value.As(&attributes); err != nil {
+		return ""
+	}
+
+	v, ok := attributes[names.AttrARN]
+	if !ok || !v.IsKnown() || v.IsNull() {
+		return ""
+	}
+
+	var resourceARN string
+	if err := v.As(&resourceARN); err != nil || !arn.IsARN(resourceARN) {
+		return ""
+	}
+
+	return resourceARN
+}
+
+// resourceIAMActions returns the IAM actions declared by each resource, keyed by resource type name.
+func resourceIAMActions(ctx context.Context) map[string]*types.ServicePackageResourceIAMActions {
+	iamActions := make(map[string]*types.ServicePackageResourceIAMActions)
+
+	for _, sp := range servicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.IAMActions != nil {
+				iamActions[v.TypeName] = v.IAMActions
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			if v.IAMActions == nil {
+				continue
+			}
+
+			inner, err := v.Factory(ctx)
+			if err != nil {
+				tflog.Warn(ctx, "creating resource", map[string]any{
+					"service_package": sp.ServicePackageName(),
+					"error":           err.Error(),
+				})
+				continue
+			}
+
+			metadataResponse := resource.MetadataResponse{}
+			inner.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+			iamActions[metadataResponse.TypeName] = v.IAMActions
+		}
+	}
+
+	return iamActions
+}
+
+// isNullDynamicValue returns whether the specified value is null.
+func isNullDynamicValue(v *tfprotov5.DynamicValue) bool {
+	if v == nil {
+		return true
+	}
+
+	// A null value is encoded as the single MessagePack nil byte.
+	if len(v.MsgPack) > 0 {
+		return bytes.Equal(v.MsgPack, []byte{0xc0})
+	}
+
+	return len(v.JSON) == 0 || string(v.JSON) == "null"
+}
+
+func hasErrorDiagnostic(diags []*tfprotov5.Diagnostic) bool {
+	for _, d := range diags {
+		if d != nil && d.Severity == tfprotov5.DiagnosticSeverityError {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestPlannedIAMActions(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}
+	dynamicValue := func(name *string) *tfprotov5.DynamicValue {
+		var value tftypes.Value
+		if name == nil {
+			value = tftypes.NewValue(objectType, nil)
+		} else {
+			value = tftypes.NewValue(objectType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, *name)})
+		}
+
+		v, err := tfprotov5.NewDynamicValue(objectType, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &v
+	}
+	name1, name2 := "name1", "name2"
+	iamActions := &types.ServicePackageResourceIAMActions{
+		Create: []string{"svc:Create"},
+		Read:   []string{"svc:Read"},
+		Update: []string{"svc:Update"},
+		Delete: []string{"svc:Delete"},
+	}
+
+	testCases := map[string]struct {
+		priorState      *tfprotov5.DynamicValue
+		plannedState    *tfprotov5.DynamicValue
+		requiresReplace []*tftypes.AttributePath
+		want            []string
+	}{
+		"create": {
+			priorState:   dynamicValue(nil),
+			plannedState: dynamicValue(&name1),
+			want:         []string{"svc:Create", "svc:Read"},
+		},
+		"no change": {
+			priorState:   dynamicValue(&name1),
+			plannedState: dynamicValue(&name1),
+		},
+		"update": {
+			priorState:   dynamicValue(&name1),
+			plannedState: dynamicValue(&name2),
+			want:         []string{"svc:Update", "svc:Read"},
+		},
+		"replace": {
+			priorState:      dynamicValue(&name1),
+			plannedState:    dynamicValue(&name2),
+			requiresReplace: []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("name")},
+			want:            []string{"svc:Delete", "svc:Create", "svc:Read"},
+		},
+		"destroy": {
+			priorState:   dynamicValue(&name1),
+			plannedState: dynamicValue(nil),
+			want:         []string{"svc:Delete"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := &tfprotov5.PlanResourceChangeRequest{
+				PriorState: testCase.priorState,
+			}
+			response := &tfprotov5.PlanResourceChangeResponse{
+				PlannedState:    testCase.plannedState,
+				RequiresReplace: testCase.requiresReplace,
+			}
+
+			got := plannedIAMActions(iamActions, request, response)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, want := isPlannedChange(request, response), len(testCase.want) > 0; got != want {
+				t.Errorf("isPlannedChange = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestPlannedResourceARN(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"arn": tftypes.String}}
+	dynamicValue := func(arn any) *tfprotov5.DynamicValue {
+		var value tftypes.Value
+		if arn == nil {
+			value = tftypes.NewValue(objectType, nil)
+		} else {
+			value = tftypes.NewValue(objectType, map[string]tftypes.Value{"arn": tftypes.NewValue(tftypes.String, arn)})
+		}
+
+		v, err := tfprotov5.NewDynamicValue(objectType, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &v
+	}
+	arn1, arn2 := "arn:aws:sqs:us-west-2:123456789012:queue1", "arn:aws:sqs:us-west-2:123456789012:queue2"
+
+	testCases := map[string]struct {
+		typ          tftypes.Type
+		priorState   *tfprotov5.DynamicValue
+		plannedState *tfprotov5.DynamicValue
+		want         string
+	}{
+		"no type": {
+			priorState:   dynamicValue(arn1),
+			plannedState: dynamicValue(arn1),
+		},
+		"create": {
+			typ:          objectType,
+			priorState:   dynamicValue(nil),
+			plannedState: dynamicValue(tftypes.UnknownValue),
+		},
+		"update": {
+			typ:          objectType,
+			priorState:   dynamicValue(arn1),
+			plannedState: dynamicValue(arn1),
+			want:         arn1,
+		},
+		"replace": {
+			typ:          objectType,
+			priorState:   dynamicValue(arn1),
+			plannedState: dynamicValue(tftypes.UnknownValue),
+		},
+		"replace known ARN": {
+			typ:          objectType,
+			priorState:   dynamicValue(arn1),
+			plannedState: dynamicValue(arn2),
+			want:         arn2,
+		},
+		"destroy": {
+			typ:          objectType,
+			priorState:   dynamicValue(arn1),
+			plannedState: dynamicValue(nil),
+			want:         arn1,
+		},
+		"not an ARN": {
+			typ:          objectType,
+			priorState:   dynamicValue("queue1"),
+			plannedState: dynamicValue("queue1"),
+		},
+		"no ARN attribute": {
+			typ:          tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}},
+			priorState:   dynamicValue(nil),
+			plannedState: dynamicValue(nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := &tfprotov5.PlanResourceChangeRequest{
+				PriorState: testCase.priorState,
+			}
+			response := &tfprotov5.PlanResourceChangeResponse{
+				PlannedState: testCase.plannedState,
+			}
+
+			if got, want := plannedResourceARN(testCase.typ, request, response), testCase.want; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestIAMPermissionPreflightServerUnreportedIAMActions(t *testing.T) {
+	t.Parallel()
+
+	s := &iamPermissionPreflightServer{
+		reported: make(map[string]bool),
+	}
+	arn1 := "arn:aws:sqs:us-west-2:123456789012:queue1"
+
+	if diff := cmp.Diff(s.unreportedIAMActions([]string{"svc:Create", "svc:Read"}, ""), []string{"svc:Create", "svc:Read"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(s.unreportedIAMActions([]string{"svc:Read", "svc:Tag"}, ""), []string{"svc:Tag"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(s.unreportedIAMActions([]string{"svc:Read"}, arn1), []string{"svc:Read"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+	if got := s.unreportedIAMActions([]string{"svc:Create", "svc:Read"}, ""); len(got) != 0 {
+		t.Errorf("got %v, want none", got)
+	}
+}
+
+func TestIAMPermissionPreflightServerUnreportedUncheckedResourceType(t *testing.T) {
+	t.Parallel()
+
+	s := &iamPermissionPreflightServer{
+		unchecked: make(map[string]bool),
+	}
+
+	if !s.unreportedUncheckedResourceType("aws_vpc") {
+		t.Errorf("aws_vpc reported, want unreported")
+	}
+	if !s.unreportedUncheckedResourceType("aws_subnet") {
+		t.Errorf("aws_subnet reported, want unreported")
+	}
+	if s.unreportedUncheckedResourceType("aws_vpc") {
+		t.Errorf("aws_vpc unreported, want reported")
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// identityInterceptor sets a resource's identity after Create, Read and Update.
+type identityInterceptor struct {
+	identity types.Identity
+}
+
+func (r identityInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	rd, ok := d.(*schema.ResourceData)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case After:
+		switch why {
+		case Create, Read, Update:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if rd.Id() == "" {
+				return ctx, diags
+			}
+
+			c := meta.(*conns.AWSClient)
+			if err := sdkv2.SetIdentity(rd, r.identity, c.AccountID, c.Region(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting resource identity: %s", err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// importIdentity handles import by resource identity, e.g. from an `import` block with an `identity` argument.
+// The resource's ID is set from the identity before the resource's own import handler is called.
+func importIdentity(f schema.StateContextFunc, identity types.Identity) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		// Import by ID.
+		if d.Id() != "" {
+			return f(ctx, d, meta)
+		}
+
+		v, err := d.Identity()
+		if err != nil {
+			return nil, err
+		}
+
+		c := meta.(*conns.AWSClient)
+		if accountID, ok := v.GetOk(names.AttrAccountID); ok && accountID.(string) != c.AccountID {
+			return nil, fmt.Errorf("identity %s (%s) does not match the provider's AWS account ID (%s)", names.AttrAccountID, accountID, c.AccountID)
+		}
+
+		if !identity.IsGlobalResource {
+			if region, ok := v.GetOk(names.AttrRegion); ok {
+				if err := c.VerifyRegionAllowed(ctx, region.(string)); err != nil {
+					return nil, err
+				}
+
+				if err := d.Set(names.AttrRegion, region); err != nil {
+					return nil, err
+				}
+
+				if inContext, ok := conns.FromContext(ctx); ok {
+					inContext.OverrideRegion = region.(string)
+				}
+			}
+		}
+
+		if err := sdkv2.SetIDFromIdentity(d, identity, v); err != nil {
+			return nil, err
+		}
+
+		return f(ctx, d, meta)
+	}
+}
@@ -17,9 +17,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // schemaResourceData is an interface that implements functions from schema.ResourceData
@@ -79,6 +82,21 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+func (w why) String() string {
+	switch w {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return "Unknown"
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -93,6 +111,11 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
+		ctx, span := startHandlerSpan(ctx, why.String())
+		defer func() {
+			tracing.End(span, sdkdiag.DiagnosticsError(diags))
+		}()
+
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -134,6 +157,22 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	}
 }
 
+// startHandlerSpan starts a span for a CRUD handler.
+// The span is annotated with the service package and resource names from the bootstrapped Context.
+func startHandlerSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		tracing.AttrOperation.String(operation),
+	}
+	if v, ok := conns.FromContext(ctx); ok {
+		attrs = append(attrs,
+			tracing.AttrResourceName.String(v.ResourceName),
+			tracing.AttrServicePackage.String(v.ServicePackageName),
+		)
+	}
+
+	return tracing.Start(ctx, operation, attrs...)
+}
+
 // contextFunc augments Context.
 type contextFunc func(context.Context, any) context.Context
 
@@ -454,3 +493,59 @@ func (r tagsDataSourceInterceptor) run(ctx context.Context, d schemaResourceData
 
 	return ctx, diags
 }
+
+// regionInterceptor implements per-resource region override for regional resources and data sources.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Use the configured or previously read region, if any, for all API calls.
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			if err := meta.(*conns.AWSClient).VerifyRegionAllowed(ctx, v); err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+
+			inContext.OverrideRegion = v
+		}
+	case After:
+		// Set region in state after CRU.
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			fallthrough
+		case Create, Update:
+			if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).Region(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// protectFromDestroyInterceptor refuses to delete resources whose tags match the provider's `protect_from_destroy` configuration.
+type protectFromDestroyInterceptor struct{}
+
+func (r protectFromDestroyInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		switch why {
+		case Delete:
+			if err := verifyNotProtected(ctx, meta.(*conns.AWSClient).ProtectConfig, d.Get(names.AttrTagsAll), d.Id()); err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
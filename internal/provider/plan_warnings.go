@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+)
+
+// planWarningsServer is a provider server that returns the warnings added by Plugin SDK resources' CustomizeDiff functions
+// with the planned resource change.
+type planWarningsServer struct {
+	fullProviderServer
+}
+
+// newPlanWarningsServer returns a provider server that collects plan warnings before delegating to the specified server.
+func newPlanWarningsServer(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	v, ok := server.(fullProviderServer)
+	if !ok {
+		return server
+	}
+
+	return &planWarningsServer{
+		fullProviderServer: v,
+	}
+}
+
+func (s *planWarningsServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx, warnings := sdkv2.ContextWithPlanWarnings(ctx)
+
+	response, err := s.fullProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	for _, warning := range warnings() {
+		diagnostic := &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  warning.Summary,
+			Detail:   warning.Detail,
+		}
+		if warning.Attribute != "" {
+			diagnostic.Attribute = tftypes.NewAttributePath().WithAttributeName(warning.Attribute)
+		}
+
+		response.Diagnostics = append(response.Diagnostics, diagnostic)
+	}
+
+	return response, nil
+}
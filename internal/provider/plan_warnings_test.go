@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+)
+
+type planWarningsTestServer struct {
+	fullProviderServer
+	warnings []sdkv2.PlanWarning
+}
+
+func (s *planWarningsTestServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	for _, warning := range s.warnings {
+		sdkv2.AddPlanWarning(ctx, warning)
+	}
+
+	return &tfprotov5.PlanResourceChangeResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  "existing",
+			},
+		},
+	}, nil
+}
+
+func TestPlanWarningsServer(t *testing.T) {
+	t.Parallel()
+
+	server := newPlanWarningsServer(&planWarningsTestServer{
+		warnings: []sdkv2.PlanWarning{
+			{Attribute: "policy", Summary: "IAM policy validation", Detail: "statement 0: invalid condition operator (StringEqual)"},
+			{Summary: "resource warning"},
+		},
+	})
+
+	response, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{TypeName: "aws_iam_policy"})
+	if err != nil {
+		t.Fatalf("PlanResourceChange() error = %s", err)
+	}
+
+	want := []*tfprotov5.Diagnostic{
+		{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "existing",
+		},
+		{
+			Severity:  tfprotov5.DiagnosticSeverityWarning,
+			Summary:   "IAM policy validation",
+			Detail:    "statement 0: invalid condition operator (StringEqual)",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("policy"),
+		},
+		{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "resource warning",
+		},
+	}
+	if diff := cmp.Diff(response.Diagnostics, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
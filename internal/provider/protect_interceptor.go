@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// verifyNotProtected returns an error if the specified resource tags match the provider's `protect_from_destroy` configuration.
+func verifyNotProtected(ctx context.Context, protectConfig *tftags.ProtectConfig, tagsAll any, id string) error {
+	if protectConfig == nil {
+		return nil
+	}
+
+	v, ok := tagsAll.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	match, ok := protectConfig.Match(tftags.New(ctx, v))
+	if !ok {
+		return nil
+	}
+
+	resourceName := "resource"
+	if inContext, ok := conns.FromContext(ctx); ok && inContext.ResourceName != "" {
+		resourceName = inContext.ResourceName
+	}
+
+	return fmt.Errorf("%s (%s) is protected from destroy by tag (%s). Remove the tag, or update the provider's protect_from_destroy configuration, to allow it to be destroyed or replaced", resourceName, id, match)
+}
+
+// protectFromDestroyCustomizeDiff returns an error if an existing resource protected by the provider's
+// `protect_from_destroy` configuration would be replaced.
+// Replacement forced by a resource's own CustomizeDiff cannot be detected here and is refused when the resource is deleted.
+func protectFromDestroyCustomizeDiff(schemaMap map[string]*schema.Schema) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		protectConfig := meta.(*conns.AWSClient).ProtectConfig
+		if protectConfig == nil || d.Id() == "" {
+			return nil
+		}
+
+		if !requiresReplacement(d, "", schemaMap) {
+			return nil
+		}
+
+		// The current tags are those in state.
+		o, _ := d.GetChange(names.AttrTagsAll)
+
+		return verifyNotProtected(ctx, protectConfig, o, d.Id())
+	}
+}
+
+// withProtectFromDestroyCustomizeDiff appends protectFromDestroyCustomizeDiff to any resource-defined CustomizeDiff.
+func withProtectFromDestroyCustomizeDiff(f schema.CustomizeDiffFunc, schemaMap map[string]*schema.Schema) schema.CustomizeDiffFunc {
+	if f == nil {
+		return protectFromDestroyCustomizeDiff(schemaMap)
+	}
+
+	return customdiff.Sequence(f, protectFromDestroyCustomizeDiff(schemaMap))
+}
+
+// requiresReplacement returns whether a change to a ForceNew attribute under the specified prefix requires replacement.
+func requiresReplacement(d *schema.ResourceDiff, prefix string, schemaMap map[string]*schema.Schema) bool {
+	for k, v := range schemaMap {
+		key := prefix + k
+		if !d.HasChange(key) {
+			continue
+		}
+
+		if v.ForceNew {
+			return true
+		}
+
+		elem, ok := v.Elem.(*schema.Resource)
+		if !ok {
+			continue
+		}
+
+		switch v.Type {
+		case schema.TypeList:
+			o, n := d.GetChange(key)
+			oldLen, newLen := len(o.([]any)), len(n.([]any))
+
+			// Added or removed blocks change any nested ForceNew attributes.
+			if oldLen != newLen && hasForceNew(elem.SchemaMap()) {
+				return true
+			}
+
+			for i := range min(oldLen, newLen) {
+				if requiresReplacement(d, fmt.Sprintf("%s.%d.", key, i), elem.SchemaMap()) {
+					return true
+				}
+			}
+		case schema.TypeSet:
+			// Any change to a set element replaces the element.
+			if hasForceNew(elem.SchemaMap()) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hasForceNew returns whether any attribute in the specified schema, including nested attributes, is ForceNew.
+func hasForceNew(schemaMap map[string]*schema.Schema) bool {
+	for _, v := range schemaMap {
+		if v.ForceNew {
+			return true
+		}
+
+		if elem, ok := v.Elem.(*schema.Resource); ok && hasForceNew(elem.SchemaMap()) {
+			return true
+		}
+	}
+
+	return false
+}
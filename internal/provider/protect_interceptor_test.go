@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestProtectFromDestroyCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaMap := map[string]*schema.Schema{
+		names.AttrName: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		names.AttrDescription: {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"settings": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mode": {
+						Type:     schema.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+					names.AttrValue: {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		names.AttrTags: {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		names.AttrTagsAll: {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	r := &schema.Resource{
+		Schema:        schemaMap,
+		CustomizeDiff: protectFromDestroyCustomizeDiff(schemaMap),
+	}
+	meta := &conns.AWSClient{
+		ProtectConfig: &tftags.ProtectConfig{
+			Tags: tftags.New(ctx, map[string]string{"DoNotDelete": "true"}),
+		},
+	}
+	state := func(tags map[string]string) *terraform.InstanceState {
+		attributes := map[string]string{
+			names.AttrName:        "test",
+			names.AttrDescription: "old",
+			"settings.#":          "1",
+			"settings.0.mode":     "a",
+			"settings.0.value":    "x",
+			"tags_all.%":          "0",
+		}
+		for k, v := range tags {
+			attributes["tags_all."+k] = v
+			attributes["tags_all.%"] = "1"
+		}
+		return &terraform.InstanceState{
+			ID:         "test",
+			Attributes: attributes,
+		}
+	}
+	protected := map[string]string{"DoNotDelete": "true"}
+
+	testCases := map[string]struct {
+		state         *terraform.InstanceState
+		config        map[string]any
+		expectedError bool
+	}{
+		"create": {
+			config: map[string]any{
+				names.AttrName: "test",
+				names.AttrTags: map[string]any{"DoNotDelete": "true"},
+			},
+		},
+		"update in place": {
+			state: state(protected),
+			config: map[string]any{
+				names.AttrName:        "test",
+				names.AttrDescription: "new",
+				"settings":            []any{map[string]any{"mode": "a", names.AttrValue: "y"}},
+				names.AttrTags:        map[string]any{"DoNotDelete": "true"},
+			},
+		},
+		"replace unprotected": {
+			state: state(nil),
+			config: map[string]any{
+				names.AttrName: "new",
+				"settings":     []any{map[string]any{"mode": "a", names.AttrValue: "x"}},
+			},
+		},
+		"replace protected": {
+			state: state(protected),
+			config: map[string]any{
+				names.AttrName: "new",
+				"settings":     []any{map[string]any{"mode": "a", names.AttrValue: "x"}},
+				names.AttrTags: map[string]any{"DoNotDelete": "true"},
+			},
+			expectedError: true,
+		},
+		"replace protected nested": {
+			state: state(protected),
+			config: map[string]any{
+				names.AttrName:        "test",
+				names.AttrDescription: "old",
+				"settings":            []any{map[string]any{"mode": "b", names.AttrValue: "x"}},
+				names.AttrTags:        map[string]any{"DoNotDelete": "true"},
+			},
+			expectedError: true,
+		},
+		"replace protected removing tag": {
+			state: state(protected),
+			config: map[string]any{
+				names.AttrName: "new",
+				"settings":     []any{map[string]any{"mode": "a", names.AttrValue: "x"}},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := r.Diff(ctx, testCase.state, terraform.NewResourceConfigRaw(testCase.config), meta)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Errorf("Diff error = %v, expected error: %t", err, want)
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
@@ -21,11 +23,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -49,6 +54,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"allowed_regions": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidRegionName,
+				},
+				Optional:      true,
+				ConflictsWith: []string{"forbidden_regions"},
+				Description:   "List of allowed AWS Regions, to prevent you from mistakenly managing resources in an incorrect Region.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -65,6 +80,37 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks with settings to default resource tags for matching resources. Rules are applied in order.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_keys": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tag keys to not default on matching resources",
+									},
+									names.AttrResourceType: {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Resource type name glob, e.g. `aws_iam_*`, of the resources to match",
+									},
+									"service": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Service package name, e.g. `ec2`, of the resources to match",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to default on matching resources",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -93,6 +139,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"allowed_account_ids"},
 			},
+			"forbidden_regions": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidRegionName,
+				},
+				Optional:      true,
+				ConflictsWith: []string{"allowed_regions"},
+				Description:   "List of forbidden AWS Regions, to prevent you from mistakenly managing resources in the wrong Region.",
+			},
 			"http_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -105,6 +161,35 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. " +
 					"Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"iam_permission_preflight": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block for checking, during planning, that the provider's IAM principal is allowed to perform the IAM actions required to apply resource changes. Only resource types that declare their IAM actions are checked.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforcement": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(conns.IAMPermissionPreflightEnforcementValues(), false),
+							Description:  "Whether missing IAM permissions are plan errors or warnings. Valid values are `error` and `warn`. Defaults to `error`.",
+						},
+						"principal_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+							Description:  "ARN of the IAM user or role whose permissions are checked. Defaults to the IAM principal of the provider's credentials.",
+						},
+					},
+				},
+			},
+			"iam_policy_validation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(conns.IAMPolicyValidationValues(), false),
+				Description: "Specifies whether IAM policy documents are validated by an offline linter during planning. " +
+					"Valid values are `off`, `warn` and `error`. Defaults to `off`.",
+			},
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -152,12 +237,106 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"protect_from_destroy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with resource tags that protect resources from being destroyed or replaced.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys that protect a resource whatever their value.",
+						},
+						names.AttrTags: {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tags that protect a resource when both key and value match.",
+						},
+					},
+				},
+			},
+			"rate_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block for client-side rate and concurrency limits on a service's API calls. Can be specified multiple times, once per service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_in_flight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Maximum number of the service's API calls in flight at any time. Defaults to no limit.",
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+							Description:  "Maximum sustained rate, in requests per second, of the service's API calls. Defaults to no limit.",
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+							Description:  "Service identifier, as used in the `endpoints` configuration block, for example `route53`.",
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block for the retry policy of a service's API calls. Can be specified multiple times, once per service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of attempts for each of the service's API calls. Defaults to the provider's `max_retries`.",
+						},
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Maximum backoff between attempts, as a duration string, for example `30s`. Defaults to `300s`.",
+						},
+						"retry_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(enum.Slice(aws.RetryModeStandard, aws.RetryModeAdaptive), false),
+							Description:  "Specifies how the service's retries are attempted. Valid values are `standard` and `adaptive`. Defaults to the provider's `retry_mode`.",
+						},
+						"retryable_error_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "API error codes, in addition to the AWS SDK's defaults, that are retried.",
+						},
+						"retryable_error_messages": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Substrings of API error messages, in addition to the AWS SDK's defaults, that are retried.",
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+							Description:  "Service identifier, as used in the `endpoints` configuration block, for example `route53`.",
+						},
+					},
+				},
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -228,6 +407,66 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with rules that resource tags must satisfy during planning.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforcement": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(tftags.PolicyEnforcementValues(), false),
+							Description:  "Whether tag policy violations are plan errors or warnings. Valid values are `error` and `warn`. Defaults to `error`.",
+						},
+						"key_case": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(tftags.PolicyKeyCaseValues(), false),
+							Description:  "Case that all resource tag keys must use. Valid values are `lower`, `pascal` and `upper`.",
+						},
+						"organizations_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+							Description:  "AWS Organizations tag policy JSON document from which to import tag key and value rules.",
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys that all resources must have.",
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks with rules for individual resource tag keys.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Allowed values for the resource tag. A trailing `*` matches any suffix.",
+									},
+									names.AttrKey: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Resource tag key, in the required case.",
+									},
+									"value_regex": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression that the resource tag value must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -239,6 +478,45 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:    true,
 				Description: "The capacity of the AWS SDK's token bucket rate limiter.",
 			},
+			"tracing": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block for OpenTelemetry tracing of Terraform RPCs, resource operations and AWS API calls.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEndpoint: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Description:  "OTLP/HTTP endpoint URL to which spans are exported. Defaults to the value of the `OTEL_EXPORTER_OTLP_TRACES_ENDPOINT` or `OTEL_EXPORTER_OTLP_ENDPOINT` environment variable.",
+						},
+						"exporter": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      tracing.ExporterOTLP,
+							ValidateFunc: validation.StringInSlice(tracing.ExporterValues(), false),
+							Description:  "Span exporter. Valid values are `file` and `otlp`. Defaults to `otlp`.",
+						},
+						"file_path": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Path of the file to which spans are appended as JSON by the `file` exporter.",
+						},
+						"headers": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Additional HTTP headers sent by the `otlp` exporter.",
+						},
+						"insecure": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether the `otlp` exporter uses HTTP rather than HTTPS.",
+						},
+					},
+				},
+			},
 			"use_dualstack_endpoint": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -290,7 +568,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResource(typeName, servicePackageName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -298,6 +576,20 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			if !names.IsGlobalService(servicePackageName) {
+				// The data source is regional.
+				// Inject a top-level `region` attribute unless the schema already defines one.
+				if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+					addRegionAttribute(r, regionDataSourceSchema())
+
+					interceptors = append(interceptors, interceptorItem{
+						when:        Before | After,
+						why:         Read,
+						interceptor: regionInterceptor{},
+					})
+				}
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -367,13 +659,52 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResource(typeName, servicePackageName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
 			interceptors := interceptorItems{}
+			var isRegional bool
+
+			if !names.IsGlobalService(servicePackageName) {
+				// The resource is regional.
+				// Inject a top-level `region` attribute unless the schema already defines one.
+				if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+					isRegional = true
+					addRegionAttribute(r, regionResourceSchema())
+					r.CustomizeDiff = withRegionCustomizeDiff(r.CustomizeDiff)
+
+					interceptors = append(interceptors, interceptorItem{
+						when:        Before | After,
+						why:         AllOps,
+						interceptor: regionInterceptor{},
+					})
+				}
+			}
+
+			if _, ok := r.SchemaMap()[names.AttrTagsAll]; ok {
+				// The resource can be protected from destroy by its tags.
+				r.CustomizeDiff = withProtectFromDestroyCustomizeDiff(r.CustomizeDiff, r.SchemaMap())
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before,
+					why:         Delete,
+					interceptor: protectFromDestroyInterceptor{},
+				})
+			}
+
+			if v.Identity.HasAttributes() {
+				// The resource has a resource identity.
+				r.Identity = sdkv2.IdentitySchema(v.Identity)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: identityInterceptor{identity: v.Identity},
+				})
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -427,8 +758,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			if v := r.DeleteWithoutTimeout; v != nil {
 				r.DeleteWithoutTimeout = rs.Delete(v)
 			}
+			identity := v.Identity
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
+					if isRegional {
+						v = importRegion(v)
+					}
+					if identity.HasAttributes() {
+						v = importIdentity(v, identity)
+					}
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
@@ -481,6 +819,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
+		IAMPolicyValidation:            d.Get("iam_policy_validation").(string),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
@@ -514,14 +853,31 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("allowed_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		for i, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				// An empty configuration block.
+				if len(v.([]interface{})) > 1 {
+					return nil, sdkdiag.AppendErrorf(diags, "assume_role[%d]: role_arn must be set when more than one role is assumed", i)
+				}
+				continue
+			}
+
+			assumeRole := expandAssumeRole(ctx, tfMap)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+			config.AssumeRole = append(config.AssumeRole, assumeRole)
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -534,6 +890,16 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		for i, rule := range config.DefaultTagsConfig.Rules {
+			if rule.ResourceType == "" && rule.Service == "" {
+				return nil, sdkdiag.AppendErrorf(diags, "default_tags.rule[%d]: one of `resource_type` or `service` must be specified", i)
+			}
+
+			if _, err := filepath.Match(rule.ResourceType, ""); err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "default_tags.rule[%d]: invalid `resource_type` (%s): %s", i, rule.ResourceType, err)
+			}
+		}
 	}
 
 	v := d.Get("endpoints")
@@ -548,6 +914,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("forbidden_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.ForbiddenRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOkExists("http_proxy"); ok {
 		if s, sok := v.(string); sok {
 			config.HTTPProxy = aws.String(s)
@@ -567,6 +937,42 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("protect_from_destroy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.ProtectConfig = expandProtectFromDestroy(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		policyConfig, err := expandTagPolicy(ctx, v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "tag_policy: %s", err)
+		}
+		config.TagPolicyConfig = policyConfig
+	}
+
+	if v, ok := d.GetOk("iam_permission_preflight"); ok && len(v.([]interface{})) > 0 {
+		config.IAMPermissionPreflight = expandIAMPermissionPreflight(ctx, v.([]interface{})[0])
+	}
+
+	if v, ok := d.GetOk("rate_limits"); ok && len(v.([]interface{})) > 0 {
+		rateLimits, err := expandRateLimits(ctx, v.([]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "rate_limits: %s", err)
+		}
+		config.RateLimits = rateLimits
+	}
+
+	if v, ok := d.GetOk("retry"); ok && len(v.([]interface{})) > 0 {
+		retryConfigs, err := expandRetryConfigs(ctx, v.([]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "retry: %s", err)
+		}
+		config.RetryConfigs = retryConfigs
+	}
+
+	if v, ok := d.GetOk("tracing"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.Tracing = expandTracing(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -605,9 +1011,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks for IAM Roles to assume prior to making API calls. Roles are assumed in order, each using the previous role's credentials.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -849,9 +1255,42 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
 
+	if v, ok := tfMap["rule"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			defaultConfig.Rules = append(defaultConfig.Rules, expandDefaultTagsRule(ctx, tfMap))
+		}
+	}
+
 	return defaultConfig
 }
 
+func expandDefaultTagsRule(ctx context.Context, tfMap map[string]interface{}) tftags.DefaultRule {
+	rule := tftags.DefaultRule{}
+
+	if v, ok := tfMap["exclude_keys"].(*schema.Set); ok {
+		rule.ExcludeKeys = tftags.New(ctx, v.List())
+	}
+
+	if v, ok := tfMap[names.AttrResourceType].(string); ok {
+		rule.ResourceType = v
+	}
+
+	if v, ok := tfMap["service"].(string); ok {
+		rule.Service = v
+	}
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+		rule.Tags = tftags.New(ctx, v)
+	}
+
+	return rule
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -870,6 +1309,211 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandProtectFromDestroy(ctx context.Context, tfMap map[string]interface{}) *tftags.ProtectConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	protectConfig := &tftags.ProtectConfig{}
+
+	if v, ok := tfMap["tag_keys"].(*schema.Set); ok {
+		protectConfig.TagKeys = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap[names.AttrTags].(map[string]interface{}); ok {
+		protectConfig.Tags = tftags.New(ctx, v)
+	}
+
+	return protectConfig
+}
+
+func expandTagPolicy(_ context.Context, tfMap map[string]interface{}) (*tftags.PolicyConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+
+	if v, ok := tfMap["enforcement"].(string); ok {
+		policyConfig.Enforcement = v
+	}
+
+	if v, ok := tfMap["key_case"].(string); ok {
+		policyConfig.KeyCase = v
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok {
+		policyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["rule"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			rule := tftags.PolicyRule{
+				Key: tfMap[names.AttrKey].(string),
+			}
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok {
+				rule.AllowedValues = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["value_regex"].(string); ok && v != "" {
+				re, err := regexp.Compile(v)
+				if err != nil {
+					return nil, fmt.Errorf("rule (%s) value_regex: %w", rule.Key, err)
+				}
+				rule.ValueRegexp = re
+			}
+
+			policyConfig.Rules = append(policyConfig.Rules, rule)
+		}
+	}
+
+	if v, ok := tfMap["organizations_policy"].(string); ok && v != "" {
+		rules, err := tftags.PolicyRulesFromOrganizationsPolicy(v)
+		if err != nil {
+			return nil, err
+		}
+		policyConfig.Rules = append(policyConfig.Rules, rules...)
+	}
+
+	return policyConfig, nil
+}
+
+func expandIAMPermissionPreflight(_ context.Context, tfMapRaw interface{}) *conns.IAMPermissionPreflightConfig {
+	// An empty configuration block enables the preflight with default settings.
+	preflightConfig := &conns.IAMPermissionPreflightConfig{}
+
+	tfMap, ok := tfMapRaw.(map[string]interface{})
+	if !ok {
+		return preflightConfig
+	}
+
+	if v, ok := tfMap["enforcement"].(string); ok {
+		preflightConfig.Enforcement = v
+	}
+
+	if v, ok := tfMap["principal_arn"].(string); ok {
+		preflightConfig.PrincipalARN = v
+	}
+
+	return preflightConfig
+}
+
+func expandRateLimits(_ context.Context, tfList []interface{}) (map[string]*conns.RateLimitConfig, error) {
+	rateLimits := make(map[string]*conns.RateLimitConfig)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		service := tfMap["service"].(string)
+		if _, ok := rateLimits[service]; ok {
+			return nil, fmt.Errorf("service %q is specified more than once", service)
+		}
+
+		rateLimit := &conns.RateLimitConfig{}
+
+		if v, ok := tfMap["max_in_flight"].(int); ok {
+			rateLimit.MaxInFlight = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			rateLimit.RequestsPerSecond = v
+		}
+
+		rateLimits[service] = rateLimit
+	}
+
+	return rateLimits, nil
+}
+
+func expandRetryConfigs(_ context.Context, tfList []interface{}) (map[string]*conns.RetryConfig, error) {
+	retryConfigs := make(map[string]*conns.RetryConfig)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		service := tfMap["service"].(string)
+		if _, ok := retryConfigs[service]; ok {
+			return nil, fmt.Errorf("service %q is specified more than once", service)
+		}
+
+		retryConfig := &conns.RetryConfig{}
+
+		if v, ok := tfMap["max_attempts"].(int); ok {
+			retryConfig.MaxAttempts = v
+		}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf("service %q: max_backoff: %w", service, err)
+			}
+			retryConfig.MaxBackoff = d
+		}
+
+		if v, ok := tfMap["retry_mode"].(string); ok && v != "" {
+			mode, err := aws.ParseRetryMode(v)
+			if err != nil {
+				return nil, fmt.Errorf("service %q: retry_mode: %w", service, err)
+			}
+			retryConfig.Mode = mode
+		}
+
+		if v, ok := tfMap["retryable_error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			retryConfig.RetryableErrorCodes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["retryable_error_messages"].(*schema.Set); ok && v.Len() > 0 {
+			retryConfig.RetryableErrorMessages = flex.ExpandStringValueSet(v)
+		}
+
+		retryConfigs[service] = retryConfig
+	}
+
+	return retryConfigs, nil
+}
+
+func expandTracing(_ context.Context, tfMap map[string]interface{}) *tracing.Config {
+	if tfMap == nil {
+		return nil
+	}
+
+	tracingConfig := &tracing.Config{}
+
+	if v, ok := tfMap[names.AttrEndpoint].(string); ok {
+		tracingConfig.Endpoint = v
+	}
+
+	if v, ok := tfMap["exporter"].(string); ok {
+		tracingConfig.Exporter = v
+	}
+
+	if v, ok := tfMap["file_path"].(string); ok {
+		tracingConfig.FilePath = v
+	}
+
+	if v, ok := tfMap["headers"].(map[string]interface{}); ok && len(v) > 0 {
+		tracingConfig.Headers = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["insecure"].(bool); ok {
+		tracingConfig.Insecure = v
+	}
+
+	return tracingConfig
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
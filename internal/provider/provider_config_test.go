@@ -29,16 +29,16 @@ func TestSharedConfigFileParsing(t *testing.T) { //nolint:paralleltest
 	testcases := map[string]struct {
 		Config                  map[string]any
 		SharedConfigurationFile string
-		Check                   func(t *testing.T, meta *conns.AWSClient)
+		Check                   func(ctx context.Context, t *testing.T, meta *conns.AWSClient)
 	}{
 		"leading newline": {
 			SharedConfigurationFile: `
 [default]
 region = us-west-2
 `, //lintignore:AWSAT003
-			Check: func(t *testing.T, meta *conns.AWSClient) {
+			Check: func(ctx context.Context, t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-west-2"; a != e {
+				if a, e := meta.Region(ctx), "us-west-2"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
@@ -49,9 +49,9 @@ region = us-west-2
 			SharedConfigurationFile: `	[default]
 	region = us-west-2
 	`, //lintignore:AWSAT003
-			Check: func(t *testing.T, meta *conns.AWSClient) {
+			Check: func(ctx context.Context, t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-west-2"; a != e {
+				if a, e := meta.Region(ctx), "us-west-2"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
@@ -63,9 +63,9 @@ region = us-west-2
 	[default]
 	region = us-west-2
 		`, //lintignore:AWSAT003
-			Check: func(t *testing.T, meta *conns.AWSClient) {
+			Check: func(ctx context.Context, t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-west-2"; a != e {
+				if a, e := meta.Region(ctx), "us-west-2"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
@@ -83,9 +83,9 @@ region = us-west-2
 	[profile test]
 	region = us-east-1
 			`, //lintignore:AWSAT003
-			Check: func(t *testing.T, meta *conns.AWSClient) {
+			Check: func(ctx context.Context, t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-east-1"; a != e {
+				if a, e := meta.Region(ctx), "us-east-1"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
@@ -102,9 +102,9 @@ region = us-west-2
 [profile test]
 region = us-east-1
 `, //lintignore:AWSAT003
-			Check: func(t *testing.T, meta *conns.AWSClient) {
+			Check: func(ctx context.Context, t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-east-1"; a != e {
+				if a, e := meta.Region(ctx), "us-east-1"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
@@ -116,8 +116,8 @@ region = us-east-1
 region = us-west-2
 sso_start_url = https://d-123456789a.awsapps.com/start#
 `, //lintignore:AWSAT003
-			Check: func(t *testing.T, meta *conns.AWSClient) {
-				awsConfig := meta.AwsConfig(context.TODO())
+			Check: func(ctx context.Context, t *testing.T, meta *conns.AWSClient) {
+				awsConfig := meta.AwsConfig(ctx)
 				var ssoStartUrl string
 				for _, source := range awsConfig.ConfigSources {
 					if shared, ok := source.(config.SharedConfig); ok {
@@ -200,7 +200,7 @@ sso_start_url = https://d-123456789a.awsapps.com/start#
 
 			meta := p.Meta().(*conns.AWSClient)
 
-			tc.Check(t, meta)
+			tc.Check(ctx, t, meta)
 		})
 	}
 }
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionDataSourceSchema returns the schema of the `region` attribute injected into regional data sources.
+func regionDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionResourceSchema returns the schema of the `region` attribute injected into regional resources.
+func regionResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// addRegionAttribute adds the top-level `region` attribute to the specified resource or data source.
+func addRegionAttribute(r *schema.Resource, s *schema.Schema) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = s
+			return m
+		}
+		return
+	}
+
+	if r.Schema == nil {
+		r.Schema = make(map[string]*schema.Schema)
+	}
+	r.Schema[names.AttrRegion] = s
+}
+
+// regionCustomizeDiff plans the provider's configured Region for new resources with no `region` value configured.
+// Any configured value is used for API calls made during CustomizeDiff.
+func regionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if v := d.GetRawConfig().GetAttr(names.AttrRegion); !v.IsNull() {
+		if v.IsKnown() {
+			// Fail at plan time if the resource would be managed in a disallowed Region.
+			if err := meta.(*conns.AWSClient).VerifyRegionAllowed(ctx, v.AsString()); err != nil {
+				return err
+			}
+
+			if inContext, ok := conns.FromContext(ctx); ok {
+				inContext.OverrideRegion = v.AsString()
+			}
+		}
+
+		return nil
+	}
+
+	if d.Id() == "" {
+		if region := meta.(*conns.AWSClient).Region(ctx); region != "" {
+			return d.SetNew(names.AttrRegion, region)
+		}
+	}
+
+	return nil
+}
+
+// withRegionCustomizeDiff prepends regionCustomizeDiff to any resource-defined CustomizeDiff.
+func withRegionCustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	if f == nil {
+		return regionCustomizeDiff
+	}
+
+	return customdiff.Sequence(regionCustomizeDiff, f)
+}
+
+// importRegion handles an optional `@region` suffix on a regional resource's import ID,
+// e.g. `sg-0123456789abcdef0@eu-west-1`.
+func importRegion(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id, region, ok := verify.ParseImportIDRegion(d.Id()); ok {
+			if err := meta.(*conns.AWSClient).VerifyRegionAllowed(ctx, region); err != nil {
+				return nil, err
+			}
+
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, err
+			}
+
+			if inContext, ok := conns.FromContext(ctx); ok {
+				inContext.OverrideRegion = region
+			}
+		}
+
+		results, err := f(ctx, d, meta)
+		if err != nil {
+			return nil, err
+		}
+
+		region := meta.(*conns.AWSClient).Region(ctx)
+		for _, v := range results {
+			if v.Get(names.AttrRegion).(string) == "" {
+				if err := v.Set(names.AttrRegion, region); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		return results, nil
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"math"
 	"math/rand"
 	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // Inspired by "github.com/ServiceWeaver/weaver/runtime/retry".
@@ -76,7 +78,12 @@ func randomizedSleep(ctx context.Context, d time.Duration) {
 }
 
 // Sleeps for the specified duration or until context is done, whichever occurs first.
+// Does not sleep if sleepless state change waits are enabled, which is only the case during VCR replay.
 func sleep(ctx context.Context, d time.Duration) {
+	if tfresource.IsSleepless() {
+		return
+	}
+
 	t := time.NewTimer(d)
 	defer t.Stop()
 	select {
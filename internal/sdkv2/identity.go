@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// IdentitySchema returns the Plugin SDK identity schema for a resource with the specified identity.
+func IdentitySchema(identity inttypes.Identity) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			m := map[string]*schema.Schema{
+				names.AttrAccountID: {
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+				},
+			}
+
+			if !identity.IsGlobalResource {
+				m[names.AttrRegion] = &schema.Schema{
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+				}
+			}
+
+			for _, v := range identity.Attributes {
+				m[v.Name] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: v.Required,
+					OptionalForImport: !v.Required,
+				}
+			}
+
+			return m
+		},
+	}
+}
+
+// SetIdentity sets the identity of the specified resource from its ID and state.
+func SetIdentity(d *schema.ResourceData, identity inttypes.Identity, accountID, region string) error {
+	v, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	if err := v.Set(names.AttrAccountID, accountID); err != nil {
+		return fmt.Errorf("setting %s: %w", names.AttrAccountID, err)
+	}
+
+	if !identity.IsGlobalResource {
+		if err := v.Set(names.AttrRegion, region); err != nil {
+			return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+		}
+	}
+
+	for _, attr := range identity.Attributes {
+		var value string
+		if attr.Name == names.AttrID {
+			value = d.Id()
+		} else {
+			value, _ = d.Get(attr.Name).(string)
+		}
+
+		if err := v.Set(attr.Name, value); err != nil {
+			return fmt.Errorf("setting %s: %w", attr.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// SetIDFromIdentity sets the specified resource's ID, and the values of its identity attributes, from its identity.
+func SetIDFromIdentity(d *schema.ResourceData, identity inttypes.Identity, v *schema.IdentityData) error {
+	values := make(map[string]string)
+	for _, attr := range identity.Attributes {
+		if value, ok := v.GetOk(attr.Name); ok {
+			values[attr.Name] = value.(string)
+		}
+	}
+
+	id, err := identity.FormatID(values)
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	for name, value := range values {
+		if name == names.AttrID {
+			continue
+		}
+
+		if err := d.Set(name, value); err != nil {
+			return fmt.Errorf("setting %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateIdentity verifies that the specified resource's identity attributes are defined in its schema
+// and that an identity round-trips through the resource's ID and state.
+// The resource's identity schema is set.
+func ValidateIdentity(r *schema.Resource, identity inttypes.Identity) error {
+	const (
+		accountID = "123456789012"
+		region    = "us-west-2" //lintignore:AWSAT003
+	)
+
+	for _, attr := range identity.Attributes {
+		if attr.Name == names.AttrID {
+			continue
+		}
+
+		s, ok := r.SchemaMap()[attr.Name]
+		if !ok {
+			return fmt.Errorf("identity attribute %q is not defined in the resource schema", attr.Name)
+		}
+		if s.Type != schema.TypeString {
+			return fmt.Errorf("identity attribute %q is not a string", attr.Name)
+		}
+	}
+
+	r.Identity = IdentitySchema(identity)
+
+	values := make(map[string]string)
+	for _, attr := range identity.Attributes {
+		values[attr.Name] = "identity-" + attr.Name
+	}
+
+	d := r.Data(&terraform.InstanceState{})
+	v, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	for name, value := range values {
+		if err := v.Set(name, value); err != nil {
+			return fmt.Errorf("setting identity %s: %w", name, err)
+		}
+	}
+
+	// Identity -> ID and state.
+	if err := SetIDFromIdentity(d, identity, v); err != nil {
+		return fmt.Errorf("setting ID from identity: %w", err)
+	}
+
+	parsed, err := identity.ParseID(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing ID (%s): %w", d.Id(), err)
+	}
+	for name, got := range parsed {
+		if want := values[name]; got != want {
+			return fmt.Errorf("ID (%s) attribute %q parses as %q, want %q", d.Id(), name, got, want)
+		}
+	}
+
+	// ID and state -> identity.
+	for name := range values {
+		if err := v.Set(name, ""); err != nil {
+			return fmt.Errorf("clearing identity %s: %w", name, err)
+		}
+	}
+	if err := SetIdentity(d, identity, accountID, region); err != nil {
+		return fmt.Errorf("setting identity: %w", err)
+	}
+
+	for name, want := range values {
+		if got, _ := v.Get(name).(string); got != want {
+			return fmt.Errorf("identity attribute %q round-trips as %q, want %q", name, got, want)
+		}
+	}
+
+	return nil
+}
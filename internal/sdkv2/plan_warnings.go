@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// The Plugin SDK does not return warnings from a resource's CustomizeDiff.
+// Instead, warnings are added to the context passed to CustomizeDiff and the provider server
+// returns them as diagnostics of the planned resource change.
+
+// PlanWarning is a warning about a planned resource change.
+type PlanWarning struct {
+	Attribute string // Name of the top-level attribute that the warning applies to. Empty if it applies to the whole resource.
+	Summary   string
+	Detail    string
+}
+
+type planWarningsKey struct{}
+
+type planWarnings struct {
+	mu       sync.Mutex
+	warnings []PlanWarning
+}
+
+// ContextWithPlanWarnings returns a context that collects the warnings added by AddPlanWarning,
+// and a function that returns the collected warnings.
+func ContextWithPlanWarnings(ctx context.Context) (context.Context, func() []PlanWarning) {
+	v := &planWarnings{}
+
+	return context.WithValue(ctx, planWarningsKey{}, v), func() []PlanWarning {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+
+		return v.warnings
+	}
+}
+
+// AddPlanWarning adds a warning to be returned with the planned resource change.
+// Warnings added outside of planning, e.g. when CustomizeDiff is run during apply, are logged.
+func AddPlanWarning(ctx context.Context, warning PlanWarning) {
+	v, ok := ctx.Value(planWarningsKey{}).(*planWarnings)
+	if !ok {
+		tflog.Warn(ctx, warning.Summary, map[string]any{
+			"attribute": warning.Attribute,
+			"detail":    warning.Detail,
+		})
+
+		return
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.warnings = append(v.warnings, warning)
+}
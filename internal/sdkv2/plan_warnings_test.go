@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPlanWarnings(t *testing.T) {
+	t.Parallel()
+
+	ctx, warnings := ContextWithPlanWarnings(context.Background())
+
+	if got := warnings(); len(got) != 0 {
+		t.Fatalf("warnings() = %v, want none", got)
+	}
+
+	AddPlanWarning(ctx, PlanWarning{Attribute: "policy", Summary: "summary 1", Detail: "detail 1"})
+	AddPlanWarning(ctx, PlanWarning{Summary: "summary 2"})
+
+	want := []PlanWarning{
+		{Attribute: "policy", Summary: "summary 1", Detail: "detail 1"},
+		{Summary: "summary 2"},
+	}
+	if diff := cmp.Diff(warnings(), want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Warnings added to a context that doesn't collect them are only logged.
+	AddPlanWarning(context.Background(), PlanWarning{Summary: "summary 3"})
+
+	if diff := cmp.Diff(warnings(), want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
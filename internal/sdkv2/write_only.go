@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Write-only attributes are set in configuration but are never persisted to plan or state.
+// Each write-only attribute, e.g. `password_wo`, is paired with a `*_wo_version` attribute
+// which is persisted and whose change signals that the write-only value should be sent to AWS again.
+
+// GetWriteOnlyValue returns the raw configuration value of the write-only attribute at the specified path.
+func GetWriteOnlyValue(d *schema.ResourceData, path cty.Path, attrType cty.Type) (cty.Value, diag.Diagnostics) {
+	v, diags := d.GetRawConfigAt(path)
+	if diags.HasError() {
+		return cty.NilVal, diags
+	}
+
+	if !v.Type().Equals(attrType) {
+		return cty.NilVal, append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid write-only attribute type",
+			Detail:        "Expected " + attrType.FriendlyName() + ", got " + v.Type().FriendlyName(),
+			AttributePath: path,
+		})
+	}
+
+	return v, diags
+}
+
+// GetWriteOnlyStringValue returns the string value of the write-only attribute at the specified path.
+// An empty string is returned if the attribute is not configured.
+func GetWriteOnlyStringValue(d *schema.ResourceData, path cty.Path) (string, diag.Diagnostics) {
+	v, diags := GetWriteOnlyValue(d, path, cty.String)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if v.IsNull() || !v.IsKnown() {
+		return "", diags
+	}
+
+	return v.AsString(), diags
+}
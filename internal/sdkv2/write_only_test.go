@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestGetWriteOnlyStringValue(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"password_wo": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"password_wo_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		rawConfig     cty.Value
+		path          cty.Path
+		expectedValue string
+		expectError   bool
+	}{
+		"configured": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password_wo":         cty.StringVal("avoid-plaintext-passwords"),
+				"password_wo_version": cty.NumberIntVal(1),
+			}),
+			path:          cty.GetAttrPath("password_wo"),
+			expectedValue: "avoid-plaintext-passwords",
+		},
+		"null": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password_wo":         cty.NullVal(cty.String),
+				"password_wo_version": cty.NullVal(cty.Number),
+			}),
+			path:          cty.GetAttrPath("password_wo"),
+			expectedValue: "",
+		},
+		"unknown": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password_wo":         cty.UnknownVal(cty.String),
+				"password_wo_version": cty.NumberIntVal(1),
+			}),
+			path:          cty.GetAttrPath("password_wo"),
+			expectedValue: "",
+		},
+		"wrong type": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password_wo":         cty.NullVal(cty.String),
+				"password_wo_version": cty.NumberIntVal(1),
+			}),
+			path:        cty.GetAttrPath("password_wo_version"),
+			expectError: true,
+		},
+		"invalid path": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password_wo":         cty.StringVal("avoid-plaintext-passwords"),
+				"password_wo_version": cty.NumberIntVal(1),
+			}),
+			path:        cty.GetAttrPath("secret_wo"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := r.Data(&terraform.InstanceState{RawConfig: testCase.rawConfig})
+
+			got, diags := GetWriteOnlyStringValue(d, testCase.path)
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Fatalf("GetWriteOnlyStringValue() error = %t, want %t: %v", got, want, diags)
+			}
+
+			if got, want := got, testCase.expectedValue; got != want {
+				t.Errorf("GetWriteOnlyStringValue() = %q, want %q", got, want)
+			}
+		})
+	}
+}
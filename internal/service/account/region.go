@@ -209,7 +209,7 @@ func waitRegionEnabled(ctx context.Context, conn *account.Client, accountID, reg
 		PollInterval: 30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*account.GetRegionOptStatusOutput); ok {
 		return output, err
@@ -228,7 +228,7 @@ func waitRegionDisabled(ctx context.Context, conn *account.Client, accountID, re
 		PollInterval: 30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*account.GetRegionOptStatusOutput); ok {
 		return output, err
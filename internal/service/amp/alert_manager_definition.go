@@ -193,7 +193,7 @@ func waitAlertManagerDefinitionCreated(ctx context.Context, conn *amp.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.AlertManagerDefinitionDescription); ok {
 		if statusCode := output.Status.StatusCode; statusCode == types.AlertManagerDefinitionStatusCodeCreationFailed {
@@ -217,7 +217,7 @@ func waitAlertManagerDefinitionUpdated(ctx context.Context, conn *amp.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.AlertManagerDefinitionDescription); ok {
 		if statusCode := output.Status.StatusCode; statusCode == types.AlertManagerDefinitionStatusCodeUpdateFailed {
@@ -241,7 +241,7 @@ func waitAlertManagerDefinitionDeleted(ctx context.Context, conn *amp.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.AlertManagerDefinitionDescription); ok {
 		return output, err
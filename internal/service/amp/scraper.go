@@ -475,7 +475,7 @@ func waitScraperCreated(ctx context.Context, conn *amp.Client, id string, timeou
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if out, ok := outputRaw.(*awstypes.ScraperDescription); ok {
 		return out, err
@@ -492,7 +492,7 @@ func waitScraperDeleted(ctx context.Context, conn *amp.Client, id string, timeou
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ScraperDescription); ok {
 		return output, err
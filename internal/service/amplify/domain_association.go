@@ -284,7 +284,7 @@ func waitDomainAssociationCreated(ctx context.Context, conn *amplify.Client, app
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*types.DomainAssociation); ok {
 		if v.DomainStatus == types.DomainStatusFailed {
@@ -308,7 +308,7 @@ func waitDomainAssociationVerified(ctx context.Context, conn *amplify.Client, ap
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*types.DomainAssociation); ok {
 		if v.DomainStatus == types.DomainStatusFailed {
@@ -193,7 +193,7 @@ func resourceAuthorizerRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading API Gateway Authorizer (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrARN, authorizerARN(meta.(*conns.AWSClient), apiID, d.Id()))
+	d.Set(names.AttrARN, authorizerARN(ctx, meta.(*conns.AWSClient), apiID, d.Id()))
 	d.Set("authorizer_credentials", authorizer.AuthorizerCredentials)
 	if authorizer.AuthorizerResultTtlInSeconds != nil { // nosemgrep:ci.helper-schema-ResourceData-Set-extraneous-nil-check
 		d.Set("authorizer_result_ttl_in_seconds", authorizer.AuthorizerResultTtlInSeconds)
@@ -379,11 +379,11 @@ func findAuthorizerByTwoPartKey(ctx context.Context, conn *apigateway.Client, au
 	return output, nil
 }
 
-func authorizerARN(c *conns.AWSClient, apiID, authorizerID string) string {
+func authorizerARN(ctx context.Context, c *conns.AWSClient, apiID, authorizerID string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   "apigateway",
-		Region:    c.Region,
+		Region:    c.Region(ctx),
 		Resource:  fmt.Sprintf("/restapis/%s/authorizers/%s", apiID, authorizerID),
 	}.String()
 }
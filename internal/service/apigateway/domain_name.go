@@ -250,7 +250,7 @@ func resourceDomainNameRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading API Gateway Domain Name (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrARN, domainNameARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set(names.AttrARN, domainNameARN(ctx, meta.(*conns.AWSClient), d.Id()))
 	d.Set(names.AttrCertificateARN, domainName.CertificateArn)
 	d.Set("certificate_name", domainName.CertificateName)
 	if domainName.CertificateUploadDate != nil {
@@ -467,7 +467,7 @@ func waitDomainNameUpdated(ctx context.Context, conn *apigateway.Client, domainN
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DomainName); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.DomainNameStatusMessage)))
@@ -516,11 +516,11 @@ func flattenMutualTLSAuthentication(apiObject *types.MutualTlsAuthentication) []
 	return []interface{}{tfMap}
 }
 
-func domainNameARN(c *conns.AWSClient, domainName string) string {
+func domainNameARN(ctx context.Context, c *conns.AWSClient, domainName string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   "apigateway",
-		Region:    c.Region,
+		Region:    c.Region(ctx),
 		Resource:  fmt.Sprintf("/domainnames/%s", domainName),
 	}.String()
 }
@@ -337,7 +337,7 @@ func (d *resourceEnvironmentData) refreshFromCreateOutput(ctx context.Context, m
 	envID := aws.ToString(out.Id)
 
 	d.ApplicationID = types.StringValue(appID)
-	d.ARN = types.StringValue(environmentARN(meta, aws.ToString(out.ApplicationId), aws.ToString(out.Id)).String())
+	d.ARN = types.StringValue(environmentARN(ctx, meta, aws.ToString(out.ApplicationId), aws.ToString(out.Id)).String())
 	d.Description = flex.StringToFrameworkLegacy(ctx, out.Description)
 	d.EnvironmentID = types.StringValue(envID)
 	d.ID = types.StringValue(fmt.Sprintf("%s:%s", envID, appID))
@@ -359,7 +359,7 @@ func (d *resourceEnvironmentData) refreshFromGetOutput(ctx context.Context, meta
 	envID := aws.ToString(out.Id)
 
 	d.ApplicationID = types.StringValue(appID)
-	d.ARN = types.StringValue(environmentARN(meta, aws.ToString(out.ApplicationId), aws.ToString(out.Id)).String())
+	d.ARN = types.StringValue(environmentARN(ctx, meta, aws.ToString(out.ApplicationId), aws.ToString(out.Id)).String())
 	d.Description = flex.StringToFrameworkLegacy(ctx, out.Description)
 	d.EnvironmentID = types.StringValue(envID)
 	d.ID = types.StringValue(fmt.Sprintf("%s:%s", envID, appID))
@@ -381,7 +381,7 @@ func (d *resourceEnvironmentData) refreshFromUpdateOutput(ctx context.Context, m
 	envID := aws.ToString(out.Id)
 
 	d.ApplicationID = types.StringValue(appID)
-	d.ARN = types.StringValue(environmentARN(meta, aws.ToString(out.ApplicationId), aws.ToString(out.Id)).String())
+	d.ARN = types.StringValue(environmentARN(ctx, meta, aws.ToString(out.ApplicationId), aws.ToString(out.Id)).String())
 	d.Description = flex.StringToFrameworkLegacy(ctx, out.Description)
 	d.EnvironmentID = types.StringValue(envID)
 	d.ID = types.StringValue(fmt.Sprintf("%s:%s", envID, appID))
@@ -413,11 +413,11 @@ func (d *resourceEnvironmentData) deleteEnvironmentInput() *appconfig.DeleteEnvi
 	}
 }
 
-func environmentARN(meta *conns.AWSClient, appID, envID string) arn.ARN {
+func environmentARN(ctx context.Context, meta *conns.AWSClient, appID, envID string) arn.ARN {
 	return arn.ARN{
 		AccountID: meta.AccountID,
 		Partition: meta.Partition,
-		Region:    meta.Region,
+		Region:    meta.Region(ctx),
 		Resource:  fmt.Sprintf("application/%s/environment/%s", appID, envID),
 		Service:   "appconfig",
 	}
@@ -292,7 +292,7 @@ func waitAutoScalingConfigurationCreated(ctx context.Context, conn *apprunner.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.AutoScalingConfiguration); ok {
 		return output, err
@@ -312,7 +312,7 @@ func waitAutoScalingConfigurationDeleted(ctx context.Context, conn *apprunner.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.AutoScalingConfiguration); ok {
 		return output, err
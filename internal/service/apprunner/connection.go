@@ -226,7 +226,7 @@ func waitConnectionDeleted(ctx context.Context, conn *apprunner.Client, name str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ConnectionSummary); ok {
 		return output, err
@@ -53,7 +53,7 @@ func resourceFleetStackAssociationCreate(ctx context.Context, d *schema.Resource
 		StackName: aws.String(d.Get("stack_name").(string)),
 	}
 
-	err := retry.RetryContext(ctx, fleetOperationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, fleetOperationTimeout, func() *retry.RetryError {
 		_, err := conn.AssociateFleetWithContext(ctx, input)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
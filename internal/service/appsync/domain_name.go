@@ -133,7 +133,7 @@ func resourceDomainNameDelete(ctx context.Context, d *schema.ResourceData, meta
 		DomainName: aws.String(d.Id()),
 	}
 
-	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 5*time.Minute, func() *retry.RetryError {
 		_, err := conn.DeleteDomainNameWithContext(ctx, input)
 		if tfawserr.ErrCodeEquals(err, appsync.ErrCodeConcurrentModificationException) {
 			return retry.RetryableError(fmt.Errorf("deleting Appsync Domain Name %q: %w", d.Id(), err))
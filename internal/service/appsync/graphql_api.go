@@ -304,7 +304,7 @@ func resourceGraphQLAPICreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if v, ok := d.GetOk("additional_authentication_provider"); ok {
-		input.AdditionalAuthenticationProviders = expandGraphQLAPIAdditionalAuthProviders(v.([]interface{}), meta.(*conns.AWSClient).Region)
+		input.AdditionalAuthenticationProviders = expandGraphQLAPIAdditionalAuthProviders(v.([]interface{}), meta.(*conns.AWSClient).Region(ctx))
 	}
 
 	if v, ok := d.GetOk("lambda_authorizer_config"); ok {
@@ -320,7 +320,7 @@ func resourceGraphQLAPICreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if v, ok := d.GetOk("user_pool_config"); ok {
-		input.UserPoolConfig = expandGraphQLAPIUserPoolConfig(v.([]interface{}), meta.(*conns.AWSClient).Region)
+		input.UserPoolConfig = expandGraphQLAPIUserPoolConfig(v.([]interface{}), meta.(*conns.AWSClient).Region(ctx))
 	}
 
 	if v, ok := d.GetOk("introspection_config"); ok {
@@ -420,7 +420,7 @@ func resourceGraphQLAPIUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if v, ok := d.GetOk("additional_authentication_provider"); ok {
-			input.AdditionalAuthenticationProviders = expandGraphQLAPIAdditionalAuthProviders(v.([]interface{}), meta.(*conns.AWSClient).Region)
+			input.AdditionalAuthenticationProviders = expandGraphQLAPIAdditionalAuthProviders(v.([]interface{}), meta.(*conns.AWSClient).Region(ctx))
 		}
 
 		if v, ok := d.GetOk("lambda_authorizer_config"); ok {
@@ -436,7 +436,7 @@ func resourceGraphQLAPIUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if v, ok := d.GetOk("user_pool_config"); ok {
-			input.UserPoolConfig = expandGraphQLAPIUserPoolConfig(v.([]interface{}), meta.(*conns.AWSClient).Region)
+			input.UserPoolConfig = expandGraphQLAPIUserPoolConfig(v.([]interface{}), meta.(*conns.AWSClient).Region(ctx))
 		}
 
 		if v, ok := d.GetOk("introspection_config"); ok {
@@ -586,7 +586,7 @@ func waitSchemaCreated(ctx context.Context, conn *appsync.AppSync, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*appsync.GetSchemaCreationStatusOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Details)))
@@ -2539,7 +2539,7 @@ func waitGroupCapacitySatisfied(ctx context.Context, conn *autoscaling.Client, e
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(struct{ err error }); ok {
 		tfresource.SetLastError(err, output.err)
@@ -2555,7 +2555,7 @@ func waitGroupDrained(ctx context.Context, conn *autoscaling.Client, name string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AutoScalingGroup); ok {
 		return output, err
@@ -2571,7 +2571,7 @@ func waitLoadBalancersAdded(ctx context.Context, conn *autoscaling.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.LoadBalancerState); ok {
 		return output, err
@@ -2587,7 +2587,7 @@ func waitLoadBalancersRemoved(ctx context.Context, conn *autoscaling.Client, nam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.LoadBalancerState); ok {
 		return output, err
@@ -2603,7 +2603,7 @@ func waitLoadBalancerTargetGroupsAdded(ctx context.Context, conn *autoscaling.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.LoadBalancerTargetGroupState); ok {
 		return output, err
@@ -2619,7 +2619,7 @@ func waitLoadBalancerTargetGroupsRemoved(ctx context.Context, conn *autoscaling.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.LoadBalancerTargetGroupState); ok {
 		return output, err
@@ -2635,7 +2635,7 @@ func waitTrafficSourcesCreated(ctx context.Context, conn *autoscaling.Client, as
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.TrafficSourceState); ok {
 		return output, err
@@ -2651,7 +2651,7 @@ func waitTrafficSourcesDeleted(ctx context.Context, conn *autoscaling.Client, as
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.TrafficSourceState); ok {
 		return output, err
@@ -2686,7 +2686,7 @@ func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.InstanceRefresh); ok {
 		return output, err
@@ -2703,7 +2703,7 @@ func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.Client, name str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.WarmPoolConfiguration); ok {
 		return output, err
@@ -2719,7 +2719,7 @@ func waitWarmPoolDrained(ctx context.Context, conn *autoscaling.Client, name str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscaling.DescribeWarmPoolOutput); ok {
 		return output, err
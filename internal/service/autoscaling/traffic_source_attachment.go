@@ -216,7 +216,7 @@ func waitTrafficSourceAttachmentCreated(ctx context.Context, conn *autoscaling.C
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TrafficSourceState); ok {
 		return output, err
@@ -233,7 +233,7 @@ func waitTrafficSourceAttachmentDeleted(ctx context.Context, conn *autoscaling.C
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TrafficSourceState); ok {
 		return output, err
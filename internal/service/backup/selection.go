@@ -208,7 +208,7 @@ func resourceSelectionCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	// Retry for IAM eventual consistency
 	var output *backup.CreateBackupSelectionOutput
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		var err error
 		output, err = conn.CreateBackupSelectionWithContext(ctx, input)
 
@@ -257,7 +257,7 @@ func resourceSelectionRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	var resp *backup.GetBackupSelectionOutput
 
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		var err error
 
 		resp, err = conn.GetBackupSelectionWithContext(ctx, input)
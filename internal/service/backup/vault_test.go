@@ -285,7 +285,7 @@ func testAccCheckRunDynamoDBTableBackupJob(ctx context.Context, rName string) re
 		resourceARN := arn.ARN{
 			Partition: client.Partition,
 			Service:   "dynamodb",
-			Region:    client.Region,
+			Region:    client.Region(ctx),
 			AccountID: client.AccountID,
 			Resource:  fmt.Sprintf("table/%s", rName),
 		}.String()
@@ -68,7 +68,7 @@ resource "aws_batch_scheduling_policy" "test" {
 }
 
 func testAccSchedulingPolicyDataSourceConfig_basic(rName string) string {
-	return fmt.Sprintf(testAccSchedulingPolicyDataSourceConfig(rName) + `
+	return acctest.ConfigCompose(testAccSchedulingPolicyDataSourceConfig(rName), `
 data "aws_batch_scheduling_policy" "test" {
   arn = aws_batch_scheduling_policy.test.arn
 }
@@ -319,7 +319,7 @@ func waitAgentAliasCreated(ctx context.Context, conn *bedrockagent.Client, agent
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AgentAlias); ok {
 		return output, err
@@ -336,7 +336,7 @@ func waitAgentAliasUpdated(ctx context.Context, conn *bedrockagent.Client, agent
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AgentAlias); ok {
 		return output, err
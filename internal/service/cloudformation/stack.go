@@ -458,7 +458,7 @@ func waitStackCreated(ctx context.Context, conn *cloudformation.Client, name, re
 		Refresh:    statusStack(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -516,7 +516,7 @@ func waitStackUpdated(ctx context.Context, conn *cloudformation.Client, name, re
 		Refresh:    statusStack(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -557,7 +557,7 @@ func waitStackDeleted(ctx context.Context, conn *cloudformation.Client, name, re
 		Refresh:    statusStack(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -376,7 +376,7 @@ func waitEventDataStoreAvailable(ctx context.Context, conn *cloudtrail.Client, a
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudtrail.GetEventDataStoreOutput); ok {
 		return output, err
@@ -393,7 +393,7 @@ func waitEventDataStoreDeleted(ctx context.Context, conn *cloudtrail.Client, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudtrail.GetEventDataStoreOutput); ok {
 		return output, err
@@ -410,7 +410,7 @@ func waitRepositoryAssociationCreated(ctx context.Context, conn *codegurureviewe
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.RepositoryAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
@@ -429,7 +429,7 @@ func waitRepositoryAssociationDeleted(ctx context.Context, conn *codegurureviewe
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.RepositoryAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
@@ -15,12 +15,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cognito_user_pool_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cognito_user_pool_domain", &resource.Sweeper{
 		Name: "aws_cognito_user_pool_domain",
 		F:    sweepUserPoolDomains,
 	})
 
-	resource.AddTestSweepers("aws_cognito_user_pool", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cognito_user_pool", &resource.Sweeper{
 		Name: "aws_cognito_user_pool",
 		F:    sweepUserPools,
 		Dependencies: []string{
@@ -36,15 +36,15 @@ func sweepUserPoolDomains(region string) error {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
 	conn := client.CognitoIDPConn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &cognitoidentityprovider.ListUserPoolsInput{
 		MaxResults: aws.Int64(50),
 	}
 
 	err = conn.ListUserPoolsPagesWithContext(ctx, input, func(resp *cognitoidentityprovider.ListUserPoolsOutput, lastPage bool) bool {
-		if len(resp.UserPools) == 0 {
-			log.Print("[DEBUG] No Cognito user pools (i.e. domains) to sweep")
-			return false
+		if resp == nil {
+			return !lastPage
 		}
 
 		for _, u := range resp.UserPools {
@@ -56,29 +56,32 @@ func sweepUserPoolDomains(region string) error {
 				continue
 			}
 			if output.UserPool != nil && output.UserPool.Domain != nil {
-				domain := aws.StringValue(output.UserPool.Domain)
-
-				log.Printf("[INFO] Deleting Cognito user pool domain: %s", domain)
-				_, err := conn.DeleteUserPoolDomainWithContext(ctx, &cognitoidentityprovider.DeleteUserPoolDomainInput{
-					Domain:     output.UserPool.Domain,
-					UserPoolId: u.Id,
-				})
-				if err != nil {
-					log.Printf("[ERROR] Failed deleting Cognito user pool domain (%s): %s", domain, err)
-				}
+				r := resourceUserPoolDomain()
+				d := r.Data(nil)
+				d.SetId(aws.StringValue(output.UserPool.Domain))
+				d.Set("user_pool_id", u.Id)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 			}
 		}
 		return !lastPage
 	})
 
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Cognito User Pool Domain sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if awsv1.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Cognito User Pool Domain sweep for %s: %s", region, err)
-			return nil
-		}
 		return fmt.Errorf("Error retrieving Cognito User Pools: %s", err)
 	}
 
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Cognito User Pool Domains (%s): %w", region, err)
+	}
+
 	return nil
 }
 
@@ -89,38 +92,41 @@ func sweepUserPools(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.CognitoIDPConn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &cognitoidentityprovider.ListUserPoolsInput{
 		MaxResults: aws.Int64(50),
 	}
 
 	err = conn.ListUserPoolsPagesWithContext(ctx, input, func(resp *cognitoidentityprovider.ListUserPoolsOutput, lastPage bool) bool {
-		if len(resp.UserPools) == 0 {
-			log.Print("[DEBUG] No Cognito User Pools to sweep")
-			return false
+		if resp == nil {
+			return !lastPage
 		}
 
 		for _, userPool := range resp.UserPools {
-			name := aws.StringValue(userPool.Name)
+			r := resourceUserPool()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(userPool.Id))
 
-			log.Printf("[INFO] Deleting Cognito User Pool: %s", name)
-			_, err := conn.DeleteUserPoolWithContext(ctx, &cognitoidentityprovider.DeleteUserPoolInput{
-				UserPoolId: userPool.Id,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed deleting Cognito User Pool (%s): %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 		return !lastPage
 	})
 
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Cognito User Pool sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if awsv1.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Cognito User Pool sweep for %s: %s", region, err)
-			return nil
-		}
 		return fmt.Errorf("Error retrieving Cognito User Pools: %w", err)
 	}
 
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Cognito User Pools (%s): %w", region, err)
+	}
+
 	return nil
 }
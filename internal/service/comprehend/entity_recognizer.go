@@ -681,7 +681,7 @@ func waitEntityRecognizerCreated(ctx context.Context, conn *comprehend.Client, i
 		Timeout:      timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*types.EntityRecognizerProperties); ok {
 		if output.Status == types.ModelStatusInError {
 			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
@@ -702,7 +702,7 @@ func waitEntityRecognizerStopped(ctx context.Context, conn *comprehend.Client, i
 		Timeout:      timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*types.EntityRecognizerProperties); ok {
 		return out, err
 	}
@@ -721,7 +721,7 @@ func waitEntityRecognizerDeleted(ctx context.Context, conn *comprehend.Client, i
 		Timeout:        timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*types.EntityRecognizerProperties); ok {
 		return out, err
 	}
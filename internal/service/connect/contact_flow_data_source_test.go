@@ -97,7 +97,7 @@ resource "aws_connect_contact_flow" "test" {
 }
 
 func testAccContactFlowDataSourceConfig_id(rName, rName2 string) string {
-	return fmt.Sprintf(testAccContactFlowBaseDataSourceConfig(rName, rName2) + `
+	return acctest.ConfigCompose(testAccContactFlowBaseDataSourceConfig(rName, rName2), `
 data "aws_connect_contact_flow" "test" {
   instance_id     = aws_connect_instance.test.id
   contact_flow_id = aws_connect_contact_flow.test.contact_flow_id
@@ -106,7 +106,7 @@ data "aws_connect_contact_flow" "test" {
 }
 
 func testAccContactFlowDataSourceConfig_name(rName, rName2 string) string {
-	return fmt.Sprintf(testAccContactFlowBaseDataSourceConfig(rName, rName2) + `
+	return acctest.ConfigCompose(testAccContactFlowBaseDataSourceConfig(rName, rName2), `
 data "aws_connect_contact_flow" "test" {
   instance_id = aws_connect_instance.test.id
   name        = aws_connect_contact_flow.test.name
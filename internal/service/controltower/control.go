@@ -246,7 +246,7 @@ func waitOperationSucceeded(ctx context.Context, conn *controltower.Client, id s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*controltower.GetControlOperationOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ControlOperation.StatusMessage)))
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -164,7 +165,7 @@ func WaitForDeletion(ctx context.Context, conn *datapipeline.DataPipeline, pipel
 	params := &datapipeline.DescribePipelinesInput{
 		PipelineIds: []*string{aws.String(pipelineID)},
 	}
-	return retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
+	return tfresource.Retry(ctx, 10*time.Minute, func() *retry.RetryError {
 		_, err := conn.DescribePipelinesWithContext(ctx, params)
 		if tfawserr.ErrCodeEquals(err, datapipeline.ErrCodePipelineNotFoundException) || tfawserr.ErrCodeEquals(err, datapipeline.ErrCodePipelineDeletedException) {
 			return nil
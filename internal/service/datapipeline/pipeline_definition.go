@@ -155,7 +155,7 @@ func resourcePipelineDefinitionPut(ctx context.Context, d *schema.ResourceData,
 
 	var err error
 	var output *datapipeline.PutPipelineDefinitionOutput
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err = tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		output, err = conn.PutPipelineDefinitionWithContext(ctx, input)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, datapipeline.ErrCodeInternalServiceError) {
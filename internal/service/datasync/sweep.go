@@ -20,7 +20,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
 		Name: "aws_datasync_agent",
 		F:    sweepAgents,
 		Dependencies: []string{
@@ -29,7 +29,7 @@ func RegisterSweepers() {
 	})
 
 	// Pseudo-resource for any DataSync location resource type.
-	resource.AddTestSweepers("aws_datasync_location", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location", &resource.Sweeper{
 		Name: "aws_datasync_location",
 		F:    sweepLocations,
 		Dependencies: []string{
@@ -37,7 +37,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
 		Name: "aws_datasync_task",
 		F:    sweepTasks,
 	})
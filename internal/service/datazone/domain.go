@@ -366,7 +366,7 @@ func waitDomainCreated(ctx context.Context, conn *datazone.Client, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*datazone.GetDomainOutput); ok {
 		return out, err
 	}
@@ -382,7 +382,7 @@ func waitDomainDeleted(ctx context.Context, conn *datazone.Client, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*datazone.GetDomainOutput); ok {
 		return out, err
 	}
@@ -260,7 +260,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	// IAM roles take some time to propagate
 	var resp *dax.CreateClusterOutput
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		var err error
 		resp, err = conn.CreateCluster(ctx, input)
 		if errs.IsA[*awstypes.InvalidParameterValueException](err) {
@@ -296,7 +296,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	log.Printf("[DEBUG] Waiting for state to become available: %v", d.Id())
-	_, sterr := stateConf.WaitForStateContext(ctx)
+	_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 	if sterr != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DAX cluster (%s) to be created: %s", d.Id(), sterr)
 	}
@@ -462,7 +462,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			Delay:      30 * time.Second,
 		}
 
-		_, sterr := stateConf.WaitForStateContext(ctx)
+		_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 		if sterr != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for DAX (%s) to update: %s", d.Id(), sterr)
 		}
@@ -506,7 +506,7 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	req := &dax.DeleteClusterInput{
 		ClusterName: aws.String(d.Id()),
 	}
-	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 5*time.Minute, func() *retry.RetryError {
 		_, err := conn.DeleteCluster(ctx, req)
 		if errs.IsA[*awstypes.InvalidClusterStateFault](err) {
 			return retry.RetryableError(err)
@@ -541,7 +541,7 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 		Delay:      30 * time.Second,
 	}
 
-	_, sterr := stateConf.WaitForStateContext(ctx)
+	_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 	if sterr != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DAX (%s) to delete: %s", d.Id(), sterr)
 	}
@@ -568,7 +568,7 @@ func resourceDeploymentGroupRead(ctx context.Context, d *schema.ResourceData, me
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "codedeploy",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("deploymentgroup:%s/%s", appName, groupName),
 	}.String()
@@ -712,7 +712,7 @@ func resourceDeploymentGroupUpdate(ctx context.Context, d *schema.ResourceData,
 		log.Printf("[DEBUG] Updating CodeDeploy DeploymentGroup %s", d.Id())
 
 		var err error
-		err = retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+		err = tfresource.Retry(ctx, 5*time.Minute, func() *retry.RetryError {
 			_, err = conn.UpdateDeploymentGroup(ctx, &input)
 
 			if errs.IsA[*types.InvalidRoleException](err) {
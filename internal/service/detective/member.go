@@ -291,7 +291,7 @@ func waitMemberInvited(ctx context.Context, conn *detective.Detective, graphARN,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*detective.MemberDetail); ok {
 		return output, err
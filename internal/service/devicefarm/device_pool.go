@@ -150,7 +150,7 @@ func resourceDevicePoolRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set(names.AttrDescription, devicePool.Description)
 	d.Set("max_devices", devicePool.MaxDevices)
 
-	projectArn, err := decodeProjectARN(arn, "devicepool", meta)
+	projectArn, err := decodeProjectARN(ctx, arn, "devicepool", meta)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "decoding project_arn (%s): %s", arn, err)
 	}
@@ -273,7 +273,7 @@ func flattenDevicePoolRules(list []*devicefarm.Rule) []map[string]interface{} {
 	return result
 }
 
-func decodeProjectARN(id, typ string, meta interface{}) (string, error) {
+func decodeProjectARN(ctx context.Context, id, typ string, meta interface{}) (string, error) {
 	poolArn, err := arn.Parse(id)
 	if err != nil {
 		return "", fmt.Errorf("parsing '%s': %w", id, err)
@@ -289,7 +289,7 @@ func decodeProjectARN(id, typ string, meta interface{}) (string, error) {
 	projectArn := arn.ARN{
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
 		Resource:  "project:" + projectId,
 		Service:   devicefarm.ServiceName,
 	}.String()
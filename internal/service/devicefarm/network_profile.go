@@ -204,7 +204,7 @@ func resourceNetworkProfileRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("uplink_loss_percent", project.UplinkLossPercent)
 	d.Set(names.AttrType, project.Type)
 
-	projectArn, err := decodeProjectARN(arn, "networkprofile", meta)
+	projectArn, err := decodeProjectARN(ctx, arn, "networkprofile", meta)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "decoding project_arn (%s): %s", arn, err)
 	}
@@ -2723,7 +2723,7 @@ func waitEndpointDeleted(ctx context.Context, conn *dms.DatabaseMigrationService
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
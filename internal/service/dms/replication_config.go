@@ -504,7 +504,7 @@ func waitReplicationRunning(ctx context.Context, conn *dms.DatabaseMigrationServ
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.Replication); ok {
 		setLastReplicationError(err, output)
@@ -524,7 +524,7 @@ func waitReplicationStopped(ctx context.Context, conn *dms.DatabaseMigrationServ
 		Delay:      60 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.Replication); ok {
 		setLastReplicationError(err, output)
@@ -544,7 +544,7 @@ func waitReplicationDeleted(ctx context.Context, conn *dms.DatabaseMigrationServ
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.Replication); ok {
 		setLastReplicationError(err, output)
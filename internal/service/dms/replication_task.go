@@ -439,7 +439,7 @@ func waitReplicationTaskDeleted(ctx context.Context, conn *dms.DatabaseMigration
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -459,7 +459,7 @@ func waitReplicationTaskModified(ctx context.Context, conn *dms.DatabaseMigratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -479,7 +479,7 @@ func waitReplicationTaskMoved(ctx context.Context, conn *dms.DatabaseMigrationSe
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -499,7 +499,7 @@ func waitReplicationTaskReady(ctx context.Context, conn *dms.DatabaseMigrationSe
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -522,7 +522,7 @@ func waitReplicationTaskRunning(ctx context.Context, conn *dms.DatabaseMigration
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -546,7 +546,7 @@ func waitReplicationTaskStopped(ctx context.Context, conn *dms.DatabaseMigration
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -570,7 +570,7 @@ func waitReplicationTaskSteady(ctx context.Context, conn *dms.DatabaseMigrationS
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
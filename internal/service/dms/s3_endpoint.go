@@ -359,7 +359,7 @@ func resourceS3EndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 	log.Println("[DEBUG] DMS create endpoint:", input)
 
 	var out *dms.CreateEndpointOutput
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		var err error
 		out, err = conn.CreateEndpointWithContext(ctx, input)
 
@@ -519,7 +519,7 @@ func resourceS3EndpointUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 		log.Println("[DEBUG] DMS update endpoint:", input)
 
-		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 			_, err := conn.ModifyEndpointWithContext(ctx, input)
 
 			if tfawserr.ErrCodeEquals(err, "AccessDeniedFault") {
@@ -838,6 +838,41 @@ func TestAccDocDBCluster_updateEngineMajorVersion(t *testing.T) {
 	})
 }
 
+func TestAccDocDBCluster_masterPasswordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v docdb.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBServiceID),
+		TerraformVersionChecks:   acctest.WriteOnlyAttributeTerraformVersionChecks,
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_masterPasswordWriteOnly(rName, "avoid-plaintext-passwords-1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "master_password", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_masterPasswordWriteOnly(rName, "avoid-plaintext-passwords-2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "master_password", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDocDBCluster_storageType(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dbCluster docdb.DBCluster
@@ -1403,6 +1438,25 @@ resource "aws_docdb_cluster_instance" "test" {
 `, rName, engineVersion)
 }
 
+func testAccClusterConfig_masterPasswordWriteOnly(rName, masterPassword string, masterPasswordVersion int) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  availability_zones = [
+    data.aws_availability_zones.available.names[0],
+    data.aws_availability_zones.available.names[1],
+    data.aws_availability_zones.available.names[2]
+  ]
+
+  cluster_identifier         = %[1]q
+  master_password_wo         = %[2]q
+  master_password_wo_version = %[3]d
+  master_username            = "tfacctest"
+  apply_immediately          = true
+  skip_final_snapshot        = true
+}
+`, rName, masterPassword, masterPasswordVersion))
+}
+
 func testAccClusterConfig_storageType(rName, storageType string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_docdb_cluster" "test" {
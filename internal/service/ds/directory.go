@@ -749,7 +749,7 @@ func waitDirectoryCreated(ctx context.Context, conn *directoryservice.DirectoryS
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	// Wrap any error returned with waiting message
 	defer func() {
@@ -775,7 +775,7 @@ func waitDirectoryDeleted(ctx context.Context, conn *directoryservice.DirectoryS
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	// Wrap any error returned with waiting message
 	defer func() {
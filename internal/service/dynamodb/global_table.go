@@ -231,7 +231,7 @@ func waitGlobalTableCreated(ctx context.Context, conn *dynamodb.Client, name str
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalTableDescription); ok {
 		return output, err
@@ -249,7 +249,7 @@ func waitGlobalTableUpdated(ctx context.Context, conn *dynamodb.Client, name str
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalTableDescription); ok {
 		return output, err
@@ -267,7 +267,7 @@ func waitGlobalTableDeleted(ctx context.Context, conn *dynamodb.Client, name str
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalTableDescription); ok {
 		return output, err
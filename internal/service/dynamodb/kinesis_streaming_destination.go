@@ -233,7 +233,7 @@ func waitKinesisStreamingDestinationActive(ctx context.Context, conn *dynamodb.C
 		Refresh: statusKinesisStreamingDestination(ctx, conn, streamARN, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.KinesisDataStreamDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.DestinationStatusDescription)))
@@ -254,7 +254,7 @@ func waitKinesisStreamingDestinationDisabled(ctx context.Context, conn *dynamodb
 		Refresh: statusKinesisStreamingDestination(ctx, conn, streamARN, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.KinesisDataStreamDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.DestinationStatusDescription)))
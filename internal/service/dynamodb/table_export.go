@@ -265,7 +265,7 @@ func waitTableExportCreated(ctx context.Context, conn *dynamodb.Client, id strin
 		Timeout: max(maxTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ExportDescription); ok {
 		return output, err
@@ -56,6 +56,23 @@ func resourceTableReplica() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				if !diff.NewValueKnown("global_table_arn") {
+					return nil
+				}
+
+				// The main table's Region is also used for API calls.
+				mainRegion, err := regionFromARN(diff.Get("global_table_arn").(string))
+				if err != nil {
+					return nil
+				}
+
+				if err := meta.(*conns.AWSClient).VerifyRegionAllowed(ctx, mainRegion); err != nil {
+					return fmt.Errorf("global_table_arn: %w", err)
+				}
+
+				return nil
+			},
 			verify.SetTagsDiff,
 		),
 
@@ -100,7 +117,7 @@ func resourceTableReplicaCreate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
 
-	replicaRegion := meta.(*conns.AWSClient).Region
+	replicaRegion := meta.(*conns.AWSClient).Region(ctx)
 
 	mainRegion, err := regionFromARN(d.Get("global_table_arn").(string))
 	if err != nil {
@@ -140,7 +157,7 @@ func resourceTableReplicaCreate(ctx context.Context, d *schema.ResourceData, met
 		}},
 	}
 
-	err = retry.RetryContext(ctx, max(replicaUpdateTimeout, d.Timeout(schema.TimeoutCreate)), func() *retry.RetryError {
+	err = tfresource.Retry(ctx, max(replicaUpdateTimeout, d.Timeout(schema.TimeoutCreate)), func() *retry.RetryError {
 		_, err := conn.UpdateTable(ctx, input, optFn)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, errCodeThrottlingException) {
@@ -166,7 +183,7 @@ func resourceTableReplicaCreate(ctx context.Context, d *schema.ResourceData, met
 		return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTableReplica, d.Get("global_table_arn").(string), err)
 	}
 
-	if _, err := waitReplicaActive(ctx, conn, tableName, meta.(*conns.AWSClient).Region, d.Timeout(schema.TimeoutCreate), optFn); err != nil {
+	if _, err := waitReplicaActive(ctx, conn, tableName, meta.(*conns.AWSClient).Region(ctx), d.Timeout(schema.TimeoutCreate), optFn); err != nil {
 		return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionWaitingForCreation, resNameTableReplica, d.Get("global_table_arn").(string), err)
 	}
 
@@ -192,7 +209,7 @@ func resourceTableReplicaRead(ctx context.Context, d *schema.ResourceData, meta
 	diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
 
-	replicaRegion := meta.(*conns.AWSClient).Region
+	replicaRegion := meta.(*conns.AWSClient).Region(ctx)
 
 	tableName, mainRegion, err := tableReplicaParseResourceID(d.Id())
 	if err != nil {
@@ -329,7 +346,7 @@ func resourceTableReplicaUpdate(ctx context.Context, d *schema.ResourceData, met
 		return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTableReplica, d.Id(), err)
 	}
 
-	replicaRegion := meta.(*conns.AWSClient).Region
+	replicaRegion := meta.(*conns.AWSClient).Region(ctx)
 
 	if mainRegion == replicaRegion {
 		return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTableReplica, d.Id(), errors.New("replica cannot be in same region as main table"))
@@ -365,7 +382,7 @@ func resourceTableReplicaUpdate(ctx context.Context, d *schema.ResourceData, met
 			TableName: aws.String(tableName),
 		}
 
-		err := retry.RetryContext(ctx, max(replicaUpdateTimeout, d.Timeout(schema.TimeoutUpdate)), func() *retry.RetryError {
+		err := tfresource.Retry(ctx, max(replicaUpdateTimeout, d.Timeout(schema.TimeoutUpdate)), func() *retry.RetryError {
 			_, err := conn.UpdateTable(ctx, input, optFn)
 			if err != nil {
 				if tfawserr.ErrCodeEquals(err, errCodeThrottlingException) {
@@ -430,7 +447,7 @@ func resourceTableReplicaDelete(ctx context.Context, d *schema.ResourceData, met
 		return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionDeleting, resNameTableReplica, d.Id(), err)
 	}
 
-	replicaRegion := meta.(*conns.AWSClient).Region
+	replicaRegion := meta.(*conns.AWSClient).Region(ctx)
 
 	// now main table region.
 	optFn := func(o *dynamodb.Options) {
@@ -448,7 +465,7 @@ func resourceTableReplicaDelete(ctx context.Context, d *schema.ResourceData, met
 		},
 	}
 
-	err = retry.RetryContext(ctx, updateTableTimeout, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, updateTableTimeout, func() *retry.RetryError {
 		_, err := conn.UpdateTable(ctx, input, optFn)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, errCodeThrottlingException) {
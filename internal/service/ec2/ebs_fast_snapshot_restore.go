@@ -25,6 +25,8 @@ import (
 )
 
 // @FrameworkResource(name="EBS Fast Snapshot Restore")
+// @IdentityAttribute("availability_zone")
+// @IdentityAttribute("snapshot_id")
 func newEBSFastSnapshotRestoreResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &ebsFastSnapshotRestoreResource{}
 
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_instance", name="Instance")
+func newInstanceListResource() inttypes.ListResourceForSDK {
+	return &instanceListResource{}
+}
+
+type instanceListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+}
+
+type instanceListResourceModel struct {
+	Filters    types.List   `tfsdk:"filter"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
+
+func (l *instanceListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_instance"
+}
+
+func (l *instanceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list instances whose Name tag begins with this prefix.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only list instances with all of these tags.",
+			},
+		},
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customFiltersListBlock(),
+		},
+	}
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data instanceListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	input := instanceListInput(ctx, data)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := ec2.NewDescribeInstancesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(framework.NewListResultErrorDiagnostic("listing EC2 Instances", err))
+				return
+			}
+
+			for _, reservation := range page.Reservations {
+				for _, instance := range reservation.Instances {
+					d := l.ResourceData()
+					d.SetId(aws.ToString(instance.InstanceId))
+
+					result := request.NewListResult(ctx)
+					result.DisplayName = instanceDisplayName(ctx, instance)
+
+					if !l.SetResult(ctx, awsClient, request.IncludeResource, &result, d) {
+						continue
+					}
+
+					if result.Diagnostics.HasError() {
+						yield(result)
+						return
+					}
+
+					if !yield(result) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// instanceListInput returns the DescribeInstances input for the list resource's name prefix, tag and custom filters.
+// Terminated instances are not listed.
+func instanceListInput(ctx context.Context, data instanceListResourceModel) *ec2.DescribeInstancesInput {
+	input := &ec2.DescribeInstancesInput{
+		Filters: []awstypes.Filter{
+			newFilterV2("instance-state-name", enum.Slice(
+				awstypes.InstanceStateNamePending,
+				awstypes.InstanceStateNameRunning,
+				awstypes.InstanceStateNameShuttingDown,
+				awstypes.InstanceStateNameStopping,
+				awstypes.InstanceStateNameStopped,
+			)),
+		},
+	}
+	if v := data.NamePrefix.ValueString(); v != "" {
+		input.Filters = append(input.Filters, newFilterV2("tag:Name", []string{v + "*"}))
+	}
+	input.Filters = append(input.Filters, newTagFilterListV2(TagsV2(tftags.New(ctx, data.Tags).IgnoreAWS()))...)
+	input.Filters = append(input.Filters, newCustomFilterListFrameworkListV2(ctx, data.Filters)...)
+
+	return input
+}
+
+// instanceDisplayName returns the value of an instance's Name tag, or its ID if it has no Name tag.
+func instanceDisplayName(ctx context.Context, instance awstypes.Instance) string {
+	if v := keyValueTagsV2(ctx, instance.Tags).KeyValue("Name"); v != nil {
+		return aws.ToString(v)
+	}
+
+	return aws.ToString(instance.InstanceId)
+}
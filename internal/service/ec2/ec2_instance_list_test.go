@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestInstanceListInput(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	instanceStates := []string{"pending", "running", "shutting-down", "stopping", "stopped"}
+
+	testCases := map[string]struct {
+		data     instanceListResourceModel
+		expected map[string][]string
+	}{
+		"no filters": {
+			data: instanceListResourceModel{
+				Filters:    types.ListNull(testCustomFilterObjectType()),
+				NamePrefix: types.StringNull(),
+				Tags:       types.MapNull(types.StringType),
+			},
+			expected: map[string][]string{
+				"instance-state-name": instanceStates,
+			},
+		},
+		"name prefix": {
+			data: instanceListResourceModel{
+				Filters:    types.ListNull(testCustomFilterObjectType()),
+				NamePrefix: types.StringValue("tf-acc-"),
+				Tags:       types.MapNull(types.StringType),
+			},
+			expected: map[string][]string{
+				"instance-state-name": instanceStates,
+				"tag:Name":            {"tf-acc-*"},
+			},
+		},
+		"tags": {
+			data: instanceListResourceModel{
+				Filters:    types.ListNull(testCustomFilterObjectType()),
+				NamePrefix: types.StringNull(),
+				Tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"Environment":                   types.StringValue("test"),
+					"Owner":                         types.StringValue("team-a"),
+					"aws:cloudformation:stack-name": types.StringValue("stack"),
+				}),
+			},
+			expected: map[string][]string{
+				"instance-state-name": instanceStates,
+				"tag:Environment":     {"test"},
+				"tag:Owner":           {"team-a"},
+			},
+		},
+		"custom filter": {
+			data: instanceListResourceModel{
+				Filters:    testCustomFilterList("instance-type", "t3.micro"),
+				NamePrefix: types.StringValue("tf-acc-"),
+				Tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"Environment": types.StringValue("test"),
+				}),
+			},
+			expected: map[string][]string{
+				"instance-state-name": instanceStates,
+				"instance-type":       {"t3.micro"},
+				"tag:Environment":     {"test"},
+				"tag:Name":            {"tf-acc-*"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testFiltersMap(instanceListInput(ctx, testCase.data).Filters)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func testCustomFilterObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			names.AttrName:   types.StringType,
+			names.AttrValues: types.ListType{ElemType: types.StringType},
+		},
+	}
+}
+
+func testCustomFilterList(name string, values ...string) types.List {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+
+	return types.ListValueMust(testCustomFilterObjectType(), []attr.Value{
+		types.ObjectValueMust(testCustomFilterObjectType().AttrTypes, map[string]attr.Value{
+			names.AttrName:   types.StringValue(name),
+			names.AttrValues: types.ListValueMust(types.StringType, elems),
+		}),
+	})
+}
+
+// testFiltersMap returns EC2 filters keyed by name, as the order of tag filters is not deterministic.
+func testFiltersMap(filters []awstypes.Filter) map[string][]string {
+	m := make(map[string][]string, len(filters))
+	for _, filter := range filters {
+		m[aws.ToString(filter.Name)] = filter.Values
+	}
+
+	return m
+}
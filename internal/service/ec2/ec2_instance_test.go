@@ -99,7 +99,7 @@ func TestFetchRootDevice(t *testing.T) {
 
 	for _, tc := range cases {
 		tc := tc
-		t.Run(fmt.Sprintf(tc.label), func(t *testing.T) {
+		t.Run(tc.label, func(t *testing.T) {
 			t.Parallel()
 
 			conn := ec2.New(sess)
@@ -5804,7 +5804,7 @@ func testAccPreCheckHasDefaultVPCDefaultSubnets(ctx context.Context, t *testing.
 	client := acctest.Provider.Meta().(*conns.AWSClient)
 
 	if !(hasDefaultVPC(ctx, t) && defaultSubnetCount(ctx, t) > 0) {
-		t.Skipf("skipping tests; %s does not have a default VPC with default subnets", client.Region)
+		t.Skipf("skipping tests; %s does not have a default VPC with default subnets", client.Region(ctx))
 	}
 }
 
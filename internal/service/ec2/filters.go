@@ -12,6 +12,7 @@ import (
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	ec2_sdkv1 "github.com/aws/aws-sdk-go/service/ec2"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -171,12 +172,36 @@ func customFiltersBlock() datasourceschema.Block {
 	}
 }
 
+// customFiltersListBlock is the Plugin Framework list resource variant of customFiltersSchema.
+// List resource schemas have no set blocks or attributes, so lists are used instead.
+func customFiltersListBlock() listschema.Block {
+	return listschema.ListNestedBlock{
+		NestedObject: listschema.NestedBlockObject{
+			Attributes: map[string]listschema.Attribute{
+				names.AttrName: listschema.StringAttribute{
+					Required: true,
+				},
+				names.AttrValues: listschema.ListAttribute{
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
 // customFilterModel represents a single configured filter.
 type customFilterModel struct {
 	Name   types.String `tfsdk:"name"`
 	Values types.Set    `tfsdk:"values"`
 }
 
+// customFilterListModel represents a single filter configured on a list resource.
+type customFilterListModel struct {
+	Name   types.String `tfsdk:"name"`
+	Values types.List   `tfsdk:"values"`
+}
+
 // newCustomFilterList takes the set value extracted from a schema
 // attribute conforming to the schema returned by CustomFiltersSchema,
 // and transforms it into a []*ec2.Filter representing the same filter
@@ -275,6 +300,36 @@ func newCustomFilterListFrameworkV2(ctx context.Context, filterSet types.Set) []
 	return filters
 }
 
+// newCustomFilterListFrameworkListV2 is the list resource variant of newCustomFilterListFrameworkV2.
+func newCustomFilterListFrameworkListV2(ctx context.Context, filterList types.List) []awstypes.Filter {
+	if filterList.IsNull() || filterList.IsUnknown() {
+		return nil
+	}
+
+	var filters []awstypes.Filter
+
+	for _, v := range filterList.Elements() {
+		var data customFilterListModel
+
+		if tfsdk.ValueAs(ctx, v, &data).HasError() {
+			continue
+		}
+
+		if data.Name.IsNull() || data.Name.IsUnknown() {
+			continue
+		}
+
+		if v := fwflex.ExpandFrameworkStringValueList(ctx, data.Values); v != nil {
+			filters = append(filters, awstypes.Filter{
+				Name:   fwflex.StringFromFramework(ctx, data.Name),
+				Values: v,
+			})
+		}
+	}
+
+	return filters
+}
+
 // newAttributeFilterList takes a flat map of scalar attributes (most
 // likely values extracted from a *schema.ResourceData on an EC2-querying
 // data source) and produces a []*ec2.Filter representing an exact match
@@ -92,7 +92,7 @@ func resourceLocalGatewayRouteRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	var localGatewayRoute *ec2.LocalGatewayRoute
-	err = retry.RetryContext(ctx, localGatewayRouteEventualConsistencyTimeout, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, localGatewayRouteEventualConsistencyTimeout, func() *retry.RetryError {
 		var err error
 		localGatewayRoute, err = GetLocalGatewayRoute(ctx, conn, localGatewayRouteTableID, destination)
 
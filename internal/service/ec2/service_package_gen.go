@@ -35,6 +35,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newEBSFastSnapshotRestoreResource,
 			Name:    "EBS Fast Snapshot Restore",
+			Identity: types.RegionalParameterizedIdentity([]types.IdentityAttribute{
+				types.StringIdentityAttribute(names.AttrAvailabilityZone, true),
+				types.StringIdentityAttribute("snapshot_id", true),
+			}),
 		},
 		{
 			Factory: newEIPDomainNameResource,
@@ -57,6 +61,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Identity: types.RegionalSingleParameterIdentity(names.AttrID),
 		},
 		{
 			Factory: newSecurityGroupIngressRuleResource,
@@ -64,6 +69,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Identity: types.RegionalSingleParameterIdentity(names.AttrID),
 		},
 	}
 }
@@ -414,6 +420,21 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 	}
 }
 
+func (p *servicePackage) SDKListResources(ctx context.Context) []*types.ServicePackageSDKListResource {
+	return []*types.ServicePackageSDKListResource{
+		{
+			Factory:  newInstanceListResource,
+			TypeName: "aws_instance",
+			Name:     "Instance",
+		},
+		{
+			Factory:  newSecurityGroupListResource,
+			TypeName: "aws_security_group",
+			Name:     "Security Group",
+		},
+	}
+}
+
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
@@ -838,6 +859,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Identity: types.RegionalSingleParameterIdentity(names.AttrID),
 		},
 		{
 			Factory:  ResourceInternetGateway,
@@ -944,6 +966,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Identity: types.RegionalSingleParameterIdentity(names.AttrID),
 		},
 		{
 			Factory:  ResourceSecurityGroupRule,
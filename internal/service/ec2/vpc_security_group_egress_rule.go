@@ -14,6 +14,7 @@ import (
 
 // @FrameworkResource(name="Security Group Egress Rule")
 // @Tags(identifierAttribute="id")
+// @IdentityAttribute("id")
 func newSecurityGroupEgressRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &securityGroupEgressRuleResource{}
 	r.securityGroupRule = r
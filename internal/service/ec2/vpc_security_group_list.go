@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_security_group", name="Security Group")
+func newSecurityGroupListResource() inttypes.ListResourceForSDK {
+	return &securityGroupListResource{}
+}
+
+type securityGroupListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+}
+
+type securityGroupListResourceModel struct {
+	Filters    types.List   `tfsdk:"filter"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       types.Map    `tfsdk:"tags"`
+	VPCID      types.String `tfsdk:"vpc_id"`
+}
+
+func (l *securityGroupListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_security_group"
+}
+
+func (l *securityGroupListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list security groups whose name begins with this prefix.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only list security groups with all of these tags.",
+			},
+			names.AttrVPCID: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list security groups in this VPC.",
+			},
+		},
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customFiltersListBlock(),
+		},
+	}
+}
+
+func (l *securityGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data securityGroupListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	input := securityGroupListInput(ctx, data)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := ec2.NewDescribeSecurityGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(framework.NewListResultErrorDiagnostic("listing EC2 Security Groups", err))
+				return
+			}
+
+			for _, sg := range page.SecurityGroups {
+				d := l.ResourceData()
+				d.SetId(aws.ToString(sg.GroupId))
+
+				result := request.NewListResult(ctx)
+				result.DisplayName = aws.ToString(sg.GroupName)
+
+				if !l.SetResult(ctx, awsClient, request.IncludeResource, &result, d) {
+					continue
+				}
+
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// securityGroupListInput returns the DescribeSecurityGroups input for the list resource's VPC ID, name prefix, tag and custom filters.
+func securityGroupListInput(ctx context.Context, data securityGroupListResourceModel) *ec2.DescribeSecurityGroupsInput {
+	input := &ec2.DescribeSecurityGroupsInput{
+		Filters: newAttributeFilterListV2(map[string]string{
+			"vpc-id": data.VPCID.ValueString(),
+		}),
+	}
+	if v := data.NamePrefix.ValueString(); v != "" {
+		input.Filters = append(input.Filters, newFilterV2("group-name", []string{v + "*"}))
+	}
+	input.Filters = append(input.Filters, newTagFilterListV2(TagsV2(tftags.New(ctx, data.Tags).IgnoreAWS()))...)
+	input.Filters = append(input.Filters, newCustomFilterListFrameworkListV2(ctx, data.Filters)...)
+
+	return input
+}
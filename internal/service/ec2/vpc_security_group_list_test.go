@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSecurityGroupListInput(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		data     securityGroupListResourceModel
+		expected map[string][]string
+	}{
+		"no filters": {
+			data: securityGroupListResourceModel{
+				Filters:    types.ListNull(testCustomFilterObjectType()),
+				NamePrefix: types.StringNull(),
+				Tags:       types.MapNull(types.StringType),
+				VPCID:      types.StringNull(),
+			},
+			expected: map[string][]string{},
+		},
+		"vpc id": {
+			data: securityGroupListResourceModel{
+				Filters:    types.ListNull(testCustomFilterObjectType()),
+				NamePrefix: types.StringNull(),
+				Tags:       types.MapNull(types.StringType),
+				VPCID:      types.StringValue("vpc-12345678"),
+			},
+			expected: map[string][]string{
+				"vpc-id": {"vpc-12345678"},
+			},
+		},
+		"name prefix": {
+			data: securityGroupListResourceModel{
+				Filters:    types.ListNull(testCustomFilterObjectType()),
+				NamePrefix: types.StringValue("tf-acc-"),
+				Tags:       types.MapNull(types.StringType),
+				VPCID:      types.StringNull(),
+			},
+			expected: map[string][]string{
+				"group-name": {"tf-acc-*"},
+			},
+		},
+		"tags": {
+			data: securityGroupListResourceModel{
+				Filters:    types.ListNull(testCustomFilterObjectType()),
+				NamePrefix: types.StringNull(),
+				Tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"Environment":                   types.StringValue("test"),
+					"Owner":                         types.StringValue("team-a"),
+					"aws:cloudformation:stack-name": types.StringValue("stack"),
+				}),
+				VPCID: types.StringNull(),
+			},
+			expected: map[string][]string{
+				"tag:Environment": {"test"},
+				"tag:Owner":       {"team-a"},
+			},
+		},
+		"all filters": {
+			data: securityGroupListResourceModel{
+				Filters:    testCustomFilterList("ip-permission.from-port", "22"),
+				NamePrefix: types.StringValue("tf-acc-"),
+				Tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"Environment": types.StringValue("test"),
+				}),
+				VPCID: types.StringValue("vpc-12345678"),
+			},
+			expected: map[string][]string{
+				"group-name":              {"tf-acc-*"},
+				"ip-permission.from-port": {"22"},
+				"tag:Environment":         {"test"},
+				"vpc-id":                  {"vpc-12345678"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testFiltersMap(securityGroupListInput(ctx, testCase.data).Filters)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
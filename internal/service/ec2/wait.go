@@ -55,7 +55,7 @@ func WaitAvailabilityZoneGroupOptedIn(ctx context.Context, conn *ec2.EC2, name s
 		Timeout: AvailabilityZoneGroupOptInStatusTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.AvailabilityZone); ok {
 		return output, err
@@ -72,7 +72,7 @@ func WaitAvailabilityZoneGroupNotOptedIn(ctx context.Context, conn *ec2.EC2, nam
 		Timeout: AvailabilityZoneGroupOptInStatusTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.AvailabilityZone); ok {
 		return output, err
@@ -94,7 +94,7 @@ func WaitCapacityReservationActive(ctx context.Context, conn *ec2.EC2, id string
 		Timeout: CapacityReservationActiveTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CapacityReservation); ok {
 		return output, err
@@ -111,7 +111,7 @@ func WaitCapacityReservationDeleted(ctx context.Context, conn *ec2.EC2, id strin
 		Timeout: CapacityReservationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CapacityReservation); ok {
 		return output, err
@@ -134,7 +134,7 @@ func WaitCarrierGatewayCreated(ctx context.Context, conn *ec2.EC2, id string) (*
 		Timeout: CarrierGatewayAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CarrierGateway); ok {
 		return output, err
@@ -151,7 +151,7 @@ func WaitCarrierGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string) (*
 		Timeout: CarrierGatewayDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CarrierGateway); ok {
 		return output, err
@@ -177,7 +177,7 @@ func WaitLocalGatewayRouteTableVPCAssociationAssociated(ctx context.Context, con
 		Timeout: LocalGatewayRouteTableVPCAssociationAssociatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTableVpcAssociation); ok {
 		return output, err
@@ -195,7 +195,7 @@ func WaitLocalGatewayRouteTableVPCAssociationDisassociated(ctx context.Context,
 		Timeout: LocalGatewayRouteTableVPCAssociationAssociatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTableVpcAssociation); ok {
 		return output, err
@@ -217,7 +217,7 @@ func WaitClientVPNEndpointDeleted(ctx context.Context, conn *ec2.EC2, id string)
 		Timeout: ClientVPNEndpointDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ClientVpnEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -236,7 +236,7 @@ func WaitClientVPNEndpointClientConnectResponseOptionsUpdated(ctx context.Contex
 		Timeout: ClientVPNEndpointAttributeUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ClientConnectResponseOptions); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -260,7 +260,7 @@ func WaitClientVPNAuthorizationRuleCreated(ctx context.Context, conn *ec2.EC2, e
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.AuthorizationRule); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -279,7 +279,7 @@ func WaitClientVPNAuthorizationRuleDeleted(ctx context.Context, conn *ec2.EC2, e
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.AuthorizationRule); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -308,7 +308,7 @@ func WaitClientVPNNetworkAssociationCreated(ctx context.Context, conn *ec2.EC2,
 		PollInterval: ClientVPNNetworkAssociationStatusPollInterval,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TargetNetwork); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -329,7 +329,7 @@ func WaitClientVPNNetworkAssociationDeleted(ctx context.Context, conn *ec2.EC2,
 		PollInterval: ClientVPNNetworkAssociationStatusPollInterval,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TargetNetwork); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -348,7 +348,7 @@ func WaitClientVPNRouteCreated(ctx context.Context, conn *ec2.EC2, endpointID, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ClientVpnRoute); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -367,7 +367,7 @@ func WaitClientVPNRouteDeleted(ctx context.Context, conn *ec2.EC2, endpointID, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ClientVpnRoute); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -388,7 +388,7 @@ func WaitFleet(ctx context.Context, conn *ec2.EC2, id string, pending, target []
 		MinTimeout: 1 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.FleetData); ok {
 		return output, err
@@ -407,7 +407,7 @@ func WaitImageAvailable(ctx context.Context, conn *ec2.EC2, id string, timeout t
 		MinTimeout: amiRetryMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Image); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -430,7 +430,7 @@ func WaitImageDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout tim
 		MinTimeout: amiRetryMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Image); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -452,7 +452,7 @@ func WaitInstanceIAMInstanceProfileUpdated(ctx context.Context, conn *ec2.EC2, i
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		return output, err
@@ -470,7 +470,7 @@ func WaitInstanceCapacityReservationSpecificationUpdated(ctx context.Context, co
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		return output, err
@@ -488,7 +488,7 @@ func WaitInstanceMaintenanceOptionsAutoRecoveryUpdated(ctx context.Context, conn
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.InstanceMaintenanceOptions); ok {
 		return output, err
@@ -507,7 +507,7 @@ func WaitInstanceMetadataOptionsApplied(ctx context.Context, conn *ec2.EC2, id s
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.InstanceMetadataOptionsResponse); ok {
 		return output, err
@@ -525,7 +525,7 @@ func WaitInstanceRootBlockDeviceDeleteOnTerminationUpdated(ctx context.Context,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.EbsInstanceBlockDevice); ok {
 		return output, err
@@ -545,7 +545,7 @@ func WaitRouteDeleted(ctx context.Context, conn *ec2.EC2, routeFinder RouteFinde
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Route); ok {
 		return output, err
@@ -564,7 +564,7 @@ func WaitRouteReady(ctx context.Context, conn *ec2.EC2, routeFinder RouteFinder,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Route); ok {
 		return output, err
@@ -589,7 +589,7 @@ func WaitRouteTableReady(ctx context.Context, conn *ec2.EC2, id string, timeout
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTable); ok {
 		return output, err
@@ -607,7 +607,7 @@ func WaitRouteTableDeleted(ctx context.Context, conn *ec2.EC2, id string, timeou
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTable); ok {
 		return output, err
@@ -625,7 +625,7 @@ func WaitRouteTableAssociationCreated(ctx context.Context, conn *ec2.EC2, id str
 		NotFoundChecks: RouteTableAssociationCreatedNotFoundChecks,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTableAssociationState); ok {
 		if state := aws.StringValue(output.State); state == ec2.RouteTableAssociationStateCodeFailed {
@@ -646,7 +646,7 @@ func WaitRouteTableAssociationDeleted(ctx context.Context, conn *ec2.EC2, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTableAssociationState); ok {
 		if state := aws.StringValue(output.State); state == ec2.RouteTableAssociationStateCodeFailed {
@@ -667,7 +667,7 @@ func WaitRouteTableAssociationUpdated(ctx context.Context, conn *ec2.EC2, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTableAssociationState); ok {
 		if state := aws.StringValue(output.State); state == ec2.RouteTableAssociationStateCodeFailed {
@@ -690,7 +690,7 @@ func WaitSecurityGroupCreated(ctx context.Context, conn *ec2.EC2, id string, tim
 		ContinuousTargetOccurence: 3,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SecurityGroup); ok {
 		return output, err
@@ -712,7 +712,7 @@ func WaitSubnetAvailable(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -729,7 +729,7 @@ func WaitSubnetIPv6CIDRBlockAssociationCreated(ctx context.Context, conn *ec2.EC
 		Timeout: SubnetIPv6CIDRBlockAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SubnetCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.SubnetCidrBlockStateCodeFailed {
@@ -750,7 +750,7 @@ func WaitSubnetIPv6CIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.EC
 		Timeout: SubnetIPv6CIDRBlockAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SubnetCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.SubnetCidrBlockStateCodeFailed {
@@ -772,7 +772,7 @@ func waitSubnetAssignIPv6AddressOnCreationUpdated(ctx context.Context, conn *ec2
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -790,7 +790,7 @@ func waitSubnetEnableLniAtDeviceIndexUpdated(ctx context.Context, conn *ec2.EC2,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -808,7 +808,7 @@ func waitSubnetEnableDNS64Updated(ctx context.Context, conn *ec2.EC2, subnetID s
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -826,7 +826,7 @@ func waitSubnetEnableResourceNameDNSAAAARecordOnLaunchUpdated(ctx context.Contex
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -844,7 +844,7 @@ func waitSubnetEnableResourceNameDNSARecordOnLaunchUpdated(ctx context.Context,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -862,7 +862,7 @@ func WaitSubnetMapCustomerOwnedIPOnLaunchUpdated(ctx context.Context, conn *ec2.
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -880,7 +880,7 @@ func WaitSubnetMapPublicIPOnLaunchUpdated(ctx context.Context, conn *ec2.EC2, su
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -898,7 +898,7 @@ func WaitSubnetPrivateDNSHostnameTypeOnLaunchUpdated(ctx context.Context, conn *
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -919,7 +919,7 @@ func WaitTransitGatewayCreated(ctx context.Context, conn *ec2.EC2, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGateway); ok {
 		return output, err
@@ -937,7 +937,7 @@ func WaitTransitGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string, ti
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGateway); ok {
 		return output, err
@@ -954,7 +954,7 @@ func WaitTransitGatewayUpdated(ctx context.Context, conn *ec2.EC2, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGateway); ok {
 		return output, err
@@ -971,7 +971,7 @@ func WaitTransitGatewayConnectCreated(ctx context.Context, conn *ec2.EC2, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
 		return output, err
@@ -989,7 +989,7 @@ func WaitTransitGatewayConnectDeleted(ctx context.Context, conn *ec2.EC2, id str
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
 		return output, err
@@ -1006,7 +1006,7 @@ func WaitTransitGatewayConnectPeerCreated(ctx context.Context, conn *ec2.EC2, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayConnectPeer); ok {
 		return output, err
@@ -1023,7 +1023,7 @@ func WaitTransitGatewayConnectPeerDeleted(ctx context.Context, conn *ec2.EC2, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayConnectPeer); ok {
 		return output, err
@@ -1040,7 +1040,7 @@ func WaitTransitGatewayMulticastDomainCreated(ctx context.Context, conn *ec2.EC2
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomain); ok {
 		return output, err
@@ -1057,7 +1057,7 @@ func WaitTransitGatewayMulticastDomainDeleted(ctx context.Context, conn *ec2.EC2
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomain); ok {
 		return output, err
@@ -1074,7 +1074,7 @@ func WaitTransitGatewayMulticastDomainAssociationCreated(ctx context.Context, co
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomainAssociation); ok {
 		return output, err
@@ -1091,7 +1091,7 @@ func WaitTransitGatewayMulticastDomainAssociationDeleted(ctx context.Context, co
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomainAssociation); ok {
 		return output, err
@@ -1114,7 +1114,7 @@ func WaitTransitGatewayPeeringAttachmentAccepted(ctx context.Context, conn *ec2.
 		Refresh: StatusTransitGatewayPeeringAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPeeringAttachment); ok {
 		if status := output.Status; status != nil {
@@ -1135,7 +1135,7 @@ func WaitTransitGatewayPeeringAttachmentCreated(ctx context.Context, conn *ec2.E
 		Refresh: StatusTransitGatewayPeeringAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPeeringAttachment); ok {
 		if status := output.Status; status != nil {
@@ -1161,7 +1161,7 @@ func WaitTransitGatewayPeeringAttachmentDeleted(ctx context.Context, conn *ec2.E
 		Refresh: StatusTransitGatewayPeeringAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPeeringAttachment); ok {
 		if status := output.Status; status != nil {
@@ -1186,7 +1186,7 @@ func WaitTransitGatewayPrefixListReferenceStateCreated(ctx context.Context, conn
 		Refresh: StatusTransitGatewayPrefixListReferenceState(ctx, conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPrefixListReference); ok {
 		return output, err
@@ -1203,7 +1203,7 @@ func WaitTransitGatewayPrefixListReferenceStateDeleted(ctx context.Context, conn
 		Refresh: StatusTransitGatewayPrefixListReferenceState(ctx, conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPrefixListReference); ok {
 		return output, err
@@ -1220,7 +1220,7 @@ func WaitTransitGatewayPrefixListReferenceStateUpdated(ctx context.Context, conn
 		Refresh: StatusTransitGatewayPrefixListReferenceState(ctx, conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPrefixListReference); ok {
 		return output, err
@@ -1242,7 +1242,7 @@ func WaitTransitGatewayRouteCreated(ctx context.Context, conn *ec2.EC2, transitG
 		Refresh: StatusTransitGatewayStaticRouteState(ctx, conn, transitGatewayRouteTableID, destination),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRoute); ok {
 		return output, err
@@ -1259,7 +1259,7 @@ func WaitTransitGatewayRouteDeleted(ctx context.Context, conn *ec2.EC2, transitG
 		Refresh: StatusTransitGatewayStaticRouteState(ctx, conn, transitGatewayRouteTableID, destination),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRoute); ok {
 		return output, err
@@ -1283,7 +1283,7 @@ func WaitTransitGatewayPolicyTableCreated(ctx context.Context, conn *ec2.EC2, id
 		Refresh: StatusTransitGatewayPolicyTableState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTable); ok {
 		return output, err
@@ -1300,7 +1300,7 @@ func WaitTransitGatewayRouteTableCreated(ctx context.Context, conn *ec2.EC2, id
 		Refresh: StatusTransitGatewayRouteTableState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTable); ok {
 		return output, err
@@ -1317,7 +1317,7 @@ func WaitTransitGatewayPolicyTableDeleted(ctx context.Context, conn *ec2.EC2, id
 		Refresh: StatusTransitGatewayPolicyTableState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTable); ok {
 		return output, err
@@ -1334,7 +1334,7 @@ func WaitTransitGatewayRouteTableDeleted(ctx context.Context, conn *ec2.EC2, id
 		Refresh: StatusTransitGatewayRouteTableState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTable); ok {
 		return output, err
@@ -1358,7 +1358,7 @@ func WaitTransitGatewayPolicyTableAssociationCreated(ctx context.Context, conn *
 		Refresh: StatusTransitGatewayPolicyTableAssociationState(ctx, conn, transitGatewayPolicyTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTableAssociation); ok {
 		return output, err
@@ -1376,7 +1376,7 @@ func WaitTransitGatewayPolicyTableAssociationDeleted(ctx context.Context, conn *
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTableAssociation); ok {
 		return output, err
@@ -1393,7 +1393,7 @@ func WaitTransitGatewayRouteTableAssociationCreated(ctx context.Context, conn *e
 		Refresh: StatusTransitGatewayRouteTableAssociationState(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTableAssociation); ok {
 		return output, err
@@ -1411,7 +1411,7 @@ func WaitTransitGatewayRouteTableAssociationDeleted(ctx context.Context, conn *e
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTableAssociation); ok {
 		return output, err
@@ -1433,7 +1433,7 @@ func WaitTransitGatewayRouteTablePropagationCreated(ctx context.Context, conn *e
 		Refresh: StatusTransitGatewayRouteTablePropagationState(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTablePropagation); ok {
 		return output, err
@@ -1450,7 +1450,7 @@ func WaitTransitGatewayRouteTablePropagationDeleted(ctx context.Context, conn *e
 		Refresh: StatusTransitGatewayRouteTablePropagationState(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
 		return nil, nil
@@ -1477,7 +1477,7 @@ func WaitTransitGatewayVPCAttachmentAccepted(ctx context.Context, conn *ec2.EC2,
 		Refresh: StatusTransitGatewayVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -1494,7 +1494,7 @@ func WaitTransitGatewayVPCAttachmentCreated(ctx context.Context, conn *ec2.EC2,
 		Refresh: StatusTransitGatewayVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -1516,7 +1516,7 @@ func WaitTransitGatewayVPCAttachmentDeleted(ctx context.Context, conn *ec2.EC2,
 		Refresh: StatusTransitGatewayVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -1533,7 +1533,7 @@ func WaitTransitGatewayVPCAttachmentUpdated(ctx context.Context, conn *ec2.EC2,
 		Refresh: StatusTransitGatewayVPCAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -1552,7 +1552,7 @@ func WaitVolumeCreated(ctx context.Context, conn *ec2_sdkv2.Client, id string, t
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Volume); ok {
 		return output, err
@@ -1571,7 +1571,7 @@ func WaitVolumeDeleted(ctx context.Context, conn *ec2_sdkv2.Client, id string, t
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Volume); ok {
 		return output, err
@@ -1590,7 +1590,7 @@ func WaitVolumeUpdated(ctx context.Context, conn *ec2_sdkv2.Client, id string, t
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Volume); ok {
 		return output, err
@@ -1609,7 +1609,7 @@ func WaitVolumeAttachmentCreated(ctx context.Context, conn *ec2.EC2, volumeID, i
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VolumeAttachment); ok {
 		return output, err
@@ -1631,7 +1631,7 @@ func WaitVolumeModificationComplete(ctx context.Context, conn *ec2.EC2, id strin
 		MinTimeout: 30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VolumeModification); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -1657,7 +1657,7 @@ func WaitVPCCIDRBlockAssociationCreated(ctx context.Context, conn *ec2.EC2, id s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.VpcCidrBlockStateCodeFailed {
@@ -1680,7 +1680,7 @@ func WaitVPCCIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.EC2, id s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.VpcCidrBlockStateCodeFailed {
@@ -1708,7 +1708,7 @@ func WaitVPCIPv6CIDRBlockAssociationCreated(ctx context.Context, conn *ec2.EC2,
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.VpcCidrBlockStateCodeFailed {
@@ -1731,7 +1731,7 @@ func WaitVPCIPv6CIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.EC2,
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcCidrBlockState); ok {
 		if state := aws.StringValue(output.State); state == ec2.VpcCidrBlockStateCodeFailed {
@@ -1752,7 +1752,7 @@ func WaitVPCPeeringConnectionActive(ctx context.Context, conn *ec2.EC2, id strin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcPeeringConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -1775,7 +1775,7 @@ func WaitVPCPeeringConnectionDeleted(ctx context.Context, conn *ec2.EC2, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcPeeringConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -1801,7 +1801,7 @@ func WaitVPNGatewayVPCAttachmentAttached(ctx context.Context, conn *ec2.EC2, vpn
 		Timeout: VPNGatewayVPCAttachmentAttachedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcAttachment); ok {
 		return output, err
@@ -1818,7 +1818,7 @@ func WaitVPNGatewayVPCAttachmentDetached(ctx context.Context, conn *ec2.EC2, vpn
 		Timeout: VPNGatewayVPCAttachmentDetachedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcAttachment); ok {
 		return output, err
@@ -1842,7 +1842,7 @@ func WaitCustomerGatewayCreated(ctx context.Context, conn *ec2.EC2, id string) (
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CustomerGateway); ok {
 		return output, err
@@ -1859,7 +1859,7 @@ func WaitCustomerGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string) (
 		Timeout: customerGatewayDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.CustomerGateway); ok {
 		return output, err
@@ -1876,7 +1876,7 @@ func WaitNATGatewayCreated(ctx context.Context, conn *ec2.EC2, id string, timeou
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NatGateway); ok {
 		if state := aws.StringValue(output.State); state == ec2.NatGatewayStateFailed {
@@ -1899,7 +1899,7 @@ func WaitNATGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string, timeou
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NatGateway); ok {
 		if state := aws.StringValue(output.State); state == ec2.NatGatewayStateFailed {
@@ -1920,7 +1920,7 @@ func WaitNATGatewayAddressAssigned(ctx context.Context, conn *ec2.EC2, natGatewa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NatGatewayAddress); ok {
 		if status := aws.StringValue(output.Status); status == ec2.NatGatewayAddressStatusFailed {
@@ -1941,7 +1941,7 @@ func WaitNATGatewayAddressAssociated(ctx context.Context, conn *ec2.EC2, natGate
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NatGatewayAddress); ok {
 		if status := aws.StringValue(output.Status); status == ec2.NatGatewayAddressStatusFailed {
@@ -1962,7 +1962,7 @@ func WaitNATGatewayAddressDisassociated(ctx context.Context, conn *ec2.EC2, natG
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NatGatewayAddress); ok {
 		if status := aws.StringValue(output.Status); status == ec2.NatGatewayAddressStatusFailed {
@@ -1983,7 +1983,7 @@ func WaitNATGatewayAddressUnassigned(ctx context.Context, conn *ec2.EC2, natGate
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NatGatewayAddress); ok {
 		if status := aws.StringValue(output.Status); status == ec2.NatGatewayAddressStatusFailed {
@@ -2012,7 +2012,7 @@ func WaitVPNConnectionCreated(ctx context.Context, conn *ec2.EC2, id string) (*e
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnConnection); ok {
 		return output, err
@@ -2031,7 +2031,7 @@ func WaitVPNConnectionDeleted(ctx context.Context, conn *ec2.EC2, id string) (*e
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnConnection); ok {
 		return output, err
@@ -2050,7 +2050,7 @@ func WaitVPNConnectionUpdated(ctx context.Context, conn *ec2.EC2, id string) (*e
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnConnection); ok {
 		return output, err
@@ -2072,7 +2072,7 @@ func WaitVPNConnectionRouteCreated(ctx context.Context, conn *ec2.EC2, vpnConnec
 		Timeout: vpnConnectionRouteCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnStaticRoute); ok {
 		return output, err
@@ -2089,7 +2089,7 @@ func WaitVPNConnectionRouteDeleted(ctx context.Context, conn *ec2.EC2, vpnConnec
 		Timeout: vpnConnectionRouteDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnStaticRoute); ok {
 		return output, err
@@ -2113,7 +2113,7 @@ func WaitVPNGatewayCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnGateway); ok {
 		return output, err
@@ -2132,7 +2132,7 @@ func WaitVPNGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpnGateway); ok {
 		return output, err
@@ -2149,7 +2149,7 @@ func waitEIPDomainNameAttributeUpdated(ctx context.Context, conn *ec2_sdkv2.Clie
 		Refresh: statusEIPDomainNameAttribute(ctx, conn, allocationID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AddressAttribute); ok {
 		if v := output.PtrRecordUpdate; v != nil {
@@ -2170,7 +2170,7 @@ func waitEIPDomainNameAttributeDeleted(ctx context.Context, conn *ec2_sdkv2.Clie
 		Refresh: statusEIPDomainNameAttribute(ctx, conn, allocationID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AddressAttribute); ok {
 		if v := output.PtrRecordUpdate; v != nil {
@@ -2191,7 +2191,7 @@ func WaitHostCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time
 		Refresh: StatusHostState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Host); ok {
 		return output, err
@@ -2208,7 +2208,7 @@ func WaitHostUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout time
 		Refresh: StatusHostState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Host); ok {
 		return output, err
@@ -2225,7 +2225,7 @@ func WaitHostDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time
 		Refresh: StatusHostState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Host); ok {
 		return output, err
@@ -2247,7 +2247,7 @@ func WaitInternetGatewayAttached(ctx context.Context, conn *ec2.EC2, internetGat
 		Refresh:        StatusInternetGatewayAttachmentState(ctx, conn, internetGatewayID, vpcID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.InternetGatewayAttachment); ok {
 		return output, err
@@ -2264,7 +2264,7 @@ func WaitInternetGatewayDetached(ctx context.Context, conn *ec2.EC2, internetGat
 		Refresh: StatusInternetGatewayAttachmentState(ctx, conn, internetGatewayID, vpcID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.InternetGatewayAttachment); ok {
 		return output, err
@@ -2285,7 +2285,7 @@ func WaitManagedPrefixListCreated(ctx context.Context, conn *ec2.EC2, id string)
 		Refresh: StatusManagedPrefixListState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ManagedPrefixList); ok {
 		if state := aws.StringValue(output.State); state == ec2.PrefixListStateCreateFailed {
@@ -2306,7 +2306,7 @@ func WaitManagedPrefixListModified(ctx context.Context, conn *ec2.EC2, id string
 		Refresh: StatusManagedPrefixListState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ManagedPrefixList); ok {
 		if state := aws.StringValue(output.State); state == ec2.PrefixListStateModifyFailed {
@@ -2327,7 +2327,7 @@ func WaitManagedPrefixListDeleted(ctx context.Context, conn *ec2.EC2, id string)
 		Refresh: StatusManagedPrefixListState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ManagedPrefixList); ok {
 		if state := aws.StringValue(output.State); state == ec2.PrefixListStateDeleteFailed {
@@ -2350,7 +2350,7 @@ func WaitNetworkInsightsAnalysisCreated(ctx context.Context, conn *ec2.EC2, id s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInsightsAnalysis); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -2374,7 +2374,7 @@ func WaitNetworkInterfaceAttached(ctx context.Context, conn *ec2.EC2, id string,
 		Refresh: StatusNetworkInterfaceAttachmentStatus(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInterfaceAttachment); ok {
 		return output, err
@@ -2398,7 +2398,7 @@ func WaitNetworkInterfaceAvailableAfterUse(ctx context.Context, conn *ec2.EC2, i
 		NotFoundChecks:            1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInterface); ok {
 		return output, err
@@ -2415,7 +2415,7 @@ func WaitNetworkInterfaceCreated(ctx context.Context, conn *ec2.EC2, id string,
 		Refresh: StatusNetworkInterfaceStatus(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInterface); ok {
 		return output, err
@@ -2432,7 +2432,7 @@ func WaitNetworkInterfaceDetached(ctx context.Context, conn *ec2.EC2, id string,
 		Refresh: StatusNetworkInterfaceAttachmentStatus(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.NetworkInterfaceAttachment); ok {
 		return output, err
@@ -2454,7 +2454,7 @@ func WaitPlacementGroupCreated(ctx context.Context, conn *ec2.EC2, name string)
 		Refresh: StatusPlacementGroupState(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.PlacementGroup); ok {
 		return output, err
@@ -2471,7 +2471,7 @@ func WaitPlacementGroupDeleted(ctx context.Context, conn *ec2.EC2, name string)
 		Refresh: StatusPlacementGroupState(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.PlacementGroup); ok {
 		return output, err
@@ -2490,7 +2490,7 @@ func WaitSpotFleetRequestCreated(ctx context.Context, conn *ec2.EC2, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SpotFleetRequestConfig); ok {
 		return output, err
@@ -2509,7 +2509,7 @@ func WaitSpotFleetRequestFulfilled(ctx context.Context, conn *ec2.EC2, id string
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SpotFleetRequestConfig); ok {
 		if activityStatus := aws.StringValue(output.ActivityStatus); activityStatus == ec2.ActivityStatusError {
@@ -2547,7 +2547,7 @@ func WaitSpotFleetRequestUpdated(ctx context.Context, conn *ec2.EC2, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SpotFleetRequestConfig); ok {
 		return output, err
@@ -2566,7 +2566,7 @@ func WaitSpotInstanceRequestFulfilled(ctx context.Context, conn *ec2.EC2, id str
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.SpotInstanceRequest); ok {
 		if fault := output.Fault; fault != nil {
@@ -2592,7 +2592,7 @@ func WaitVPCEndpointAccepted(ctx context.Context, conn *ec2.EC2, vpcEndpointID s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpoint); ok {
 		if state, lastError := aws.StringValue(output.State), output.LastError; state == vpcEndpointStateFailed && lastError != nil {
@@ -2615,7 +2615,7 @@ func WaitVPCEndpointAvailable(ctx context.Context, conn *ec2.EC2, vpcEndpointID
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpoint); ok {
 		if state, lastError := aws.StringValue(output.State), output.LastError; state == vpcEndpointStateFailed && lastError != nil {
@@ -2638,7 +2638,7 @@ func WaitVPCEndpointDeleted(ctx context.Context, conn *ec2.EC2, vpcEndpointID st
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpoint); ok {
 		return output, err
@@ -2657,7 +2657,7 @@ func WaitVPCEndpointServiceAvailable(ctx context.Context, conn *ec2.EC2, id stri
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ServiceConfiguration); ok {
 		return output, err
@@ -2676,7 +2676,7 @@ func WaitVPCEndpointServiceDeleted(ctx context.Context, conn *ec2.EC2, id string
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.ServiceConfiguration); ok {
 		return output, err
@@ -2694,7 +2694,7 @@ func WaitVPCEndpointRouteTableAssociationDeleted(ctx context.Context, conn *ec2.
 		ContinuousTargetOccurence: 2,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -2708,7 +2708,7 @@ func WaitVPCEndpointRouteTableAssociationReady(ctx context.Context, conn *ec2.EC
 		ContinuousTargetOccurence: 2,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -2728,7 +2728,7 @@ func WaitEBSSnapshotImportComplete(ctx context.Context, conn *ec2_sdkv2.Client,
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SnapshotTaskDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -2749,7 +2749,7 @@ func waitVPCEndpointConnectionAccepted(ctx context.Context, conn *ec2.EC2, servi
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpointConnection); ok {
 		return output, err
@@ -2771,7 +2771,7 @@ func waitEBSSnapshotTierArchive(ctx context.Context, conn *ec2_sdkv2.Client, id
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SnapshotTierStatus); ok {
 		tfresource.SetLastError(err, fmt.Errorf("%s: %s", string(output.LastTieringOperationStatus), aws.StringValue(output.LastTieringOperationStatusDetail)))
@@ -2791,7 +2791,7 @@ func WaitIPAMCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Ipam); ok {
 		return output, err
@@ -2809,7 +2809,7 @@ func WaitIPAMDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Ipam); ok {
 		return output, err
@@ -2827,7 +2827,7 @@ func WaitIPAMUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout time
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Ipam); ok {
 		return output, err
@@ -2845,7 +2845,7 @@ func WaitIPAMPoolCreated(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPool); ok {
 		if state := aws.StringValue(output.State); state == ec2.IpamPoolStateCreateFailed {
@@ -2867,7 +2867,7 @@ func WaitIPAMPoolDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPool); ok {
 		if state := aws.StringValue(output.State); state == ec2.IpamPoolStateDeleteFailed {
@@ -2889,7 +2889,7 @@ func WaitIPAMPoolUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPool); ok {
 		if state := aws.StringValue(output.State); state == ec2.IpamPoolStateModifyFailed {
@@ -2912,7 +2912,7 @@ func WaitIPAMPoolCIDRIdCreated(ctx context.Context, conn *ec2.EC2, poolCidrId, p
 		NotFoundChecks: IPAMPoolCIDRNotFoundChecks,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPoolCidr); ok {
 		if state, failureReason := aws.StringValue(output.State), output.FailureReason; state == ec2.IpamPoolCidrStateFailedProvision && failureReason != nil {
@@ -2934,7 +2934,7 @@ func WaitIPAMPoolCIDRDeleted(ctx context.Context, conn *ec2.EC2, cidrBlock, pool
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPoolCidr); ok {
 		if state, failureReason := aws.StringValue(output.State), output.FailureReason; state == ec2.IpamPoolCidrStateFailedDeprovision && failureReason != nil {
@@ -2956,7 +2956,7 @@ func WaitIPAMPoolCIDRAllocationCreated(ctx context.Context, conn *ec2.EC2, alloc
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamPoolAllocation); ok {
 		return output, err
@@ -2974,7 +2974,7 @@ func WaitIPAMResourceDiscoveryAvailable(ctx context.Context, conn *ec2.EC2, id s
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.Ipam); ok {
 		return output, err
@@ -2992,7 +2992,7 @@ func WaitIPAMResourceDiscoveryAssociationAvailable(ctx context.Context, conn *ec
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamResourceDiscoveryAssociation); ok {
 		return output, err
@@ -3010,7 +3010,7 @@ func WaitIPAMResourceDiscoveryAssociationDeleted(ctx context.Context, conn *ec2.
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamResourceDiscoveryAssociation); ok {
 		return output, err
@@ -3028,7 +3028,7 @@ func WaitIPAMResourceDiscoveryDeleted(ctx context.Context, conn *ec2.EC2, id str
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamResourceDiscovery); ok {
 		return output, err
@@ -3046,7 +3046,7 @@ func WaitIPAMResourceDiscoveryUpdated(ctx context.Context, conn *ec2.EC2, id str
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamResourceDiscovery); ok {
 		return output, err
@@ -3064,7 +3064,7 @@ func WaitIPAMScopeCreated(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamScope); ok {
 		return output, err
@@ -3082,7 +3082,7 @@ func WaitIPAMScopeDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamScope); ok {
 		return output, err
@@ -3100,7 +3100,7 @@ func WaitIPAMScopeUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ec2.IpamScope); ok {
 		return output, err
@@ -3117,7 +3117,7 @@ func WaitInstanceConnectEndpointCreated(ctx context.Context, conn *ec2_sdkv2.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Ec2InstanceConnectEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.StateMessage)))
@@ -3136,7 +3136,7 @@ func WaitInstanceConnectEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Ec2InstanceConnectEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.StateMessage)))
@@ -3154,7 +3154,7 @@ func WaitImageBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Client
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -3169,7 +3169,7 @@ func WaitVerifiedAccessEndpointCreated(ctx context.Context, conn *ec2_sdkv2.Clie
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VerifiedAccessEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Status.Message)))
@@ -3190,7 +3190,7 @@ func WaitVerifiedAccessEndpointUpdated(ctx context.Context, conn *ec2_sdkv2.Clie
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VerifiedAccessEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Status.Message)))
@@ -3209,7 +3209,7 @@ func WaitVerifiedAccessEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Clie
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VerifiedAccessEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Status.Message)))
@@ -3228,7 +3228,7 @@ func waitFastSnapshotRestoreCreated(ctx context.Context, conn *ec2_sdkv2.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DescribeFastSnapshotRestoreSuccessItem); ok {
 		return output, err
@@ -3245,7 +3245,7 @@ func waitFastSnapshotRestoreDeleted(ctx context.Context, conn *ec2_sdkv2.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DescribeFastSnapshotRestoreSuccessItem); ok {
 		return output, err
@@ -25,7 +25,7 @@ func waitVPCCreatedV2(ctx context.Context, conn *ec2.Client, id string) (*types.
 		Timeout: vpcCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Vpc); ok {
 		return output, err
@@ -44,7 +44,7 @@ func waitVPCIPv6CIDRBlockAssociationCreatedV2(ctx context.Context, conn *ec2.Cli
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.VpcCidrBlockState); ok {
 		if state := output.State; state == types.VpcCidrBlockStateCodeFailed {
@@ -66,7 +66,7 @@ func waitVPCAttributeUpdatedV2(ctx context.Context, conn *ec2.Client, vpcID stri
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Vpc); ok {
 		return output, err
@@ -85,7 +85,7 @@ func waitVPCIPv6CIDRBlockAssociationDeletedV2(ctx context.Context, conn *ec2.Cli
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.VpcCidrBlockState); ok {
 		if state := output.State; state == types.VpcCidrBlockStateCodeFailed {
@@ -113,7 +113,7 @@ func waitNetworkInterfaceAvailableAfterUseV2(ctx context.Context, conn *ec2.Clie
 		NotFoundChecks:            1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.NetworkInterface); ok {
 		return output, err
@@ -130,7 +130,7 @@ func waitNetworkInterfaceCreatedV2(ctx context.Context, conn *ec2.Client, id str
 		Refresh: statusNetworkInterfaceV2(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.NetworkInterface); ok {
 		return output, err
@@ -147,7 +147,7 @@ func waitNetworkInterfaceAttachedV2(ctx context.Context, conn *ec2.Client, id st
 		Refresh: statusNetworkInterfaceAttachmentV2(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.NetworkInterfaceAttachment); ok {
 		return output, err
@@ -164,7 +164,7 @@ func waitNetworkInterfaceDetachedV2(ctx context.Context, conn *ec2.Client, id st
 		Refresh: statusNetworkInterfaceAttachmentV2(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.NetworkInterfaceAttachment); ok {
 		return output, err
@@ -26,6 +26,7 @@ import (
 
 // @SDKResource("aws_ecr_repository", name="Repository")
 // @Tags(identifierAttribute="arn")
+// @IAMActions(create="ecr:CreateRepository;ecr:DescribeRepositories;ecr:ListTagsForResource;ecr:TagResource", read="ecr:DescribeRepositories;ecr:ListTagsForResource", update="ecr:PutImageScanningConfiguration;ecr:PutImageTagMutability;ecr:TagResource;ecr:UntagResource", delete="ecr:DeleteRepository;ecr:DescribeRepositories")
 func resourceRepository() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRepositoryCreate,
@@ -90,6 +90,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			IAMActions: &types.ServicePackageResourceIAMActions{
+				Create: []string{"ecr:CreateRepository", "ecr:DescribeRepositories", "ecr:ListTagsForResource", "ecr:TagResource"},
+				Read:   []string{"ecr:DescribeRepositories", "ecr:ListTagsForResource"},
+				Update: []string{"ecr:PutImageScanningConfiguration", "ecr:PutImageTagMutability", "ecr:TagResource", "ecr:UntagResource"},
+				Delete: []string{"ecr:DeleteRepository", "ecr:DescribeRepositories"},
+			},
 		},
 		{
 			Factory:  resourceRepositoryPolicy,
@@ -206,7 +206,7 @@ func resourceCapacityProviderUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		log.Printf("[DEBUG] Updating ECS Capacity Provider: %s", input)
-		err := retry.RetryContext(ctx, capacityProviderUpdateTimeout, func() *retry.RetryError {
+		err := tfresource.Retry(ctx, capacityProviderUpdateTimeout, func() *retry.RetryError {
 			_, err := conn.UpdateCapacityProviderWithContext(ctx, input)
 
 			if tfawserr.ErrCodeEquals(err, ecs.ErrCodeUpdateInProgressException) {
@@ -265,7 +265,7 @@ func resourceCapacityProviderImport(ctx context.Context, d *schema.ResourceData,
 	d.Set(names.AttrName, d.Id())
 	d.SetId(arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Service:   "ecs",
 		Resource:  fmt.Sprintf("capacity-provider/%s", d.Id()),
@@ -93,7 +93,7 @@ func (e *expectActiveError) Error() string {
 func FindServiceByIDWaitForActive(ctx context.Context, conn *ecs.ECS, id, cluster string) (*ecs.Service, error) {
 	var service *ecs.Service
 	// Use the retry.RetryContext function instead of WaitForState() because we don't want the timeout error, if any
-	err := retry.RetryContext(ctx, serviceDescribeTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, serviceDescribeTimeout, func() *retry.RetryError {
 		var err error
 		service, err = FindServiceByID(ctx, conn, id, cluster)
 		if tfresource.NotFound(err) {
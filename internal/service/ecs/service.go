@@ -965,7 +965,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		// Retry due to IAM eventual consistency
-		err := retry.RetryContext(ctx, propagationTimeout+serviceUpdateTimeout, func() *retry.RetryError {
+		err := tfresource.Retry(ctx, propagationTimeout+serviceUpdateTimeout, func() *retry.RetryError {
 			_, err := conn.UpdateServiceWithContext(ctx, input)
 
 			if err != nil {
@@ -1038,7 +1038,7 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta int
 		Cluster: aws.String(d.Get("cluster").(string)),
 	}
 	log.Printf("[DEBUG] Deleting ECS Service: %s", d.Id())
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+	err = tfresource.Retry(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		_, err := conn.DeleteServiceWithContext(ctx, &input)
 
 		if err != nil {
@@ -1082,7 +1082,7 @@ func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta int
 	d.SetId(name)
 	clusterArn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
 		Service:   "ecs",
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("cluster/%s", cluster),
@@ -1630,7 +1630,7 @@ func resourceLoadBalancerHash(v interface{}) int {
 
 func serviceCreateWithRetry(ctx context.Context, conn *ecs.ECS, input ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error) {
 	var output *ecs.CreateServiceOutput
-	err := retry.RetryContext(ctx, propagationTimeout+serviceCreateTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, propagationTimeout+serviceCreateTimeout, func() *retry.RetryError {
 		var err error
 		output, err = conn.CreateServiceWithContext(ctx, &input)
 
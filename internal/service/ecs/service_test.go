@@ -1636,7 +1636,7 @@ func testAccCheckServiceExists(ctx context.Context, name string, service *ecs.Se
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn(ctx)
 
-		err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+		err := tfresource.Retry(ctx, 1*time.Minute, func() *retry.RetryError {
 			var err error
 			service, err = tfecs.FindServiceNoTagsByID(ctx, conn, rs.Primary.ID, rs.Primary.Attributes["cluster"])
 			if tfresource.NotFound(err) {
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -39,7 +40,7 @@ func waitCapacityProviderDeleted(ctx context.Context, conn *ecs.ECS, arn string)
 		Timeout: capacityProviderDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ecs.CapacityProvider); ok {
 		return v, err
@@ -56,7 +57,7 @@ func waitCapacityProviderUpdated(ctx context.Context, conn *ecs.ECS, arn string)
 		Timeout: capacityProviderUpdateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ecs.CapacityProvider); ok {
 		return v, err
@@ -82,7 +83,7 @@ func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ecs.Service); ok {
 		return v, err
@@ -109,7 +110,7 @@ func waitServiceInactive(ctx context.Context, conn *ecs.ECS, id, cluster string,
 		MinTimeout: serviceInactiveMinTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -123,7 +124,7 @@ func waitServiceActive(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ecs.Service); ok {
 		return v, err
@@ -140,7 +141,7 @@ func waitTaskSetStable(ctx context.Context, conn *ecs.ECS, timeout time.Duration
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -153,7 +154,7 @@ func waitTaskSetDeleted(ctx context.Context, conn *ecs.ECS, taskSetID, service,
 		Timeout: taskSetDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
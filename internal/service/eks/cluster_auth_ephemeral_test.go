@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSClusterAuthEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := acctest.EphemeralValuesDataSourceName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		TerraformVersionChecks:   acctest.EphemeralResourceTerraformVersionChecks,
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterAuthEphemeralConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.name", "foobar"),
+					resource.TestMatchResourceAttr(dataSourceName, "tags.token", regexache.MustCompile(`^k8s-aws-v1\.`)),
+					testAccCheckClusterAuthEphemeralToken(dataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClusterAuthEphemeralToken(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		name := rs.Primary.Attributes["tags.name"]
+		tok := rs.Primary.Attributes["tags.token"]
+		verifier := tfeks.NewVerifier(name)
+		identity, err := verifier.Verify(tok)
+		if err != nil {
+			return fmt.Errorf("Error verifying token for cluster %q: %v", name, err)
+		}
+		if identity.ARN == "" {
+			return fmt.Errorf("Unexpected blank ARN for token identity")
+		}
+
+		return nil
+	}
+}
+
+func testAccClusterAuthEphemeralConfig_basic() string {
+	return acctest.ConfigCompose(
+		acctest.ConfigEphemeralValues(map[string]string{
+			"name":  "ephemeral.aws_eks_cluster_auth.test.name",
+			"token": "ephemeral.aws_eks_cluster_auth.test.token",
+		}),
+		`
+ephemeral "aws_eks_cluster_auth" "test" {
+  name = "foobar"
+}
+`)
+}
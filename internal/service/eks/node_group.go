@@ -672,7 +672,7 @@ func waitNodegroupCreated(ctx context.Context, conn *eks.Client, clusterName, no
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Nodegroup); ok {
 		if status, health := output.Status, output.Health; status == types.NodegroupStatusCreateFailed && health != nil {
@@ -693,7 +693,7 @@ func waitNodegroupDeleted(ctx context.Context, conn *eks.Client, clusterName, no
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Nodegroup); ok {
 		if status, health := output.Status, output.Health; status == types.NodegroupStatusDeleteFailed && health != nil {
@@ -714,7 +714,7 @@ func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, cluste
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Update); ok {
 		if status := output.Status; status == types.UpdateStatusCancelled || status == types.UpdateStatusFailed {
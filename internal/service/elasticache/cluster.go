@@ -766,7 +766,7 @@ func DeleteCacheCluster(ctx context.Context, conn *elasticache.ElastiCache, cach
 	}
 
 	log.Printf("[DEBUG] Deleting ElastiCache Cache Cluster: %s", input)
-	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 5*time.Minute, func() *retry.RetryError {
 		_, err := conn.DeleteCacheClusterWithContext(ctx, input)
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, elasticache.ErrCodeInvalidCacheClusterStateFault, "serving as primary") {
@@ -890,7 +890,7 @@ func waitCacheClusterAvailable(ctx context.Context, conn *elasticache.ElastiCach
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.CacheCluster); ok {
 		return output, err
@@ -917,7 +917,7 @@ func waitCacheClusterDeleted(ctx context.Context, conn *elasticache.ElastiCache,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.CacheCluster); ok {
 		return output, err
@@ -710,7 +710,7 @@ func waitGlobalReplicationGroupAvailable(ctx context.Context, conn *elasticache.
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
 		return output, err
@@ -734,7 +734,7 @@ func waitGlobalReplicationGroupDeleted(ctx context.Context, conn *elasticache.El
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
 		return output, err
@@ -795,7 +795,7 @@ func waitGlobalReplicationGroupMemberDetached(ctx context.Context, conn *elastic
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.GlobalReplicationGroupMember); ok {
 		return output, err
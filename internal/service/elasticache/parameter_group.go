@@ -360,7 +360,7 @@ func resourceResetParameterGroup(ctx context.Context, conn *elasticache.ElastiCa
 		CacheParameterGroupName: aws.String(name),
 		ParameterNameValues:     parameters,
 	}
-	return retry.RetryContext(ctx, 30*time.Second, func() *retry.RetryError {
+	return tfresource.Retry(ctx, 30*time.Second, func() *retry.RetryError {
 		_, err := conn.ResetCacheParameterGroupWithContext(ctx, &input)
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, elasticache.ErrCodeInvalidCacheParameterGroupStateFault, " has pending changes") {
@@ -369,7 +369,7 @@ func waitUserCreated(ctx context.Context, conn *elasticache.ElastiCache, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.User); ok {
 		return output, err
@@ -386,7 +386,7 @@ func waitUserUpdated(ctx context.Context, conn *elasticache.ElastiCache, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.User); ok {
 		return output, err
@@ -403,7 +403,7 @@ func waitUserDeleted(ctx context.Context, conn *elasticache.ElastiCache, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.User); ok {
 		return output, err
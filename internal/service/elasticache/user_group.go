@@ -289,7 +289,7 @@ func waitUserGroupCreated(ctx context.Context, conn *elasticache.ElastiCache, id
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.UserGroup); ok {
 		return output, err
@@ -308,7 +308,7 @@ func waitUserGroupUpdated(ctx context.Context, conn *elasticache.ElastiCache, id
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.UserGroup); ok {
 		return output, err
@@ -327,7 +327,7 @@ func waitUserGroupDeleted(ctx context.Context, conn *elasticache.ElastiCache, id
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.UserGroup); ok {
 		return output, err
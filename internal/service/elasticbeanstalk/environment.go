@@ -751,7 +751,7 @@ func waitEnvironmentReady(ctx context.Context, conn *elasticbeanstalk.Client, id
 		MinTimeout:   3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EnvironmentDescription); ok {
 		return output, err
@@ -771,7 +771,7 @@ func waitEnvironmentDeleted(ctx context.Context, conn *elasticbeanstalk.Client,
 		MinTimeout:   3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EnvironmentDescription); ok {
 		return output, err
@@ -58,7 +58,7 @@ func resourceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	log.Printf("[INFO] registering instance %s with ELB %s", instance, elbName)
 
-	err := retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 10*time.Minute, func() *retry.RetryError {
 		_, err := conn.RegisterInstancesWithLoadBalancerWithContext(ctx, &registerInstancesOpts)
 
 		if tfawserr.ErrCodeEquals(err, "InvalidTarget") {
@@ -72,7 +72,7 @@ func resourceListenerCertificateCreate(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("[DEBUG] Adding certificate: %s of listener: %s", certificateArn, listenerArn)
 
-	err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 1*time.Minute, func() *retry.RetryError {
 		_, err := conn.AddListenerCertificates(ctx, params)
 
 		// Retry for IAM Server Certificate eventual consistency
@@ -111,7 +111,7 @@ func resourceListenerCertificateRead(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[DEBUG] Reading certificate: %s of listener: %s", certificateArn, listenerArn)
 
-	err = retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, 1*time.Minute, func() *retry.RetryError {
 		err := findListenerCertificate(ctx, conn, certificateArn, listenerArn, true, nil)
 		if tfresource.NotFound(err) && d.IsNewResource() {
 			return retry.RetryableError(err)
@@ -566,7 +566,7 @@ func resourceListenerRuleRead(ctx context.Context, d *schema.ResourceData, meta
 		RuleArns: []string{d.Id()},
 	}
 
-	err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 1*time.Minute, func() *retry.RetryError {
 		var err error
 		resp, err = conn.DescribeRules(ctx, req)
 		if err != nil {
@@ -764,7 +764,7 @@ func retryListenerRuleCreate(ctx context.Context, conn *elasticloadbalancingv2.C
 	} else {
 		var priority int32
 
-		err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+		err := tfresource.Retry(ctx, 5*time.Minute, func() *retry.RetryError {
 			var err error
 			priority, err = highestListenerRulePriority(ctx, conn, listenerARN)
 			if err != nil {
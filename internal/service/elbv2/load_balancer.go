@@ -950,7 +950,7 @@ func waitLoadBalancerActive(ctx context.Context, conn *elbv2.ELBV2, arn string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elbv2.LoadBalancer); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.State.Reason)))
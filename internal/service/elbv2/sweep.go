@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb", &resource.Sweeper{
 		Name: "aws_lb",
 		F:    sweepLoadBalancers,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
 		Name: "aws_lb_target_group",
 		F:    sweepTargetGroups,
 		Dependencies: []string{
@@ -34,7 +34,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_lb_listener", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb_listener", &resource.Sweeper{
 		Name: "aws_lb_listener",
 		F:    sweepListeners,
 	})
@@ -47,37 +47,40 @@ func sweepLoadBalancers(region string) error {
 		return fmt.Errorf("getting client: %s", err)
 	}
 	conn := client.ELBV2Conn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 
-	var sweeperErrs *multierror.Error
 	err = conn.DescribeLoadBalancersPagesWithContext(ctx, &elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
-		if page == nil || len(page.LoadBalancers) == 0 {
-			log.Print("[DEBUG] No LBs to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
 
 		for _, loadBalancer := range page.LoadBalancers {
-			name := aws.StringValue(loadBalancer.LoadBalancerName)
+			r := ResourceLoadBalancer()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(loadBalancer.LoadBalancerArn))
 
-			log.Printf("[INFO] Deleting LB: %s", name)
-			_, err := conn.DeleteLoadBalancerWithContext(ctx, &elbv2.DeleteLoadBalancerInput{
-				LoadBalancerArn: loadBalancer.LoadBalancerArn,
-			})
-			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("failed to delete LB (%s): %w", name, err))
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
 	if awsv1.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping LB sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return nil
 	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving LBs: %w", err))
+		return fmt.Errorf("retrieving LBs (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping LBs (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepTargetGroups(region string) error {
@@ -87,33 +90,39 @@ func sweepTargetGroups(region string) error {
 		return fmt.Errorf("getting client: %w", err)
 	}
 	conn := client.ELBV2Conn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.DescribeTargetGroupsPagesWithContext(ctx, &elbv2.DescribeTargetGroupsInput{}, func(page *elbv2.DescribeTargetGroupsOutput, lastPage bool) bool {
-		if page == nil || len(page.TargetGroups) == 0 {
-			log.Print("[DEBUG] No LB Target Groups to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
 
 		for _, targetGroup := range page.TargetGroups {
-			name := aws.StringValue(targetGroup.TargetGroupName)
+			r := ResourceTargetGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(targetGroup.TargetGroupArn))
 
-			log.Printf("[INFO] Deleting LB Target Group: %s", name)
-			_, err := conn.DeleteTargetGroupWithContext(ctx, &elbv2.DeleteTargetGroupInput{
-				TargetGroupArn: targetGroup.TargetGroupArn,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete LB Target Group (%s): %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping LB Target Group sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if awsv1.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping LB Target Group sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("retrieving LB Target Groups: %w", err)
+		return fmt.Errorf("retrieving LB Target Groups (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping LB Target Groups (%s): %w", region, err)
 	}
+
 	return nil
 }
 
@@ -376,7 +376,7 @@ func waitVirtualClusterDeleted(ctx context.Context, conn *emrcontainers.EMRConta
 		Delay:   1 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*emrcontainers.VirtualCluster); ok {
 		return v, err
@@ -250,7 +250,7 @@ func resourceKxDataviewRead(ctx context.Context, d *schema.ResourceData, meta in
 	// Ref: https://docs.aws.amazon.com/service-authorization/latest/reference/list_amazonfinspace.html#amazonfinspace-resources-for-iam-policies
 	dataviewARN := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
 		Service:   names.FinSpace,
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("kxEnvironment/%s/kxDatabase/%s/kxDataview/%s", aws.ToString(out.EnvironmentId), aws.ToString(out.DatabaseName), aws.ToString(out.DataviewName)),
@@ -355,7 +355,7 @@ func waitKxDataviewCreated(ctx context.Context, conn *finspace.Client, id string
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxDataviewOutput); ok {
 		return out, err
 	}
@@ -371,7 +371,7 @@ func waitKxDataviewUpdated(ctx context.Context, conn *finspace.Client, id string
 		NotFoundChecks:            20,
 		ContinuousTargetOccurence: 2,
 	}
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if out, ok := outputRaw.(*finspace.GetKxDataviewOutput); ok {
 		return out, err
@@ -387,7 +387,7 @@ func waitKxDataviewDeleted(ctx context.Context, conn *finspace.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxDataviewOutput); ok {
 		return out, err
 	}
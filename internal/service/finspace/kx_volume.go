@@ -338,7 +338,7 @@ func waitKxVolumeCreated(ctx context.Context, conn *finspace.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxVolumeOutput); ok {
 		return out, err
 	}
@@ -356,7 +356,7 @@ func waitKxVolumeUpdated(ctx context.Context, conn *finspace.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxVolumeOutput); ok {
 		return out, err
 	}
@@ -372,7 +372,7 @@ func waitKxVolumeDeleted(ctx context.Context, conn *finspace.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxVolumeOutput); ok {
 		return out, err
 	}
@@ -215,7 +215,7 @@ func waitAdminAccountCreated(ctx context.Context, conn *fms.Client, accountID st
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fms.GetAdminAccountOutput); ok {
 		return output, err
@@ -237,7 +237,7 @@ func waitAdminAccountDeleted(ctx context.Context, conn *fms.Client, timeout time
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fms.GetAdminAccountOutput); ok {
 		return output, err
@@ -260,7 +260,7 @@ func waitBackupAvailable(ctx context.Context, conn *fsx.FSx, id string) (*fsx.Ba
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Backup); ok {
 		return output, err
@@ -280,7 +280,7 @@ func waitBackupDeleted(ctx context.Context, conn *fsx.FSx, id string) (*fsx.Back
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Backup); ok {
 		return output, err
@@ -456,7 +456,7 @@ func waitDataRepositoryAssociationCreated(ctx context.Context, conn *fsx.FSx, id
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.DataRepositoryAssociation); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.DataRepositoryLifecycleFailed && details != nil {
@@ -478,7 +478,7 @@ func waitDataRepositoryAssociationUpdated(ctx context.Context, conn *fsx.FSx, id
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.DataRepositoryAssociation); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.DataRepositoryLifecycleFailed && details != nil {
@@ -500,7 +500,7 @@ func waitDataRepositoryAssociationDeleted(ctx context.Context, conn *fsx.FSx, id
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.DataRepositoryAssociation); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.DataRepositoryLifecycleFailed && details != nil {
@@ -647,7 +647,7 @@ func waitStorageVirtualMachineCreated(ctx context.Context, conn *fsx.FSx, id str
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.StorageVirtualMachine); ok {
 		if reason := output.LifecycleTransitionReason; reason != nil {
@@ -669,7 +669,7 @@ func waitStorageVirtualMachineUpdated(ctx context.Context, conn *fsx.FSx, id str
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.StorageVirtualMachine); ok {
 		if reason := output.LifecycleTransitionReason; reason != nil {
@@ -691,7 +691,7 @@ func waitStorageVirtualMachineDeleted(ctx context.Context, conn *fsx.FSx, id str
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.StorageVirtualMachine); ok {
 		if reason := output.LifecycleTransitionReason; reason != nil {
@@ -989,7 +989,7 @@ func waitVolumeCreated(ctx context.Context, conn *fsx.FSx, id string, timeout ti
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Volume); ok {
 		if status, reason := aws.StringValue(output.Lifecycle), output.LifecycleTransitionReason; status == fsx.VolumeLifecycleFailed && reason != nil {
@@ -1011,7 +1011,7 @@ func waitVolumeUpdated(ctx context.Context, conn *fsx.FSx, id string, startTime
 		Delay:   150 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Volume); ok {
 		switch status := aws.StringValue(output.Lifecycle); status {
@@ -1051,7 +1051,7 @@ func waitVolumeDeleted(ctx context.Context, conn *fsx.FSx, id string, timeout ti
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Volume); ok {
 		if status, reason := aws.StringValue(output.Lifecycle), output.LifecycleTransitionReason; status == fsx.VolumeLifecycleFailed && reason != nil {
@@ -1110,7 +1110,7 @@ func waitVolumeAdministrativeActionCompleted(ctx context.Context, conn *fsx.FSx,
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.AdministrativeAction); ok {
 		if status, details := aws.StringValue(output.Status), output.FailureDetails; status == fsx.StatusFailed && details != nil {
@@ -256,7 +256,7 @@ func waitSnapshotCreated(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Snapshot); ok {
 		if output.LifecycleTransitionReason != nil {
@@ -278,7 +278,7 @@ func waitSnapshotUpdated(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   150 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Snapshot); ok {
 		if output.LifecycleTransitionReason != nil {
@@ -300,7 +300,7 @@ func waitSnapshotDeleted(ctx context.Context, conn *fsx.FSx, id string, timeout
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.Snapshot); ok {
 		if output.LifecycleTransitionReason != nil {
@@ -111,7 +111,7 @@ func resourceBuildCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	log.Printf("[INFO] Creating GameLift Build: %s", input)
 	var out *gamelift.CreateBuildOutput
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		var err error
 		out, err = conn.CreateBuildWithContext(ctx, &input)
 		if err != nil {
@@ -294,7 +294,7 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	log.Printf("[INFO] Creating GameLift Fleet: %s", input)
 	var out *gamelift.CreateFleetOutput
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		var err error
 		out, err = conn.CreateFleetWithContext(ctx, input)
 
@@ -439,7 +439,7 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	input := &gamelift.DeleteFleetInput{
 		FleetId: aws.String(d.Id()),
 	}
-	err := retry.RetryContext(ctx, 60*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 60*time.Minute, func() *retry.RetryError {
 		_, err := conn.DeleteFleetWithContext(ctx, input)
 		if err != nil {
 			msg := fmt.Sprintf("Cannot delete fleet %s that is in status of ", d.Id())
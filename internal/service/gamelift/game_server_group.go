@@ -225,7 +225,7 @@ func resourceGameServerGroupCreate(ctx context.Context, d *schema.ResourceData,
 
 	log.Printf("[INFO] Creating GameLift Game Server Group: %s", input)
 	var out *gamelift.CreateGameServerGroupOutput
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		var err error
 		out, err = conn.CreateGameServerGroupWithContext(ctx, input)
 
@@ -363,7 +363,7 @@ func resourceGameServerGroupDelete(ctx context.Context, d *schema.ResourceData,
 	input := &gamelift.DeleteGameServerGroupInput{
 		GameServerGroupName: aws.String(d.Id()),
 	}
-	err := retry.RetryContext(ctx, gameServerGroupDeletedDefaultTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, gameServerGroupDeletedDefaultTimeout, func() *retry.RetryError {
 		_, err := conn.DeleteGameServerGroupWithContext(ctx, input)
 		if err != nil {
 			msg := fmt.Sprintf("Cannot delete game server group %s: %s", d.Id(), err)
@@ -125,7 +125,7 @@ func resourceScriptCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	log.Printf("[INFO] Creating GameLift Script: %s", input)
 	var out *gamelift.CreateScriptOutput
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		var err error
 		out, err = conn.CreateScriptWithContext(ctx, &input)
 		if err != nil {
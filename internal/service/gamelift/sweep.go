@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
 		Name: "aws_gamelift_alias",
 		Dependencies: []string{
 			"aws_gamelift_fleet",
@@ -25,17 +25,17 @@ func RegisterSweepers() {
 		F: sweepAliases,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
 		Name: "aws_gamelift_build",
 		F:    sweepBuilds,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_script", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_script", &resource.Sweeper{
 		Name: "aws_gamelift_script",
 		F:    sweepScripts,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
 		Name: "aws_gamelift_fleet",
 		Dependencies: []string{
 			"aws_gamelift_build",
@@ -43,12 +43,12 @@ func RegisterSweepers() {
 		F: sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_game_server_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_game_server_group", &resource.Sweeper{
 		Name: "aws_gamelift_game_server_group",
 		F:    sweepGameServerGroups,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
 		Name: "aws_gamelift_game_session_queue",
 		F:    sweepGameSessionQueue,
 	})
@@ -61,33 +61,32 @@ func sweepAliases(region string) error {
 		return fmt.Errorf("getting client: %s", err)
 	}
 	conn := client.GameLiftConn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = listAliases(ctx, &gamelift.ListAliasesInput{}, conn, func(resp *gamelift.ListAliasesOutput) error {
-		if len(resp.Aliases) == 0 {
-			log.Print("[DEBUG] No GameLift Aliases to sweep")
-			return nil
-		}
-
-		log.Printf("[INFO] Found %d GameLift Aliases", len(resp.Aliases))
-
 		for _, alias := range resp.Aliases {
-			log.Printf("[INFO] Deleting GameLift Alias %q", *alias.AliasId)
-			_, err := conn.DeleteAliasWithContext(ctx, &gamelift.DeleteAliasInput{
-				AliasId: alias.AliasId,
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting GameLift Alias (%s): %s",
-					*alias.AliasId, err)
-			}
+			r := ResourceAlias()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(alias.AliasId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 		return nil
 	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping GameLift Alias sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if awsv1.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping GameLift Alias sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error listing GameLift Aliases: %s", err)
+		return fmt.Errorf("listing GameLift Aliases (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping GameLift Aliases (%s): %w", region, err)
 	}
 
 	return nil
@@ -100,32 +99,31 @@ func sweepBuilds(region string) error {
 		return fmt.Errorf("getting client: %s", err)
 	}
 	conn := client.GameLiftConn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	resp, err := conn.ListBuildsWithContext(ctx, &gamelift.ListBuildsInput{})
-	if err != nil {
-		if awsv1.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Gamelife Build sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error listing GameLift Builds: %s", err)
-	}
 
-	if len(resp.Builds) == 0 {
-		log.Print("[DEBUG] No GameLift Builds to sweep")
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Gamelife Build sweep for %s: %s", region, err)
 		return nil
 	}
 
-	log.Printf("[INFO] Found %d GameLift Builds", len(resp.Builds))
+	if err != nil {
+		return fmt.Errorf("listing GameLift Builds (%s): %w", region, err)
+	}
 
 	for _, build := range resp.Builds {
-		log.Printf("[INFO] Deleting GameLift Build %q", *build.BuildId)
-		_, err := conn.DeleteBuildWithContext(ctx, &gamelift.DeleteBuildInput{
-			BuildId: build.BuildId,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting GameLift Build (%s): %s",
-				*build.BuildId, err)
-		}
+		r := ResourceBuild()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(build.BuildId))
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping GameLift Builds (%s): %w", region, err)
 	}
 
 	return nil
@@ -138,32 +136,31 @@ func sweepScripts(region string) error {
 		return fmt.Errorf("getting client: %s", err)
 	}
 	conn := client.GameLiftConn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	resp, err := conn.ListScriptsWithContext(ctx, &gamelift.ListScriptsInput{})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Gamelife Script sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if awsv1.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Gamelife Script sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error listing GameLift Scripts: %s", err)
+		return fmt.Errorf("listing GameLift Scripts (%s): %w", region, err)
 	}
 
-	if len(resp.Scripts) == 0 {
-		log.Print("[DEBUG] No GameLift Scripts to sweep")
-		return nil
+	for _, script := range resp.Scripts {
+		r := ResourceScript()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(script.ScriptId))
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 	}
 
-	log.Printf("[INFO] Found %d GameLift Scripts", len(resp.Scripts))
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-	for _, build := range resp.Scripts {
-		log.Printf("[INFO] Deleting GameLift Script %q", *build.ScriptId)
-		_, err := conn.DeleteScriptWithContext(ctx, &gamelift.DeleteScriptInput{
-			ScriptId: build.ScriptId,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting GameLift Script (%s): %s",
-				*build.ScriptId, err)
-		}
+	if err != nil {
+		return fmt.Errorf("sweeping GameLift Scripts (%s): %w", region, err)
 	}
 
 	return nil
@@ -286,6 +283,7 @@ func sweepGameSessionQueue(region string) error {
 		return fmt.Errorf("getting client: %s", err)
 	}
 	conn := client.GameLiftConn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	out, err := conn.DescribeGameSessionQueuesWithContext(ctx, &gamelift.DescribeGameSessionQueuesInput{})
 
@@ -295,25 +293,21 @@ func sweepGameSessionQueue(region string) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("listing GameLift Session Queue: %s", err)
+		return fmt.Errorf("listing GameLift Session Queues (%s): %w", region, err)
 	}
 
-	if len(out.GameSessionQueues) == 0 {
-		log.Print("[DEBUG] No GameLift Session Queue to sweep")
-		return nil
+	for _, queue := range out.GameSessionQueues {
+		r := ResourceGameSessionQueue()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(queue.Name))
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 	}
 
-	log.Printf("[INFO] Found %d GameLift Session Queue", len(out.GameSessionQueues))
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-	for _, queue := range out.GameSessionQueues {
-		log.Printf("[INFO] Deleting GameLift Session Queue %q", *queue.Name)
-		_, err := conn.DeleteGameSessionQueueWithContext(ctx, &gamelift.DeleteGameSessionQueueInput{
-			Name: aws.String(*queue.Name),
-		})
-		if err != nil {
-			return fmt.Errorf("deleting GameLift Session Queue (%s): %s",
-				*queue.Name, err)
-		}
+	if err != nil {
+		return fmt.Errorf("sweeping GameLift Session Queues (%s): %w", region, err)
 	}
 
 	return nil
@@ -330,6 +324,7 @@ func listAliases(ctx context.Context, input *gamelift.ListAliasesInput, conn *ga
 	}
 
 	if resp.NextToken != nil {
+		input.NextToken = resp.NextToken
 		return listAliases(ctx, input, conn, f)
 	}
 	return nil
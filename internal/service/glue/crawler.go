@@ -438,7 +438,7 @@ func resourceCrawlerCreate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	// Retry for IAM eventual consistency
-	err = retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		_, err = glueConn.CreateCrawlerWithContext(ctx, crawlerInput)
 		if err != nil {
 			// InvalidInputException: Insufficient Lake Formation permission(s) on xxx
@@ -498,7 +498,7 @@ func resourceCrawlerRead(ctx context.Context, d *schema.ResourceData, meta inter
 	crawlerARN := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("crawler/%s", d.Id()),
 	}.String()
@@ -585,7 +585,7 @@ func resourceCrawlerUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		// Retry for IAM eventual consistency
-		err = retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+		err = tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 			_, err := glueConn.UpdateCrawlerWithContext(ctx, updateCrawlerInput)
 			if err != nil {
 				// InvalidInputException: Insufficient Lake Formation permission(s) on xxx
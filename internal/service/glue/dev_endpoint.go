@@ -234,7 +234,7 @@ func resourceDevEndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	log.Printf("[DEBUG] Creating Glue Dev Endpoint: %#v", *input)
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		_, err := conn.CreateDevEndpointWithContext(ctx, input)
 		if err != nil {
 			// Retry for IAM eventual consistency
@@ -290,7 +290,7 @@ func resourceDevEndpointRead(ctx context.Context, d *schema.ResourceData, meta i
 	endpointARN := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("devEndpoint/%s", d.Id()),
 	}.String()
@@ -465,7 +465,7 @@ func resourceDevEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if hasChanged {
 		log.Printf("[DEBUG] Updating Glue Dev Endpoint: %s", input)
-		err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+		err := tfresource.Retry(ctx, 5*time.Minute, func() *retry.RetryError {
 			_, err := conn.UpdateDevEndpointWithContext(ctx, input)
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, glue.ErrCodeInvalidInputException, "another concurrent update operation") {
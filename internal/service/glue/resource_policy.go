@@ -76,7 +76,7 @@ func resourceResourcePolicyPut(condition string) func(context.Context, *schema.R
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "putting policy request: %s", err)
 		}
-		d.SetId(meta.(*conns.AWSClient).Region)
+		d.SetId(meta.(*conns.AWSClient).Region(ctx))
 
 		return append(diags, resourceResourcePolicyRead(ctx, d, meta)...)
 	}
@@ -32,7 +32,7 @@ func waitMLTransformDeleted(ctx context.Context, conn *glue.Glue, transformId st
 		Timeout: mlTransformDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetMLTransformOutput); ok {
 		return output, err
@@ -50,7 +50,7 @@ func waitRegistryDeleted(ctx context.Context, conn *glue.Glue, registryID string
 		Timeout: registryDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetRegistryOutput); ok {
 		return output, err
@@ -68,7 +68,7 @@ func waitSchemaAvailable(ctx context.Context, conn *glue.Glue, registryID string
 		Timeout: schemaAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetSchemaOutput); ok {
 		return output, err
@@ -86,7 +86,7 @@ func waitSchemaDeleted(ctx context.Context, conn *glue.Glue, registryID string)
 		Timeout: schemaDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetSchemaOutput); ok {
 		return output, err
@@ -104,7 +104,7 @@ func waitSchemaVersionAvailable(ctx context.Context, conn *glue.Glue, registryID
 		Timeout: schemaVersionAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetSchemaVersionOutput); ok {
 		return output, err
@@ -129,7 +129,7 @@ func waitTriggerCreated(ctx context.Context, conn *glue.Glue, triggerName string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetTriggerOutput); ok {
 		return output, err
@@ -147,7 +147,7 @@ func waitTriggerDeleted(ctx context.Context, conn *glue.Glue, triggerName string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetTriggerOutput); ok {
 		return output, err
@@ -164,7 +164,7 @@ func waitDevEndpointCreated(ctx context.Context, conn *glue.Glue, name string) (
 		Timeout: 15 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.DevEndpoint); ok {
 		if status := aws.StringValue(output.Status); status == devEndpointStatusFailed {
@@ -185,7 +185,7 @@ func waitDevEndpointDeleted(ctx context.Context, conn *glue.Glue, name string) (
 		Timeout: 15 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.DevEndpoint); ok {
 		if status := aws.StringValue(output.Status); status == devEndpointStatusFailed {
@@ -206,7 +206,7 @@ func waitPartitionIndexCreated(ctx context.Context, conn *glue.Glue, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.PartitionIndexDescriptor); ok {
 		return output, err
@@ -223,7 +223,7 @@ func waitPartitionIndexDeleted(ctx context.Context, conn *glue.Glue, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.PartitionIndexDescriptor); ok {
 		return output, err
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitWorkspaceCreated(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string, timeout time.Duration) (*managedgrafana.WorkspaceDescription, error) {
@@ -19,7 +20,7 @@ func waitWorkspaceCreated(ctx context.Context, conn *managedgrafana.ManagedGrafa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
 		return output, err
@@ -36,7 +37,7 @@ func waitWorkspaceUpdated(ctx context.Context, conn *managedgrafana.ManagedGrafa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
 		return output, err
@@ -53,7 +54,7 @@ func waitWorkspaceDeleted(ctx context.Context, conn *managedgrafana.ManagedGrafa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
 		return output, err
@@ -70,7 +71,7 @@ func waitLicenseAssociationCreated(ctx context.Context, conn *managedgrafana.Man
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
 		return output, err
@@ -87,7 +88,7 @@ func waitWorkspaceSAMLConfigurationCreated(ctx context.Context, conn *managedgra
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*managedgrafana.SamlAuthentication); ok {
 		return output, err
@@ -61,7 +61,7 @@ func resourceInviteAccepterCreate(ctx context.Context, d *schema.ResourceData, m
 
 	listInvitationsInput := &guardduty.ListInvitationsInput{}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		log.Printf("[DEBUG] Listing GuardDuty Invitations: %s", listInvitationsInput)
 		err := conn.ListInvitationsPagesWithContext(ctx, listInvitationsInput, func(page *guardduty.ListInvitationsOutput, lastPage bool) bool {
 			for _, invitation := range page.Invitations {
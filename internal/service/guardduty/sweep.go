@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/guardduty"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -17,13 +16,13 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
 		Name:         "aws_guardduty_detector",
 		F:            sweepDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
@@ -39,26 +38,19 @@ func sweepDetectors(region string) error {
 
 	conn := client.GuardDutyConn(ctx)
 	input := &guardduty.ListDetectorsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.ListDetectorsPagesWithContext(ctx, input, func(page *guardduty.ListDetectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, detectorID := range page.DetectorIds {
-			id := aws.StringValue(detectorID)
-			input := &guardduty.DeleteDetectorInput{
-				DetectorId: detectorID,
-			}
+			r := ResourceDetector()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(detectorID))
 
-			log.Printf("[INFO] Deleting GuardDuty Detector: %s", id)
-			_, err := conn.DeleteDetectorWithContext(ctx, input)
-			if tfawserr.ErrCodeContains(err, "AccessDenied") {
-				log.Printf("[WARN] Skipping GuardDuty Detector (%s): %s", id, err)
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting GuardDuty Detector (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
@@ -73,7 +65,13 @@ func sweepDetectors(region string) error {
 		return fmt.Errorf("error retrieving GuardDuty Detectors: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping GuardDuty Detectors (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepPublishingDestinations(region string) error {
@@ -85,51 +83,55 @@ func sweepPublishingDestinations(region string) error {
 	}
 
 	conn := client.GuardDutyConn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
 	var sweeperErrs *multierror.Error
 
 	detect_input := &guardduty.ListDetectorsInput{}
 
 	err = conn.ListDetectorsPagesWithContext(ctx, detect_input, func(page *guardduty.ListDetectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, detectorID := range page.DetectorIds {
 			list_input := &guardduty.ListPublishingDestinationsInput{
 				DetectorId: detectorID,
 			}
 
-			err = conn.ListPublishingDestinationsPagesWithContext(ctx, list_input, func(page *guardduty.ListPublishingDestinationsOutput, lastPage bool) bool {
+			err := conn.ListPublishingDestinationsPagesWithContext(ctx, list_input, func(page *guardduty.ListPublishingDestinationsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
 				for _, destination_element := range page.Destinations {
-					input := &guardduty.DeletePublishingDestinationInput{
-						DestinationId: destination_element.DestinationId,
-						DetectorId:    detectorID,
-					}
-
-					log.Printf("[INFO] Deleting GuardDuty Publishing Destination: %s", *destination_element.DestinationId)
-					_, err := conn.DeletePublishingDestinationWithContext(ctx, input)
-
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting GuardDuty Publishing Destination (%s): %w", *destination_element.DestinationId, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					}
+					r := ResourcePublishingDestination()
+					d := r.Data(nil)
+					d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(detectorID), aws.StringValue(destination_element.DestinationId)))
+					d.Set("detector_id", detectorID)
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
 				return !lastPage
 			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing GuardDuty Publishing Destinations (%s): %w", aws.StringValue(detectorID), err))
+			}
 		}
 		return !lastPage
 	})
 
-	if err != nil {
-		sweeperErr := fmt.Errorf("Error receiving Guardduty detectors for publishing sweep : %w", err)
-		log.Printf("[ERROR] %s", sweeperErr)
-		sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-	}
-
 	if awsv1.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping GuardDuty Publishing Destination sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error retrieving GuardDuty Publishing Destinations: %s", err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving GuardDuty Detectors: %w", err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping GuardDuty Publishing Destinations (%s): %w", region, err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
@@ -69,6 +69,8 @@ func resourceGroupPolicy() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: PolicyLintCustomizeDiff(names.AttrPolicy, PolicyTypeIdentity),
 	}
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -37,6 +38,7 @@ const (
 // @SDKResource("aws_iam_policy", name="Policy")
 // @Tags(identifierAttribute="id", resourceType="Policy")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Policy")
+// @IAMActions(create="iam:CreatePolicy;iam:GetPolicy;iam:GetPolicyVersion;iam:ListPolicyTags;iam:TagPolicy", read="iam:GetPolicy;iam:GetPolicyVersion;iam:ListPolicyTags", update="iam:CreatePolicyVersion;iam:DeletePolicyVersion;iam:ListPolicyVersions;iam:TagPolicy;iam:UntagPolicy", delete="iam:DeletePolicy;iam:DeletePolicyVersion;iam:ListPolicyVersions")
 func resourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePolicyCreate,
@@ -103,7 +105,10 @@ func resourcePolicy() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			PolicyLintCustomizeDiff(names.AttrPolicy, PolicyTypeIdentity),
+		),
 	}
 }
 
@@ -0,0 +1,402 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+)
+
+// PolicyLintSeverity is the severity of a policy linter finding.
+type PolicyLintSeverity int
+
+const (
+	PolicyLintSeverityWarning PolicyLintSeverity = iota
+	PolicyLintSeverityError
+)
+
+func (s PolicyLintSeverity) String() string {
+	switch s {
+	case PolicyLintSeverityError:
+		return "error"
+	default:
+		return "warning"
+	}
+}
+
+// PolicyType is the type of an IAM policy document.
+type PolicyType int
+
+const (
+	// PolicyTypeIdentity is an identity-based policy, attached to a user, group or role.
+	PolicyTypeIdentity PolicyType = iota
+	// PolicyTypeResource is a resource-based policy, attached to a resource such as an S3 bucket.
+	PolicyTypeResource
+)
+
+// PolicyLintFinding is a problem found in an IAM policy document by the offline policy linter.
+type PolicyLintFinding struct {
+	Severity  PolicyLintSeverity
+	Statement string // Statement Sid, or index if the statement has no Sid
+	Message   string
+}
+
+func (f PolicyLintFinding) String() string {
+	if f.Statement == "" {
+		return f.Message
+	}
+
+	return fmt.Sprintf("statement %s: %s", f.Statement, f.Message)
+}
+
+//go:embed policy_lint_catalog.txt
+var policyLintCatalogData string
+
+var (
+	policyLintCatalogOnce sync.Once
+	policyLintCatalog     map[string][]string // Lower-case service prefix to lower-case actions. A nil value means that actions are not checked.
+)
+
+func loadPolicyLintCatalog() map[string][]string {
+	policyLintCatalogOnce.Do(func() {
+		policyLintCatalog = make(map[string][]string)
+
+		scanner := bufio.NewScanner(strings.NewReader(policyLintCatalogData))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+
+			line = strings.ToLower(line)
+			if service, action, ok := strings.Cut(line, ":"); ok {
+				policyLintCatalog[service] = append(policyLintCatalog[service], action)
+			} else if _, ok := policyLintCatalog[line]; !ok {
+				policyLintCatalog[line] = nil
+			}
+		}
+	})
+
+	return policyLintCatalog
+}
+
+var (
+	policyLintActionRegexp   = regexache.MustCompile(`^[0-9A-Za-z-]+:[0-9A-Za-z*?]+$`)
+	policyLintPartitionRegex = regexache.MustCompile(`^(aws|aws-cn|aws-us-gov|aws-iso|aws-iso-b|aws-iso-e|aws-iso-f)$`)
+)
+
+// policyLintConditionOperators are the IAM condition operators, without any set operator prefix or IfExists suffix.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html.
+var policyLintConditionOperators = []string{
+	"ArnEquals", "ArnLike", "ArnNotEquals", "ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals", "DateGreaterThan", "DateGreaterThanEquals", "DateLessThan", "DateLessThanEquals", "DateNotEquals",
+	"IpAddress", "NotIpAddress",
+	"Null",
+	"NumericEquals", "NumericGreaterThan", "NumericGreaterThanEquals", "NumericLessThan", "NumericLessThanEquals", "NumericNotEquals",
+	"StringEquals", "StringEqualsIgnoreCase", "StringLike", "StringNotEquals", "StringNotEqualsIgnoreCase", "StringNotLike",
+}
+
+// LintPolicy checks an IAM policy document for problems that IAM would reject or that are likely mistakes.
+// The checks are performed offline, without calling IAM Access Analyzer.
+// An error is returned only if the policy document cannot be parsed.
+func LintPolicy(policy string, policyType PolicyType) ([]PolicyLintFinding, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, fmt.Errorf("parsing policy document: %w", err)
+	}
+
+	// A single statement object is valid in place of a list of statements.
+	if v, ok := raw["Statement"]; ok && strings.HasPrefix(strings.TrimSpace(string(v)), "{") {
+		raw["Statement"] = append(append([]byte("["), v...), ']')
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc IAMPolicyDoc
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("parsing policy document: %w", err)
+	}
+
+	var findings []PolicyLintFinding
+
+	switch doc.Version {
+	case "2012-10-17", "2008-10-17":
+	case "":
+		findings = append(findings, PolicyLintFinding{
+			Severity: PolicyLintSeverityWarning,
+			Message:  `no Version specified, policy variables will not be supported; use "2012-10-17"`,
+		})
+	default:
+		findings = append(findings, PolicyLintFinding{
+			Severity: PolicyLintSeverityError,
+			Message:  fmt.Sprintf(`invalid Version (%s), must be "2012-10-17" or "2008-10-17"`, doc.Version),
+		})
+	}
+
+	if len(doc.Statements) == 0 {
+		findings = append(findings, PolicyLintFinding{
+			Severity: PolicyLintSeverityError,
+			Message:  "policy document contains no statements",
+		})
+	}
+
+	for i, statement := range doc.Statements {
+		if statement == nil {
+			continue
+		}
+
+		findings = append(findings, lintPolicyStatement(policyLintStatementName(i, statement), statement, policyType)...)
+	}
+
+	return findings, nil
+}
+
+func policyLintStatementName(i int, statement *IAMPolicyStatement) string {
+	if statement.Sid != "" {
+		return strconv.Quote(statement.Sid)
+	}
+
+	return strconv.Itoa(i)
+}
+
+func lintPolicyStatement(name string, statement *IAMPolicyStatement, policyType PolicyType) []PolicyLintFinding {
+	var findings []PolicyLintFinding
+	add := func(severity PolicyLintSeverity, format string, a ...any) {
+		findings = append(findings, PolicyLintFinding{
+			Severity:  severity,
+			Statement: name,
+			Message:   fmt.Sprintf(format, a...),
+		})
+	}
+
+	switch statement.Effect {
+	case "Allow", "Deny":
+	default:
+		add(PolicyLintSeverityError, `invalid Effect (%s), must be "Allow" or "Deny"`, statement.Effect)
+	}
+
+	actions, notActions := policyLintStringList(statement.Actions), policyLintStringList(statement.NotActions)
+	switch {
+	case len(actions) == 0 && len(notActions) == 0:
+		add(PolicyLintSeverityError, "must contain Action or NotAction")
+	case len(actions) > 0 && len(notActions) > 0:
+		add(PolicyLintSeverityError, "must not contain both Action and NotAction")
+	}
+	for _, action := range append(actions, notActions...) {
+		for _, finding := range lintPolicyAction(action) {
+			add(finding.Severity, "%s", finding.Message)
+		}
+	}
+
+	resources, notResources := policyLintStringList(statement.Resources), policyLintStringList(statement.NotResources)
+	switch {
+	case len(resources) == 0 && len(notResources) == 0:
+		add(PolicyLintSeverityError, "must contain Resource or NotResource")
+	case len(resources) > 0 && len(notResources) > 0:
+		add(PolicyLintSeverityError, "must not contain both Resource and NotResource")
+	}
+	for _, resource := range append(resources, notResources...) {
+		if err := lintPolicyResource(resource); err != nil {
+			add(PolicyLintSeverityError, "%s", err)
+		}
+	}
+
+	hasPrincipal := len(statement.Principals) > 0 || len(statement.NotPrincipals) > 0
+	switch policyType {
+	case PolicyTypeIdentity:
+		if hasPrincipal {
+			add(PolicyLintSeverityError, "identity-based policies must not contain Principal or NotPrincipal")
+		}
+	case PolicyTypeResource:
+		if !hasPrincipal {
+			add(PolicyLintSeverityError, "resource-based policies must contain Principal or NotPrincipal")
+		}
+	}
+
+	if len(statement.NotPrincipals) > 0 && statement.Effect == "Allow" {
+		add(PolicyLintSeverityError, `NotPrincipal must only be used with "Deny" Effect; with "Allow" it grants access to all principals except those listed`)
+	}
+
+	for _, condition := range statement.Conditions {
+		if !isValidPolicyConditionOperator(condition.Test) {
+			add(PolicyLintSeverityError, "invalid condition operator (%s)", condition.Test)
+		}
+	}
+
+	return findings
+}
+
+func lintPolicyAction(action string) []PolicyLintFinding {
+	if action == "*" {
+		return nil
+	}
+
+	if !policyLintActionRegexp.MatchString(action) {
+		return []PolicyLintFinding{{
+			Severity: PolicyLintSeverityError,
+			Message:  fmt.Sprintf(`invalid action (%s), must be of the form "service:Action"`, action),
+		}}
+	}
+
+	service, name, _ := strings.Cut(strings.ToLower(action), ":")
+	catalog := loadPolicyLintCatalog()
+
+	knownActions, ok := catalog[service]
+	if !ok {
+		return []PolicyLintFinding{{
+			Severity: PolicyLintSeverityError,
+			Message:  fmt.Sprintf("unknown service prefix (%s) in action (%s)", service, action),
+		}}
+	}
+
+	if knownActions == nil {
+		return nil
+	}
+
+	for _, v := range knownActions {
+		// Action names contain no path separators so path.Match implements IAM's "*" and "?" wildcards.
+		if ok, _ := path.Match(name, v); ok {
+			return nil
+		}
+	}
+
+	return []PolicyLintFinding{{
+		Severity: PolicyLintSeverityError,
+		Message:  fmt.Sprintf("action (%s) does not match any known %s action", action, service),
+	}}
+}
+
+func lintPolicyResource(resource string) error {
+	if resource == "*" {
+		return nil
+	}
+
+	parts := strings.SplitN(resource, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" {
+		return fmt.Errorf(`malformed resource ARN (%s), must be "*" or of the form "arn:partition:service:region:account-id:resource"`, resource)
+	}
+
+	if partition := parts[1]; !policyLintPartitionRegex.MatchString(partition) && !strings.ContainsAny(partition, "*?$") {
+		return fmt.Errorf("malformed resource ARN (%s), unknown partition (%s)", resource, partition)
+	}
+
+	if parts[2] == "" {
+		return fmt.Errorf("malformed resource ARN (%s), service must not be empty", resource)
+	}
+
+	if parts[5] == "" {
+		return fmt.Errorf("malformed resource ARN (%s), resource must not be empty", resource)
+	}
+
+	return nil
+}
+
+func isValidPolicyConditionOperator(operator string) bool {
+	for _, prefix := range []string{"ForAllValues:", "ForAnyValue:"} {
+		if v, ok := strings.CutPrefix(operator, prefix); ok {
+			operator = v
+			break
+		}
+	}
+
+	if v, ok := strings.CutSuffix(operator, "IfExists"); ok && v != "Null" {
+		operator = v
+	}
+
+	return slices.Contains(policyLintConditionOperators, operator)
+}
+
+// policyLintStringList returns a policy element that is a string or list of strings as a list of strings.
+func policyLintStringList(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		s := make([]string, 0, len(v))
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
+
+// PolicyLintCustomizeDiff returns a CustomizeDiffFunc that lints the IAM policy document in the specified attribute
+// when it changes, if enabled by the provider's `iam_policy_validation` argument.
+// Error findings fail the plan only if `iam_policy_validation` is "error"; all other findings are returned as plan warnings.
+func PolicyLintCustomizeDiff(key string, policyType PolicyType) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		mode := meta.(*conns.AWSClient).IAMPolicyValidation(ctx)
+
+		if mode == "" || mode == conns.IAMPolicyValidationOff {
+			return nil
+		}
+
+		if !d.NewValueKnown(key) || !d.HasChange(key) {
+			return nil
+		}
+
+		policy, ok := d.Get(key).(string)
+		if !ok || policy == "" {
+			return nil
+		}
+
+		findings, err := LintPolicy(policy, policyType)
+		if err != nil {
+			// Invalid JSON is reported by the attribute's validation.
+			return nil
+		}
+
+		warnings, err := policyLintResult(key, findings, mode)
+		for _, warning := range warnings {
+			sdkv2.AddPlanWarning(ctx, warning)
+		}
+
+		return err
+	}
+}
+
+// policyLintResult returns the policy linter's findings for the specified attribute as plan warnings and an error.
+// Error findings are returned as an error if mode is "error"; all other findings are returned as warnings.
+func policyLintResult(key string, findings []PolicyLintFinding, mode string) ([]sdkv2.PlanWarning, error) {
+	var errs []error
+	var warnings []sdkv2.PlanWarning
+
+	for _, finding := range findings {
+		if finding.Severity == PolicyLintSeverityError && mode == conns.IAMPolicyValidationError {
+			errs = append(errs, fmt.Errorf("%s: %s", key, finding))
+			continue
+		}
+
+		warnings = append(warnings, sdkv2.PlanWarning{
+			Attribute: key,
+			Summary:   "IAM policy validation " + finding.Severity.String(),
+			Detail:    finding.String(),
+		})
+	}
+
+	return warnings, errors.Join(errs...)
+}
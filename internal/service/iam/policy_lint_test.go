@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+)
+
+func TestLintPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name             string
+		Policy           string
+		PolicyType       PolicyType
+		ExpectedErrors   int
+		ExpectedWarnings int
+		ExpectErr        bool
+	}{
+		{
+			Name: "valid identity policy",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "Read",
+    "Effect": "Allow",
+    "Action": ["sqs:ReceiveMessage", "sqs:Get*", "ec2:DescribeInstances"],
+    "Resource": ["arn:aws:sqs:us-west-2:123456789012:queue", "*"],
+    "Condition": {"ForAnyValue:StringEqualsIfExists": {"aws:TagKeys": ["a"]}}
+  }]
+}`,
+			PolicyType: PolicyTypeIdentity,
+		},
+		{
+			Name: "valid resource policy single statement",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": {"Service": "events.amazonaws.com"},
+    "Action": "sns:Publish",
+    "Resource": "arn:aws:sns:us-west-2:123456789012:topic"
+  }
+}`,
+			PolicyType: PolicyTypeResource,
+		},
+		{
+			Name:       "invalid JSON",
+			Policy:     `{"Version":`,
+			PolicyType: PolicyTypeIdentity,
+			ExpectErr:  true,
+		},
+		{
+			Name:             "missing version",
+			Policy:           `{"Statement": [{"Effect": "Allow", "Action": "sts:GetCallerIdentity", "Resource": "*"}]}`,
+			PolicyType:       PolicyTypeIdentity,
+			ExpectedWarnings: 1,
+		},
+		{
+			Name:           "invalid version",
+			Policy:         `{"Version": "2012-10-18", "Statement": [{"Effect": "Allow", "Action": "sts:GetCallerIdentity", "Resource": "*"}]}`,
+			PolicyType:     PolicyTypeIdentity,
+			ExpectedErrors: 1,
+		},
+		{
+			Name:           "no statements",
+			Policy:         `{"Version": "2012-10-17", "Statement": []}`,
+			PolicyType:     PolicyTypeIdentity,
+			ExpectedErrors: 1,
+		},
+		{
+			Name:           "invalid effect",
+			Policy:         `{"Version": "2012-10-17", "Statement": [{"Effect": "allow", "Action": "sts:GetCallerIdentity", "Resource": "*"}]}`,
+			PolicyType:     PolicyTypeIdentity,
+			ExpectedErrors: 1,
+		},
+		{
+			Name:           "missing action and resource",
+			Policy:         `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow"}]}`,
+			PolicyType:     PolicyTypeIdentity,
+			ExpectedErrors: 2,
+		},
+		{
+			Name:           "both action and not action",
+			Policy:         `{"Version": "2012-10-17", "Statement": [{"Effect": "Deny", "Action": "sqs:*", "NotAction": "sns:*", "Resource": "*"}]}`,
+			PolicyType:     PolicyTypeIdentity,
+			ExpectedErrors: 1,
+		},
+		{
+			Name:           "malformed action",
+			Policy:         `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sqs-SendMessage", "Resource": "*"}]}`,
+			PolicyType:     PolicyTypeIdentity,
+			ExpectedErrors: 1,
+		},
+		{
+			Name:           "unknown service prefix",
+			Policy:         `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "notaservice:DoThing", "Resource": "*"}]}`,
+			PolicyType:     PolicyTypeIdentity,
+			ExpectedErrors: 1,
+		},
+		{
+			Name:           "unknown action",
+			Policy:         `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["kms:Decrypt", "kms:DecryptEverything", "sqs:Send*Batch"], "Resource": "*"}]}`,
+			PolicyType:     PolicyTypeIdentity,
+			ExpectedErrors: 1,
+		},
+		{
+			Name:           "malformed resource ARN",
+			Policy:         `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": ["arn:aws:sqs:us-west-2:123456789012", "arn:aws-mars:sqs:us-west-2:123456789012:queue"]}]}`,
+			PolicyType:     PolicyTypeIdentity,
+			ExpectedErrors: 2,
+		},
+		{
+			Name:           "principal in identity policy",
+			Policy:         `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": "*", "Action": "sqs:SendMessage", "Resource": "*"}]}`,
+			PolicyType:     PolicyTypeIdentity,
+			ExpectedErrors: 1,
+		},
+		{
+			Name:           "missing principal in resource policy",
+			Policy:         `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "*"}]}`,
+			PolicyType:     PolicyTypeResource,
+			ExpectedErrors: 1,
+		},
+		{
+			Name:           "not principal with allow",
+			Policy:         `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "NotPrincipal": {"AWS": "arn:aws:iam::123456789012:root"}, "Action": "sqs:SendMessage", "Resource": "*"}]}`,
+			PolicyType:     PolicyTypeResource,
+			ExpectedErrors: 1,
+		},
+		{
+			Name:           "invalid condition operator",
+			Policy:         `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "*", "Condition": {"StringEqual": {"aws:PrincipalTag/team": "a"}, "NullIfExists": {"aws:TagKeys": "true"}}}]}`,
+			PolicyType:     PolicyTypeIdentity,
+			ExpectedErrors: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, err := LintPolicy(testCase.Policy, testCase.PolicyType)
+
+			if got, want := err != nil, testCase.ExpectErr; got != want {
+				t.Fatalf("LintPolicy err %t, want %t (%v)", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			var errors, warnings int
+			for _, finding := range findings {
+				switch finding.Severity {
+				case PolicyLintSeverityError:
+					errors++
+				case PolicyLintSeverityWarning:
+					warnings++
+				}
+			}
+
+			if errors != testCase.ExpectedErrors || warnings != testCase.ExpectedWarnings {
+				t.Errorf("LintPolicy returned %d errors and %d warnings, want %d and %d: %v", errors, warnings, testCase.ExpectedErrors, testCase.ExpectedWarnings, findings)
+			}
+		})
+	}
+}
+
+func TestPolicyLintResult(t *testing.T) {
+	t.Parallel()
+
+	findings := []PolicyLintFinding{
+		{Severity: PolicyLintSeverityWarning, Message: `no Version specified, policy variables will not be supported; use "2012-10-17"`},
+		{Severity: PolicyLintSeverityError, Statement: "0", Message: "action (kms:DecryptEverything) does not match any known kms action"},
+	}
+
+	testCases := map[string]struct {
+		mode             string
+		expectError      bool
+		expectedWarnings []sdkv2.PlanWarning
+	}{
+		"warn": {
+			mode: conns.IAMPolicyValidationWarn,
+			expectedWarnings: []sdkv2.PlanWarning{
+				{Attribute: "policy", Summary: "IAM policy validation warning", Detail: `no Version specified, policy variables will not be supported; use "2012-10-17"`},
+				{Attribute: "policy", Summary: "IAM policy validation error", Detail: "statement 0: action (kms:DecryptEverything) does not match any known kms action"},
+			},
+		},
+		"error": {
+			mode:        conns.IAMPolicyValidationError,
+			expectError: true,
+			expectedWarnings: []sdkv2.PlanWarning{
+				{Attribute: "policy", Summary: "IAM policy validation warning", Detail: `no Version specified, policy variables will not be supported; use "2012-10-17"`},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := policyLintResult("policy", findings, testCase.mode)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("policyLintResult() err %t, want %t (%v)", got, want, err)
+			}
+			if diff := cmp.Diff(warnings, testCase.expectedWarnings); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -41,7 +41,9 @@ const (
 
 // @SDKResource("aws_iam_role", name="Role")
 // @Tags(identifierAttribute="id", resourceType="Role")
+// @IdentityAttribute("name")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Role")
+// @IAMActions(create="iam:AttachRolePolicy;iam:CreateRole;iam:GetRole;iam:GetRolePolicy;iam:ListAttachedRolePolicies;iam:ListRolePolicies;iam:ListRoleTags;iam:PutRolePolicy;iam:TagRole", read="iam:GetRole;iam:GetRolePolicy;iam:ListAttachedRolePolicies;iam:ListRolePolicies;iam:ListRoleTags", update="iam:AttachRolePolicy;iam:DeleteRolePermissionsBoundary;iam:DeleteRolePolicy;iam:DetachRolePolicy;iam:PutRolePermissionsBoundary;iam:PutRolePolicy;iam:TagRole;iam:UntagRole;iam:UpdateAssumeRolePolicy;iam:UpdateRole;iam:UpdateRoleDescription", delete="iam:DeleteRole;iam:DeleteRolePolicy;iam:DetachRolePolicy;iam:ListAttachedRolePolicies;iam:ListInstanceProfilesForRole;iam:ListRolePolicies;iam:RemoveRoleFromInstanceProfile")
 func resourceRole() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRoleCreate,
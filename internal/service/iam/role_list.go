@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_iam_role", name="Role")
+func newRoleListResource() inttypes.ListResourceForSDK {
+	return &roleListResource{}
+}
+
+type roleListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+}
+
+type roleListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	PathPrefix types.String `tfsdk:"path_prefix"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
+
+func (l *roleListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_role"
+}
+
+func (l *roleListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list roles whose name begins with this prefix.",
+			},
+			"path_prefix": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list roles whose path begins with this prefix.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only list roles with all of these tags.",
+			},
+		},
+	}
+}
+
+func (l *roleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data roleListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.IAMClient(ctx)
+
+	input := roleListInput(data)
+	filter := framework.NewListFilter(ctx, data.NamePrefix, data.Tags)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := iam.NewListRolesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(framework.NewListResultErrorDiagnostic("listing IAM Roles", err))
+				return
+			}
+
+			for _, role := range page.Roles {
+				name := aws.ToString(role.RoleName)
+
+				if !filter.MatchName(name) {
+					continue
+				}
+
+				if filter.HasTags() {
+					roleTags, err := roleKeyValueTags(ctx, conn, name)
+
+					if err != nil {
+						yield(framework.NewListResultErrorDiagnostic("listing IAM Roles", err))
+						return
+					}
+
+					if !filter.MatchTags(roleTags) {
+						continue
+					}
+				}
+
+				d := l.ResourceData()
+				d.SetId(name)
+				d.Set(names.AttrName, name)
+
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				if !l.SetResult(ctx, awsClient, request.IncludeResource, &result, d) {
+					continue
+				}
+
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func roleListInput(data roleListResourceModel) *iam.ListRolesInput {
+	input := &iam.ListRolesInput{}
+	if v := data.PathPrefix.ValueString(); v != "" {
+		input.PathPrefix = aws.String(v)
+	}
+
+	return input
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRoleListInput(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data               roleListResourceModel
+		expectedPathPrefix *string
+	}{
+		"no path prefix": {
+			data: roleListResourceModel{
+				NamePrefix: types.StringValue("tf-acc-"),
+				PathPrefix: types.StringNull(),
+				Tags:       types.MapNull(types.StringType),
+			},
+		},
+		"empty path prefix": {
+			data: roleListResourceModel{
+				NamePrefix: types.StringNull(),
+				PathPrefix: types.StringValue(""),
+				Tags:       types.MapNull(types.StringType),
+			},
+		},
+		"path prefix": {
+			data: roleListResourceModel{
+				NamePrefix: types.StringNull(),
+				PathPrefix: types.StringValue("/service-role/"),
+				Tags:       types.MapNull(types.StringType),
+			},
+			expectedPathPrefix: aws.String("/service-role/"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := roleListInput(testCase.data).PathPrefix
+
+			if diff := cmp.Diff(got, testCase.expectedPathPrefix); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -78,6 +78,8 @@ func resourceRolePolicy() *schema.Resource {
 				ValidateFunc: validRolePolicyRole,
 			},
 		},
+
+		CustomizeDiff: PolicyLintCustomizeDiff(names.AttrPolicy, PolicyTypeIdentity),
 	}
 }
 
@@ -23,19 +23,16 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
-		Name: "aws_iam_group",
-		F:    sweepGroups,
-		Dependencies: []string{
-			"aws_iam_user",
-		},
-	})
+	sweep.Register("aws_iam_group", sweepGroups,
+		"aws_iam_user",
+	)
 
 	sweep.Register("aws_iam_instance_profile", sweepInstanceProfile,
 		"aws_iam_role",
@@ -43,7 +40,7 @@ func RegisterSweepers() {
 
 	sweep.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -55,7 +52,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddDirectTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -92,14 +89,11 @@ func RegisterSweepers() {
 
 	sweep.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
-		Name: "aws_iam_server_certificate",
-		F:    sweepServerCertificates,
-	})
+	sweep.Register("aws_iam_server_certificate", sweepServerCertificates)
 
 	sweep.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -113,28 +107,16 @@ func RegisterSweepers() {
 	sweep.Register("aws_iam_virtual_mfa_device", sweepVirtualMFADevice)
 }
 
-func sweepGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
-
+func sweepGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.IAMClient(ctx)
-	input := &iam.ListGroupsInput{}
-	var sweeperErrs *multierror.Error
 
-	pages := iam.NewListGroupsPaginator(conn, input)
+	var sweepResources []sweep.Sweepable
+
+	pages := iam.NewListGroupsPaginator(conn, &iam.ListGroupsInput{})
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping IAM Group sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil()
-		}
-
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving IAM Groups: %w", err))
+			return sweepResources, err
 		}
 
 		for _, group := range page.Groups {
@@ -144,85 +126,79 @@ func sweepGroups(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting IAM Group: %s", name)
-
-			getGroupInput := &iam.GetGroupInput{
-				GroupName: group.GroupName,
-			}
-
-			getGroupOutput, err := conn.GetGroup(ctx, getGroupInput)
+			r := resourceGroup()
+			d := r.Data(nil)
+			d.SetId(name)
 
-			if errs.IsA[*awstypes.NoSuchEntityException](err) {
-				continue
-			}
+			sweepResources = append(sweepResources, newGroupSweeper(r, d, client))
+		}
+	}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error reading IAM Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+	return sweepResources, nil
+}
 
-			if getGroupOutput != nil {
-				for _, user := range getGroupOutput.Users {
-					username := aws.ToString(user.UserName)
+// groupSweeper removes a group's users and policies before deleting the group.
+type groupSweeper struct {
+	client    *conns.AWSClient
+	d         *schema.ResourceData
+	sweepable sweep.Sweepable
+}
 
-					log.Printf("[INFO] Removing IAM User (%s) from Group: %s", username, name)
+func newGroupSweeper(resource *schema.Resource, d *schema.ResourceData, client *conns.AWSClient) *groupSweeper {
+	return &groupSweeper{
+		client:    client,
+		d:         d,
+		sweepable: sdk.NewSweepResource(resource, d, client),
+	}
+}
 
-					input := &iam.RemoveUserFromGroupInput{
-						UserName:  user.UserName,
-						GroupName: group.GroupName,
-					}
+func (gs groupSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	conn := gs.client.IAMClient(ctx)
+	name := gs.d.Id()
 
-					_, err := conn.RemoveUserFromGroup(ctx, input)
+	group, err := conn.GetGroup(ctx, &iam.GetGroupInput{
+		GroupName: aws.String(name),
+	})
 
-					if errs.IsA[*awstypes.NoSuchEntityException](err) {
-						continue
-					}
+	if errs.IsA[*awstypes.NoSuchEntityException](err) {
+		return nil
+	}
 
-					if err != nil {
-						sweeperErr := fmt.Errorf("error removing IAM User (%s) from IAM Group (%s): %w", username, name, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
-					}
-				}
-			}
+	if err != nil {
+		return fmt.Errorf("reading IAM Group (%s): %w", name, err)
+	}
 
-			input := &iam.DeleteGroupInput{
-				GroupName: group.GroupName,
-			}
+	for _, user := range group.Users {
+		username := aws.ToString(user.UserName)
 
-			if err := DeleteGroupPolicyAttachments(ctx, conn, name); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s) policy attachments: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+		log.Printf("[INFO] Removing IAM User (%s) from Group: %s", username, name)
+		_, err := conn.RemoveUserFromGroup(ctx, &iam.RemoveUserFromGroupInput{
+			UserName:  user.UserName,
+			GroupName: aws.String(name),
+		})
 
-			if err := DeleteGroupPolicies(ctx, conn, name); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s) policies: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			continue
+		}
 
-			_, err = conn.DeleteGroup(ctx, input)
+		if err != nil {
+			return fmt.Errorf("removing IAM User (%s) from IAM Group (%s): %w", username, name, err)
+		}
+	}
 
-			if errs.IsA[*awstypes.NoSuchEntityException](err) {
-				continue
-			}
+	if err := DeleteGroupPolicyAttachments(ctx, conn, name); err != nil {
+		return fmt.Errorf("deleting IAM Group (%s) policy attachments: %w", name, err)
+	}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-		}
+	if err := DeleteGroupPolicies(ctx, conn, name); err != nil {
+		return fmt.Errorf("deleting IAM Group (%s) policies: %w", name, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return gs.sweepable.Delete(ctx, timeout, optFns...)
+}
+
+func (gs groupSweeper) Describe(ctx context.Context, refresh bool) (filter.Resource, error) {
+	return gs.sweepable.(sweep.Describable).Describe(ctx, refresh)
 }
 
 func sweepInstanceProfile(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -503,41 +479,29 @@ func sweepSAMLProvider(ctx context.Context, client *conns.AWSClient) ([]sweep.Sw
 	return sweepResources, err
 }
 
-func sweepServerCertificates(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func sweepServerCertificates(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.IAMClient(ctx)
 
+	var sweepResources []sweep.Sweepable
+
 	pages := iam.NewListServerCertificatesPaginator(conn, &iam.ListServerCertificatesInput{})
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping IAM Server Certificate sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("Error retrieving IAM Server Certificates: %s", err)
+			return sweepResources, err
 		}
 
 		for _, sc := range page.ServerCertificateMetadataList {
-			log.Printf("[INFO] Deleting IAM Server Certificate: %s", aws.ToString(sc.ServerCertificateName))
+			r := resourceServerCertificate()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(sc.ServerCertificateId))
+			d.Set(names.AttrName, sc.ServerCertificateName)
 
-			_, err := conn.DeleteServerCertificate(ctx, &iam.DeleteServerCertificateInput{
-				ServerCertificateName: sc.ServerCertificateName,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete IAM Server Certificate %s: %s",
-					aws.ToString(sc.ServerCertificateName), err)
-				continue
-			}
+			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
 		}
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
 func sweepServiceLinkedRoles(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/vault/helper/pgpkeys"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -34,7 +35,7 @@ func TestGeneratePassword(t *testing.T) {
 
 	p, err := tfiam.GeneratePassword(6)
 	if err != nil {
-		t.Fatalf(err.Error())
+		t.Fatal(err)
 	}
 	if len(p) != 6 {
 		t.Fatalf("expected a 6 character password, got: %q", p)
@@ -42,7 +43,7 @@ func TestGeneratePassword(t *testing.T) {
 
 	p, err = tfiam.GeneratePassword(128)
 	if err != nil {
-		t.Fatalf(err.Error())
+		t.Fatal(err)
 	}
 	if len(p) != 128 {
 		t.Fatalf("expected a 128 character password, got: %q", p)
@@ -394,7 +395,7 @@ func testDecryptPasswordAndTest(ctx context.Context, nProfile, nAccessKey, key s
 			return fmt.Errorf("creating session: %s", err)
 		}
 
-		return retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		return tfresource.Retry(ctx, 2*time.Minute, func() *retry.RetryError {
 			iamAsCreatedUser := iam.NewFromConfig(iamAsCreatedUserSession)
 			newPassword, err := tfiam.GeneratePassword(20)
 			if err != nil {
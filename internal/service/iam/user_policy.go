@@ -69,6 +69,8 @@ func resourceUserPolicy() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: PolicyLintCustomizeDiff(names.AttrPolicy, PolicyTypeIdentity),
 	}
 }
 
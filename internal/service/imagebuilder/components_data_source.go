@@ -85,7 +85,7 @@ func dataSourceComponentsRead(ctx context.Context, d *schema.ResourceData, meta
 		nms = append(nms, aws.StringValue(r.Name))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set(names.AttrARNs, arns)
 	d.Set("names", nms)
 
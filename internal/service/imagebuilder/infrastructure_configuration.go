@@ -208,7 +208,7 @@ func resourceInfrastructureConfigurationCreate(ctx context.Context, d *schema.Re
 	}
 
 	var output *imagebuilder.CreateInfrastructureConfigurationOutput
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		var err error
 
 		output, err = conn.CreateInfrastructureConfigurationWithContext(ctx, input)
@@ -363,7 +363,7 @@ func resourceInfrastructureConfigurationUpdate(ctx context.Context, d *schema.Re
 			input.SubnetId = aws.String(v.(string))
 		}
 
-		err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+		err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 			_, err := conn.UpdateInfrastructureConfigurationWithContext(ctx, input)
 
 			if tfawserr.ErrMessageContains(err, imagebuilder.ErrCodeInvalidParameterValueException, "instance profile does not exist") {
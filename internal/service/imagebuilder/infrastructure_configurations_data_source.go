@@ -75,7 +75,7 @@ func dataSourceInfrastructureConfigurationsRead(ctx context.Context, d *schema.R
 		nms = append(nms, aws.StringValue(r.Name))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set(names.AttrARNs, arns)
 	d.Set("names", nms)
 
@@ -121,7 +121,7 @@ func resourceAssessmentTargetDelete(ctx context.Context, d *schema.ResourceData,
 	input := &inspector.DeleteAssessmentTargetInput{
 		AssessmentTargetArn: aws.String(d.Id()),
 	}
-	err := retry.RetryContext(ctx, 60*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 60*time.Minute, func() *retry.RetryError {
 		_, err := conn.DeleteAssessmentTargetWithContext(ctx, input)
 
 		if tfawserr.ErrCodeEquals(err, inspector.ErrCodeAssessmentRunInProgressException) {
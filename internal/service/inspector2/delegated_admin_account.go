@@ -176,7 +176,7 @@ func WaitDelegatedAdminAccountEnabled(ctx context.Context, conn *inspector2.Clie
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -189,7 +189,7 @@ func WaitDelegatedAdminAccountDisabled(ctx context.Context, conn *inspector2.Cli
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -177,7 +177,7 @@ func waitMemberAssociationCreated(ctx context.Context, conn *inspector2.Client,
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
@@ -189,7 +189,7 @@ func waitMemberAssociationDeleted(ctx context.Context, conn *inspector2.Client,
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
@@ -396,7 +396,7 @@ func waitMonitor(ctx context.Context, conn *internetmonitor.Client, name string,
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*internetmonitor.GetMonitorOutput); ok {
 		if status := output.Status; status == types.MonitorConfigStateError {
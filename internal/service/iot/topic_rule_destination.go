@@ -302,7 +302,7 @@ func waitTopicRuleDestinationCreated(ctx context.Context, conn *iot.IoT, arn str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*iot.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -321,7 +321,7 @@ func waitTopicRuleDestinationDeleted(ctx context.Context, conn *iot.IoT, arn str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*iot.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -340,7 +340,7 @@ func waitTopicRuleDestinationDisabled(ctx context.Context, conn *iot.IoT, arn st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*iot.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -359,7 +359,7 @@ func waitTopicRuleDestinationEnabled(ctx context.Context, conn *iot.IoT, arn str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*iot.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -183,7 +183,7 @@ func waitStreamConsumerCreated(ctx context.Context, conn *kinesis.Client, arn st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ConsumerDescription); ok {
 		return output, err
@@ -203,7 +203,7 @@ func waitStreamConsumerDeleted(ctx context.Context, conn *kinesis.Client, arn st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ConsumerDescription); ok {
 		return output, err
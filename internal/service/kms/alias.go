@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_kms_alias", name="Alias")
+// @IAMActions(create="kms:CreateAlias;kms:ListAliases", read="kms:ListAliases", update="kms:UpdateAlias", delete="kms:DeleteAlias")
 func resourceAlias() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAliasCreate,
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSSecretsEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := acctest.EphemeralValuesDataSourceName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		TerraformVersionChecks:   acctest.EphemeralResourceTerraformVersionChecks,
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretsEphemeralConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.secret1", "my-plaintext-string"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.secret2", "my-other-plaintext-string"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecretsEphemeralConfig_basic() string {
+	return acctest.ConfigCompose(
+		acctest.ConfigEphemeralValues(map[string]string{
+			"secret1": `ephemeral.aws_kms_secrets.test.plaintext["secret1"]`,
+			"secret2": `ephemeral.aws_kms_secrets.test.plaintext["secret2"]`,
+		}),
+		`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+  description             = "Testing the Terraform AWS KMS Secrets ephemeral resource"
+}
+
+resource "aws_kms_ciphertext" "test1" {
+  key_id    = aws_kms_key.test.key_id
+  plaintext = "my-plaintext-string"
+
+  context = {
+    name = "value"
+  }
+}
+
+resource "aws_kms_ciphertext" "test2" {
+  key_id    = aws_kms_key.test.key_id
+  plaintext = "my-other-plaintext-string"
+}
+
+ephemeral "aws_kms_secrets" "test" {
+  secret {
+    name    = "secret1"
+    payload = aws_kms_ciphertext.test1.ciphertext_blob
+
+    context = {
+      name = "value"
+    }
+  }
+
+  secret {
+    name    = "secret2"
+    payload = aws_kms_ciphertext.test2.ciphertext_blob
+  }
+}
+`)
+}
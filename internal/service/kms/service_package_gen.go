@@ -14,6 +14,15 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.ServicePackageEphemeralResource {
+	return []*types.ServicePackageEphemeralResource{
+		{
+			Factory: newSecretsEphemeralResource,
+			Name:    "Secrets",
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{}
 }
@@ -68,6 +77,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAlias,
 			TypeName: "aws_kms_alias",
 			Name:     "Alias",
+			IAMActions: &types.ServicePackageResourceIAMActions{
+				Create: []string{"kms:CreateAlias", "kms:ListAliases"},
+				Read:   []string{"kms:ListAliases"},
+				Update: []string{"kms:UpdateAlias"},
+				Delete: []string{"kms:DeleteAlias"},
+			},
 		},
 		{
 			Factory:  resourceCiphertext,
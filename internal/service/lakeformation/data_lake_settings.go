@@ -191,7 +191,7 @@ func resourceDataLakeSettingsCreate(ctx context.Context, d *schema.ResourceData,
 	input.DataLakeSettings = settings
 
 	var output *lakeformation.PutDataLakeSettingsOutput
-	err := retry.RetryContext(ctx, IAMPropagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, IAMPropagationTimeout, func() *retry.RetryError {
 		var err error
 		output, err = conn.PutDataLakeSettings(ctx, input)
 		if err != nil {
@@ -304,7 +304,7 @@ func (r *resourceResourceLFTag) Create(ctx context.Context, req resource.CreateR
 	}
 
 	var output *lakeformation.AddLFTagsToResourceOutput
-	err := retry.RetryContext(ctx, IAMPropagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, IAMPropagationTimeout, func() *retry.RetryError {
 		var err error
 		output, err = conn.AddLFTagsToResource(ctx, in)
 		if err != nil {
@@ -477,7 +477,7 @@ func (r *resourceResourceLFTag) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
-	err := retry.RetryContext(ctx, deleteTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, deleteTimeout, func() *retry.RetryError {
 		var err error
 		_, err = conn.RemoveLFTagsFromResource(ctx, in)
 		if err != nil {
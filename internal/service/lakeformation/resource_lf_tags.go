@@ -248,7 +248,7 @@ func resourceResourceLFTagsCreate(ctx context.Context, d *schema.ResourceData, m
 	input.Resource = tagger.ExpandResource(d)
 
 	var output *lakeformation.AddLFTagsToResourceOutput
-	err := retry.RetryContext(ctx, IAMPropagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, IAMPropagationTimeout, func() *retry.RetryError {
 		var err error
 		output, err = conn.AddLFTagsToResource(ctx, input)
 		if err != nil {
@@ -355,7 +355,7 @@ func resourceResourceLFTagsDelete(ctx context.Context, d *schema.ResourceData, m
 		return create.AppendDiagWarningMessage(diags, names.LakeFormation, create.ErrActionSetting, ResNameLFTags, d.Id(), "no LF-Tags to remove")
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		var err error
 		_, err = conn.RemoveLFTagsFromResource(ctx, input)
 		if err != nil {
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_lambda_function", name="Function")
+func newFunctionListResource() inttypes.ListResourceForSDK {
+	return &functionListResource{}
+}
+
+type functionListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+}
+
+type functionListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
+
+func (l *functionListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_lambda_function"
+}
+
+func (l *functionListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list functions whose name begins with this prefix.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only list functions with all of these tags.",
+			},
+		},
+	}
+}
+
+func (l *functionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data functionListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.LambdaClient(ctx)
+
+	filter := framework.NewListFilter(ctx, data.NamePrefix, data.Tags)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := &lambda.ListFunctionsInput{}
+		pages := lambda.NewListFunctionsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(framework.NewListResultErrorDiagnostic("listing Lambda Functions", err))
+				return
+			}
+
+			for _, function := range page.Functions {
+				name := aws.ToString(function.FunctionName)
+
+				if !filter.MatchName(name) {
+					continue
+				}
+
+				if filter.HasTags() {
+					functionTags, err := listTags(ctx, conn, aws.ToString(function.FunctionArn))
+
+					if err != nil {
+						yield(framework.NewListResultErrorDiagnostic("listing Lambda Functions", err))
+						return
+					}
+
+					if !filter.MatchTags(functionTags) {
+						continue
+					}
+				}
+
+				d := l.ResourceData()
+				d.SetId(name)
+				d.Set("function_name", name)
+
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				if !l.SetResult(ctx, awsClient, request.IncludeResource, &result, d) {
+					continue
+				}
+
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
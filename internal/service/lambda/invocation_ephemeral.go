@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @EphemeralResource(name="Invocation")
+func newInvocationEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &invocationEphemeralResource{}, nil
+}
+
+type invocationEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (r *invocationEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_lambda_invocation"
+}
+
+func (r *invocationEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"function_name": schema.StringAttribute{
+				Required: true,
+			},
+			"input": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+			},
+			"qualifier": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"result": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (r *invocationEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data invocationEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	functionName := data.FunctionName.ValueString()
+	qualifier := data.Qualifier.ValueString()
+	if qualifier == "" {
+		qualifier = FunctionVersionLatest
+	}
+
+	input := &lambda.InvokeInput{
+		FunctionName:   aws.String(functionName),
+		InvocationType: awstypes.InvocationTypeRequestResponse,
+		Payload:        []byte(data.Input.ValueString()),
+		Qualifier:      aws.String(qualifier),
+	}
+
+	output, err := conn.Invoke(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking Lambda Function (%s)", functionName), err.Error())
+
+		return
+	}
+
+	if output.FunctionError != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking Lambda Function (%s)", functionName), string(output.Payload))
+
+		return
+	}
+
+	data.Qualifier = types.StringValue(qualifier)
+	data.Result = types.StringValue(string(output.Payload))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type invocationEphemeralResourceModel struct {
+	FunctionName types.String         `tfsdk:"function_name"`
+	Input        jsontypes.Normalized `tfsdk:"input"`
+	Qualifier    types.String         `tfsdk:"qualifier"`
+	Result       types.String         `tfsdk:"result"`
+}
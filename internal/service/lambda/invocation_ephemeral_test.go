@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaInvocationEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := acctest.EphemeralValuesDataSourceName
+	testData := "value3"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		TerraformVersionChecks:   acctest.EphemeralResourceTerraformVersionChecks,
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvocationEphemeralConfig_basic(rName, testData),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.key3", testData),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.qualifier", "$LATEST"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInvocationEphemeralConfig_basic(rName, testData string) string {
+	return acctest.ConfigCompose(
+		testAccInvocationDataSource_base_config(rName),
+		acctest.ConfigEphemeralValues(map[string]string{
+			"key1":      "jsondecode(ephemeral.aws_lambda_invocation.test.result).key1",
+			"key3":      "jsondecode(ephemeral.aws_lambda_invocation.test.result).key3",
+			"qualifier": "ephemeral.aws_lambda_invocation.test.qualifier",
+		}),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "lambda" {
+  depends_on = [aws_iam_role_policy_attachment.lambda_role_policy]
+
+  filename      = "test-fixtures/lambda_invocation.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda_role.arn
+  handler       = "lambda_invocation.handler"
+  runtime       = "nodejs16.x"
+
+  environment {
+    variables = {
+      TEST_DATA = %[2]q
+    }
+  }
+}
+
+ephemeral "aws_lambda_invocation" "test" {
+  function_name = aws_lambda_function.lambda.arn
+
+  input = jsonencode({
+    key1 = "value1"
+    key2 = "value2"
+  })
+}
+`, rName, testData))
+}
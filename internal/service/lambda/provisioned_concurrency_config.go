@@ -25,6 +25,8 @@ import (
 )
 
 // @SDKResource("aws_lambda_provisioned_concurrency_config", name="Provisioned Concurrency Config")
+// @IdentityAttribute("function_name")
+// @IdentityAttribute("qualifier")
 func resourceProvisionedConcurrencyConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProvisionedConcurrencyConfigCreate,
@@ -257,7 +259,7 @@ func waitProvisionedConcurrencyConfigReady(ctx context.Context, conn *lambda.Cli
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.GetProvisionedConcurrencyConfigOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
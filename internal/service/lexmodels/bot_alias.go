@@ -146,7 +146,7 @@ func resourceBotAliasCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.ConversationLogs = conversationLogs
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		output, err := conn.PutBotAliasWithContext(ctx, input)
 
 		input.Checksum = output.Checksum
@@ -195,7 +195,7 @@ func resourceBotAliasRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
 		Service:   "lex",
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("bot:%s", d.Id()),
@@ -240,7 +240,7 @@ func resourceBotAliasUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		input.ConversationLogs = conversationLogs
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, err := conn.PutBotAliasWithContext(ctx, input)
 
 		// IAM eventual consistency
@@ -280,7 +280,7 @@ func resourceBotAliasDelete(ctx context.Context, d *schema.ResourceData, meta in
 		Name:    aws.String(botAliasName),
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		_, err := conn.DeleteBotAliasWithContext(ctx, input)
 
 		if tfawserr.ErrCodeEquals(err, lexmodelbuildingservice.ErrCodeConflictException) {
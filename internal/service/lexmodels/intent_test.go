@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tflexmodels "github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -593,7 +594,7 @@ func TestAccLexModelsIntent_updateWithExternalChange(t *testing.T) {
 					Type: aws.String("ReturnIntent"),
 				},
 			}
-			err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+			err := tfresource.Retry(ctx, 1*time.Minute, func() *retry.RetryError {
 				_, err := conn.PutIntentWithContext(ctx, input)
 
 				if tfawserr.ErrCodeEquals(err, lexmodelbuildingservice.ErrCodeConflictException) {
@@ -202,7 +202,7 @@ func (r *resourceBot) Create(ctx context.Context, req resource.CreateRequest, re
 	botArn := arn.ARN{
 		Partition: r.Meta().Partition,
 		Service:   "lex",
-		Region:    r.Meta().Region,
+		Region:    r.Meta().Region(ctx),
 		AccountID: r.Meta().AccountID,
 		Resource:  fmt.Sprintf("bot/%s", aws.ToString(out.BotId)),
 	}.String()
@@ -248,7 +248,7 @@ func (r *resourceBot) Read(ctx context.Context, req resource.ReadRequest, resp *
 	botArn := arn.ARN{
 		Partition: r.Meta().Partition,
 		Service:   "lex",
-		Region:    r.Meta().Region,
+		Region:    r.Meta().Region(ctx),
 		AccountID: r.Meta().AccountID,
 		Resource:  fmt.Sprintf("bot/%s", aws.ToString(out.BotId)),
 	}.String()
@@ -410,7 +410,7 @@ func waitBotCreated(ctx context.Context, conn *lexmodelsv2.Client, id string, ti
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotOutput); ok {
 		return out, err
 	}
@@ -428,7 +428,7 @@ func waitBotUpdated(ctx context.Context, conn *lexmodelsv2.Client, id string, ti
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotOutput); ok {
 		return out, err
 	}
@@ -444,7 +444,7 @@ func waitBotDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotOutput); ok {
 		return out, err
 	}
@@ -58,7 +58,7 @@ func dataSourceReceivedLicensesRead(ctx context.Context, d *schema.ResourceData,
 		licenseARNs = append(licenseARNs, aws.StringValue(v.LicenseArn))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set(names.AttrARNs, licenseARNs)
 
 	return diags
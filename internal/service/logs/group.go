@@ -27,6 +27,8 @@ import (
 
 // @SDKResource("aws_cloudwatch_log_group", name="Log Group")
 // @Tags
+// @IdentityAttribute("name")
+// @IAMActions(create="logs:CreateLogGroup;logs:DescribeLogGroups;logs:ListTagsLogGroup;logs:PutRetentionPolicy", read="logs:DescribeLogGroups;logs:ListTagsLogGroup", update="logs:AssociateKmsKey;logs:DeleteRetentionPolicy;logs:DisassociateKmsKey;logs:PutRetentionPolicy;logs:TagLogGroup;logs:UntagLogGroup", delete="logs:DeleteLogGroup")
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_cloudwatch_log_group", name="Log Group")
+func newGroupListResource() inttypes.ListResourceForSDK {
+	return &groupListResource{}
+}
+
+type groupListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+}
+
+type groupListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
+
+func (l *groupListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudwatch_log_group"
+}
+
+func (l *groupListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list log groups whose name begins with this prefix.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only list log groups with all of these tags.",
+			},
+		},
+	}
+}
+
+func (l *groupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data groupListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.LogsClient(ctx)
+
+	// The name prefix filter is applied by DescribeLogGroups.
+	input := groupListInput(data)
+	filter := framework.NewListFilter(ctx, data.NamePrefix, data.Tags)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := cloudwatchlogs.NewDescribeLogGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(framework.NewListResultErrorDiagnostic("listing CloudWatch Logs Log Groups", err))
+				return
+			}
+
+			for _, group := range page.LogGroups {
+				name := aws.ToString(group.LogGroupName)
+
+				if filter.HasTags() {
+					groupTags, err := listLogGroupTags(ctx, conn, name)
+
+					if err != nil {
+						yield(framework.NewListResultErrorDiagnostic("listing CloudWatch Logs Log Groups", err))
+						return
+					}
+
+					if !filter.MatchTags(groupTags) {
+						continue
+					}
+				}
+
+				d := l.ResourceData()
+				d.SetId(name)
+				d.Set(names.AttrName, name)
+
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				if !l.SetResult(ctx, awsClient, request.IncludeResource, &result, d) {
+					continue
+				}
+
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func groupListInput(data groupListResourceModel) *cloudwatchlogs.DescribeLogGroupsInput {
+	input := &cloudwatchlogs.DescribeLogGroupsInput{}
+	if v := data.NamePrefix.ValueString(); v != "" {
+		input.LogGroupNamePrefix = aws.String(v)
+	}
+
+	return input
+}
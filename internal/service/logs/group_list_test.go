@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGroupListInput(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data                       groupListResourceModel
+		expectedLogGroupNamePrefix *string
+	}{
+		"no name prefix": {
+			data: groupListResourceModel{
+				NamePrefix: types.StringNull(),
+				Tags:       types.MapNull(types.StringType),
+			},
+		},
+		"empty name prefix": {
+			data: groupListResourceModel{
+				NamePrefix: types.StringValue(""),
+				Tags:       types.MapNull(types.StringType),
+			},
+		},
+		"name prefix": {
+			data: groupListResourceModel{
+				NamePrefix: types.StringValue("/aws/lambda/"),
+				Tags:       types.MapNull(types.StringType),
+			},
+			expectedLogGroupNamePrefix: aws.String("/aws/lambda/"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := groupListInput(testCase.data).LogGroupNamePrefix
+
+			if diff := cmp.Diff(got, testCase.expectedLogGroupNamePrefix); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -39,6 +39,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 	}
 }
 
+func (p *servicePackage) SDKListResources(ctx context.Context) []*types.ServicePackageSDKListResource {
+	return []*types.ServicePackageSDKListResource{
+		{
+			Factory:  newGroupListResource,
+			TypeName: "aws_cloudwatch_log_group",
+			Name:     "Log Group",
+		},
+	}
+}
+
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
@@ -62,6 +72,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_cloudwatch_log_group",
 			Name:     "Log Group",
 			Tags:     &types.ServicePackageResourceTags{},
+			Identity: types.RegionalSingleParameterIdentity(names.AttrName),
+			IAMActions: &types.ServicePackageResourceIAMActions{
+				Create: []string{"logs:CreateLogGroup", "logs:DescribeLogGroups", "logs:ListTagsLogGroup", "logs:PutRetentionPolicy"},
+				Read:   []string{"logs:DescribeLogGroups", "logs:ListTagsLogGroup"},
+				Update: []string{"logs:AssociateKmsKey", "logs:DeleteRetentionPolicy", "logs:DisassociateKmsKey", "logs:PutRetentionPolicy", "logs:TagLogGroup", "logs:UntagLogGroup"},
+				Delete: []string{"logs:DeleteLogGroup"},
+			},
 		},
 		{
 			Factory:  resourceMetricFilter,
@@ -78,7 +78,7 @@ func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.Status = aws.String(v.(string))
 	}
 
-	err := retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 4*time.Minute, func() *retry.RetryError {
 		_, err := conn.EnableMacieWithContext(ctx, input)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
@@ -163,7 +163,7 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 
 	input := &macie2.DisableMacieInput{}
 
-	err := retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 4*time.Minute, func() *retry.RetryError {
 		_, err := conn.DisableMacieWithContext(ctx, input)
 
 		if tfawserr.ErrMessageContains(err, macie2.ErrCodeConflictException, "Cannot disable Macie while associated with an administrator account") {
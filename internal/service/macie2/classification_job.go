@@ -608,7 +608,7 @@ func resourceClassificationJobCreate(ctx context.Context, d *schema.ResourceData
 
 	var err error
 	var output *macie2.CreateClassificationJobOutput
-	err = retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, 4*time.Minute, func() *retry.RetryError {
 		output, err = conn.CreateClassificationJobWithContext(ctx, input)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
@@ -136,7 +136,7 @@ func resourceCustomDataIdentifierCreate(ctx context.Context, d *schema.ResourceD
 
 	var err error
 	var output *macie2.CreateCustomDataIdentifierOutput
-	err = retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, 4*time.Minute, func() *retry.RetryError {
 		output, err = conn.CreateCustomDataIdentifierWithContext(ctx, input)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
@@ -161,7 +161,7 @@ func resourceFindingsFilterCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	var output *macie2.CreateFindingsFilterOutput
-	err = retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, 4*time.Minute, func() *retry.RetryError {
 		output, err = conn.CreateFindingsFilterWithContext(ctx, input)
 
 		if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
@@ -112,7 +112,7 @@ func resourceMemberCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	var err error
-	err = retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, 4*time.Minute, func() *retry.RetryError {
 		_, err := conn.CreateMemberWithContext(ctx, input)
 
 		if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
@@ -156,7 +156,7 @@ func resourceMemberCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	log.Printf("[INFO] Inviting Macie2 Member: %s", inputInvite)
 
 	var output *macie2.CreateInvitationsOutput
-	err = retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, 4*time.Minute, func() *retry.RetryError {
 		output, err = conn.CreateInvitationsWithContext(ctx, inputInvite)
 
 		if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
@@ -270,7 +270,7 @@ func resourceMemberUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			log.Printf("[INFO] Inviting Macie2 Member: %s", inputInvite)
 			var output *macie2.CreateInvitationsOutput
 			var err error
-			err = retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+			err = tfresource.Retry(ctx, 4*time.Minute, func() *retry.RetryError {
 				output, err = conn.CreateInvitationsWithContext(ctx, inputInvite)
 
 				if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
@@ -50,7 +50,7 @@ func resourceOrganizationAdminAccountCreate(ctx context.Context, d *schema.Resou
 	}
 
 	var err error
-	err = retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, 4*time.Minute, func() *retry.RetryError {
 		_, err := conn.EnableOrganizationAdminAccountWithContext(ctx, input)
 
 		if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
@@ -175,7 +175,7 @@ func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, meta int
 	dcinput := &mediapackage.DescribeChannelInput{
 		Id: aws.String(d.Id()),
 	}
-	err = retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, 5*time.Minute, func() *retry.RetryError {
 		_, err := conn.DescribeChannel(ctx, dcinput)
 		if err != nil {
 			var nfe *types.NotFoundException
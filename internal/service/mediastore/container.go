@@ -194,7 +194,7 @@ func waitContainerActive(ctx context.Context, conn *mediastore.Client, id string
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*awstypes.Container); ok {
 		return v, err
 	}
@@ -212,7 +212,7 @@ func waitContainerDeleted(ctx context.Context, conn *mediastore.Client, id strin
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*awstypes.Container); ok {
 		return v, err
 	}
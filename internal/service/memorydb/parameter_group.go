@@ -249,7 +249,7 @@ func resetParameterGroupParameters(ctx context.Context, conn *memorydb.MemoryDB,
 		ParameterNames:     parameterNames,
 	}
 
-	return retry.RetryContext(ctx, 30*time.Second, func() *retry.RetryError {
+	return tfresource.Retry(ctx, 30*time.Second, func() *retry.RetryError {
 		_, err := conn.ResetParameterGroupWithContext(ctx, &input)
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, memorydb.ErrCodeInvalidParameterGroupStateFault, " has pending changes") {
@@ -505,7 +505,7 @@ func waitDBInstanceAvailable(ctx context.Context, conn *neptune.Neptune, id stri
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*neptune.DBInstance); ok {
 		return output, err
@@ -527,7 +527,7 @@ func waitDBInstanceDeleted(ctx context.Context, conn *neptune.Neptune, id string
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*neptune.DBInstance); ok {
 		return output, err
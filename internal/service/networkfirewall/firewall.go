@@ -473,7 +473,7 @@ func waitFirewallCreated(ctx context.Context, conn *networkfirewall.NetworkFirew
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeFirewallOutput); ok {
 		return output.Firewall, err
@@ -494,7 +494,7 @@ func waitFirewallUpdated(ctx context.Context, conn *networkfirewall.NetworkFirew
 		Delay: 30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeFirewallOutput); ok {
 		return aws.StringValue(output.UpdateToken), err
@@ -511,7 +511,7 @@ func waitFirewallDeleted(ctx context.Context, conn *networkfirewall.NetworkFirew
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeFirewallOutput); ok {
 		return output.Firewall, err
@@ -73,7 +73,7 @@ func dataSourceDevicesRead(ctx context.Context, d *schema.ResourceData, meta int
 		deviceIDs = append(deviceIDs, aws.StringValue(v.DeviceId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set("ids", deviceIDs)
 
 	return diags
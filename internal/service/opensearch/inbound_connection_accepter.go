@@ -224,7 +224,7 @@ func waitInboundConnectionAccepted(ctx context.Context, conn *opensearchservice.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*opensearchservice.InboundConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
@@ -243,7 +243,7 @@ func waitInboundConnectionRejected(ctx context.Context, conn *opensearchservice.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*opensearchservice.InboundConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
@@ -262,7 +262,7 @@ func waitInboundConnectionDeleted(ctx context.Context, conn *opensearchservice.O
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*opensearchservice.InboundConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
@@ -307,7 +307,7 @@ func waitOutboundConnectionCreated(ctx context.Context, conn *opensearchservice.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*opensearchservice.OutboundConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
@@ -331,7 +331,7 @@ func waitOutboundConnectionDeleted(ctx context.Context, conn *opensearchservice.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*opensearchservice.OutboundConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
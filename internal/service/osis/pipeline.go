@@ -423,7 +423,7 @@ func waitPipelineCreated(ctx context.Context, conn *osis.Client, name string, ti
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Pipeline); ok {
 		if reason := output.StatusReason; reason != nil {
@@ -446,7 +446,7 @@ func waitPipelineUpdated(ctx context.Context, conn *osis.Client, name string, ti
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Pipeline); ok {
 		if reason := output.StatusReason; reason != nil {
@@ -469,7 +469,7 @@ func waitPipelineDeleted(ctx context.Context, conn *osis.Client, name string, ti
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Pipeline); ok {
 		if reason := output.StatusReason; reason != nil {
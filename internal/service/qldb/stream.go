@@ -298,7 +298,7 @@ func waitStreamCreated(ctx context.Context, conn *qldb.Client, ledgerName, strea
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.JournalKinesisStreamDescription); ok {
 		tfresource.SetLastError(err, errors.New(string(output.ErrorCause)))
@@ -334,7 +334,7 @@ func waitStreamDeleted(ctx context.Context, conn *qldb.Client, ledgerName, strea
 		MinTimeout: 1 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.JournalKinesisStreamDescription); ok {
 		tfresource.SetLastError(err, errors.New(string(output.ErrorCause)))
@@ -284,7 +284,7 @@ func waitAccountSubscriptionCreated(ctx context.Context, conn *quicksight.QuickS
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.AccountInfo); ok {
 		return out, err
 	}
@@ -300,7 +300,7 @@ func waitAccountSubscriptionDeleted(ctx context.Context, conn *quicksight.QuickS
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.AccountInfo); ok {
 		return out, err
 	}
@@ -441,7 +441,7 @@ func waitVPCConnectionCreated(ctx context.Context, conn *quicksight.QuickSight,
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*quicksight.VPCConnection); ok {
 		return output, err
 	}
@@ -462,7 +462,7 @@ func waitVPCConnectionUpdated(ctx context.Context, conn *quicksight.QuickSight,
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*quicksight.VPCConnection); ok {
 		return output, err
 	}
@@ -483,7 +483,7 @@ func waitVPCConnectionDeleted(ctx context.Context, conn *quicksight.QuickSight,
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*quicksight.VPCConnection); ok {
 		return output, err
 	}
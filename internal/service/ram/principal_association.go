@@ -29,6 +29,8 @@ import (
 )
 
 // @SDKResource("aws_ram_principal_association", name="Principal Association")
+// @IdentityAttribute("resource_share_arn")
+// @IdentityAttribute("principal")
 func resourcePrincipalAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePrincipalAssociationCreate,
@@ -216,7 +218,7 @@ func waitPrincipalAssociationCreated(ctx context.Context, conn *ram.RAM, resourc
 		NotFoundChecks: 20,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ram.ResourceShareAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -238,7 +240,7 @@ func waitPrincipalAssociationDeleted(ctx context.Context, conn *ram.RAM, resourc
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShareAssociation); ok {
 		return v, err
@@ -246,7 +246,7 @@ func waitResourceAssociationCreated(ctx context.Context, conn *ram.RAM, resource
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ram.ResourceShareAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -268,7 +268,7 @@ func waitResourceAssociationDeleted(ctx context.Context, conn *ram.RAM, resource
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ram.ResourceShareAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
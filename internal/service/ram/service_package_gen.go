@@ -40,6 +40,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourcePrincipalAssociation,
 			TypeName: "aws_ram_principal_association",
 			Name:     "Principal Association",
+			Identity: types.RegionalParameterizedIdentity([]types.IdentityAttribute{
+				types.StringIdentityAttribute("resource_share_arn", true),
+				types.StringIdentityAttribute(names.AttrPrincipal, true),
+			}),
 		},
 		{
 			Factory:  resourceResourceAssociation,
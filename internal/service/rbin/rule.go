@@ -211,7 +211,7 @@ func resourceRuleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	ruleArn := awsarn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   rbin.ServiceID,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("rule/%s", aws.ToString(out.Identifier)),
 	}.String()
@@ -308,7 +308,7 @@ func waitRuleCreated(ctx context.Context, conn *rbin.Client, id string, timeout
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rbin.GetRuleOutput); ok {
 		return out, err
 	}
@@ -326,7 +326,7 @@ func waitRuleUpdated(ctx context.Context, conn *rbin.Client, id string, timeout
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rbin.GetRuleOutput); ok {
 		return out, err
 	}
@@ -342,7 +342,7 @@ func waitRuleDeleted(ctx context.Context, conn *rbin.Client, id string, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rbin.GetRuleOutput); ok {
 		return out, err
 	}
@@ -177,7 +177,7 @@ func waitActivityStreamStarted(ctx context.Context, conn *rds.RDS, arn string) e
 		Delay:      30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -192,7 +192,7 @@ func waitActivityStreamStopped(ctx context.Context, conn *rds.RDS, arn string) e
 		Delay:      30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -292,7 +292,7 @@ func resourceClusterParameterGroupDelete(ctx context.Context, d *schema.Resource
 	}
 
 	log.Printf("[DEBUG] Deleting RDS DB Cluster Parameter Group: %s", d.Id())
-	err := retry.RetryContext(ctx, 3*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 3*time.Minute, func() *retry.RetryError {
 		_, err := conn.DeleteDBClusterParameterGroup(ctx, input)
 		if errs.IsA[*types.DBParameterGroupNotFoundFault](err) {
 			return nil
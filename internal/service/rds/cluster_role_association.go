@@ -70,7 +70,7 @@ func resourceClusterRoleAssociationCreate(ctx context.Context, d *schema.Resourc
 		RoleArn:             aws.String(roleARN),
 	}
 
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		var err error
 		_, err = conn.AddRoleToDBClusterWithContext(ctx, input)
 		if err != nil {
@@ -303,7 +303,7 @@ func waitDBClusterSnapshotCreated(ctx context.Context, conn *rds.RDS, id string,
 		Delay:      5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBClusterSnapshot); ok {
 		return output, err
@@ -356,7 +356,7 @@ func waitCustomDBEngineVersionCreated(ctx context.Context, conn *rds.RDS, id str
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rds.DBEngineVersion); ok {
 		return out, err
 	}
@@ -374,7 +374,7 @@ func waitCustomDBEngineVersionUpdated(ctx context.Context, conn *rds.RDS, id str
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rds.DBEngineVersion); ok {
 		return out, err
 	}
@@ -390,7 +390,7 @@ func waitCustomDBEngineVersionDeleted(ctx context.Context, conn *rds.RDS, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rds.DBEngineVersion); ok {
 		return out, err
 	}
@@ -56,7 +56,7 @@ func dataSourceEventCategoriesRead(ctx context.Context, d *schema.ResourceData,
 		eventCategories = append(eventCategories, aws.StringValueSlice(v.EventCategories)...)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set("event_categories", eventCategories)
 
 	return diags
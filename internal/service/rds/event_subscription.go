@@ -350,7 +350,7 @@ func waitEventSubscriptionCreated(ctx context.Context, conn *rds.Client, id stri
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.EventSubscription); ok {
 		return output, err
@@ -369,7 +369,7 @@ func waitEventSubscriptionDeleted(ctx context.Context, conn *rds.Client, id stri
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.EventSubscription); ok {
 		return output, err
@@ -389,7 +389,7 @@ func waitEventSubscriptionUpdated(ctx context.Context, conn *rds.Client, id stri
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.EventSubscription); ok {
 		return output, err
@@ -344,7 +344,7 @@ func resourceGlobalClusterDelete(ctx context.Context, d *schema.ResourceData, me
 	} else {
 		timeout = y
 	}
-	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
 		_, err := conn.DeleteGlobalClusterWithContext(ctx, input)
 
 		if tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidGlobalClusterStateFault, "is not empty") {
@@ -501,7 +501,7 @@ func waitGlobalClusterCreated(ctx context.Context, conn *rds.RDS, id string, tim
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -515,7 +515,7 @@ func waitGlobalClusterUpdated(ctx context.Context, conn *rds.RDS, id string, tim
 		Delay:   30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -529,7 +529,7 @@ func waitGlobalClusterDeleted(ctx context.Context, conn *rds.RDS, id string, tim
 		NotFoundChecks: 1,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -587,7 +587,7 @@ func globalClusterUpgradeMajorEngineVersion(ctx context.Context, meta interface{
 		GlobalClusterIdentifier:  aws.String(clusterID),
 	}
 
-	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
 		_, err := conn.ModifyGlobalClusterWithContext(ctx, input)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, rds.ErrCodeGlobalClusterNotFoundFault) {
@@ -687,7 +687,7 @@ func globalClusterUpgradeMinorEngineVersion(ctx context.Context, meta interface{
 
 		log.Printf("[INFO] Performing RDS Global Cluster (%s) Cluster (%s) minor version (%s) upgrade", clusterID, dbi, engineVersion)
 
-		err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		err = tfresource.Retry(ctx, timeout, func() *retry.RetryError {
 			_, err := useConn.ModifyDBClusterWithContext(ctx, modInput)
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
@@ -801,7 +801,7 @@ func WaitForClusterUpdate(ctx context.Context, conn *rds.RDS, id string, timeout
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
@@ -79,7 +79,7 @@ func resourceInstanceRoleAssociationCreate(ctx context.Context, d *schema.Resour
 		RoleArn:              aws.String(roleArn),
 	}
 
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
 		var err error
 		_, err = conn.AddRoleToDBInstanceWithContext(ctx, input)
 		if err != nil {
@@ -205,7 +205,7 @@ func waitForDBInstanceRoleAssociation(ctx context.Context, conn *rds.RDS, dbInst
 	}
 
 	log.Printf("[DEBUG] Waiting for RDS DB Instance (%s) IAM Role association: %s", dbInstanceIdentifier, roleArn)
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -222,7 +222,7 @@ func WaitForDBInstanceRoleDisassociation(ctx context.Context, conn *rds.RDS, dbI
 	}
 
 	log.Printf("[DEBUG] Waiting for RDS DB Instance (%s) IAM Role disassociation: %s", dbInstanceIdentifier, roleArn)
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
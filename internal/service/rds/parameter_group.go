@@ -320,7 +320,7 @@ func resourceParameterGroupDelete(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	log.Printf("[DEBUG] Deleting RDS DB Parameter Group: %s", d.Id())
-	err := retry.RetryContext(ctx, 3*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 3*time.Minute, func() *retry.RetryError {
 		_, err := conn.DeleteDBParameterGroup(ctx, input)
 		if errs.IsA[*types.DBParameterGroupNotFoundFault](err) {
 			return nil
@@ -240,7 +240,7 @@ func waitDefaultDBProxyTargetGroupAvailable(ctx context.Context, conn *rds.Clien
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBProxyTargetGroup); ok {
 		return output, err
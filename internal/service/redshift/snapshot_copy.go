@@ -217,6 +217,26 @@ func (r *resourceSnapshotCopy) ImportState(ctx context.Context, req resource.Imp
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrClusterIdentifier), req.ID)...)
 }
 
+func (r *resourceSnapshotCopy) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var region types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("destination_region"), &region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if region.IsNull() || region.IsUnknown() {
+		return
+	}
+
+	if err := r.Meta().VerifyRegionAllowed(ctx, region.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("destination_region"), "Invalid Region", err.Error())
+	}
+}
+
 func findSnapshotCopyByID(ctx context.Context, conn *redshift.Client, id string) (*awstypes.ClusterSnapshotCopyStatus, error) {
 	in := &redshift.DescribeClustersInput{
 		ClusterIdentifier: aws.String(id),
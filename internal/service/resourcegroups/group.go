@@ -365,7 +365,7 @@ func waitGroupConfigurationUpdated(ctx context.Context, conn *resourcegroups.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.GroupConfiguration); ok {
 		if status := output.Status; status == types.GroupConfigurationStatusUpdateFailed {
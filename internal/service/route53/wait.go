@@ -42,7 +42,7 @@ func waitChangeInfoStatusInsync(ctx context.Context, conn *route53.Route53, chan
 		Timeout:      changeTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53.ChangeInfo); ok {
 		return output, err
@@ -59,7 +59,7 @@ func waitHostedZoneDNSSECStatusUpdated(ctx context.Context, conn *route53.Route5
 		Timeout:    hostedZoneDNSSECStatusTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53.DNSSECStatus); ok {
 		if serveSignature := aws.StringValue(output.ServeSignature); serveSignature == ServeSignatureInternalFailure {
@@ -80,7 +80,7 @@ func waitKeySigningKeyStatusUpdated(ctx context.Context, conn *route53.Route53,
 		Timeout:    keySigningKeyStatusTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53.KeySigningKey); ok {
 		if status := aws.StringValue(output.Status); status == KeySigningKeyStatusInternalFailure {
@@ -101,7 +101,7 @@ func waitTrafficPolicyInstanceStateCreated(ctx context.Context, conn *route53.Ro
 		Timeout: trafficPolicyInstanceOperationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53.TrafficPolicyInstance); ok {
 		if state := aws.StringValue(output.State); state == TrafficPolicyInstanceStateFailed {
@@ -122,7 +122,7 @@ func waitTrafficPolicyInstanceStateDeleted(ctx context.Context, conn *route53.Ro
 		Timeout: trafficPolicyInstanceOperationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53.TrafficPolicyInstance); ok {
 		if state := aws.StringValue(output.State); state == TrafficPolicyInstanceStateFailed {
@@ -143,7 +143,7 @@ func waitTrafficPolicyInstanceStateUpdated(ctx context.Context, conn *route53.Ro
 		Timeout: trafficPolicyInstanceOperationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53.TrafficPolicyInstance); ok {
 		if state := aws.StringValue(output.State); state == TrafficPolicyInstanceStateFailed {
@@ -145,7 +145,7 @@ func resourceZoneCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	// Private Route53 Hosted Zones can only be created with their first VPC association,
 	// however we need to associate the remaining after creation.
-	vpcs := expandVPCs(d.Get("vpc").(*schema.Set).List(), meta.(*conns.AWSClient).Region)
+	vpcs := expandVPCs(d.Get("vpc").(*schema.Set).List(), meta.(*conns.AWSClient).Region(ctx))
 
 	if len(vpcs) > 0 {
 		input.VPC = vpcs[0]
@@ -248,7 +248,7 @@ func resourceZoneRead(ctx context.Context, d *schema.ResourceData, meta interfac
 func resourceZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).Region(ctx)
 
 	if d.HasChange("comment") {
 		input := route53.UpdateHostedZoneCommentInput{
@@ -718,7 +718,7 @@ func waitForChangeSynchronization(ctx context.Context, conn *route53.Route53, ch
 		},
 	}
 
-	_, err := conf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &conf)
 
 	return err
 }
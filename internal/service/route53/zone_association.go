@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -63,7 +64,7 @@ func resourceZoneAssociationCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
 
-	vpcRegion := meta.(*conns.AWSClient).Region
+	vpcRegion := meta.(*conns.AWSClient).Region(ctx)
 	vpcID := d.Get(names.AttrVPCID).(string)
 	zoneID := d.Get("zone_id").(string)
 
@@ -98,7 +99,7 @@ func resourceZoneAssociationCreate(ctx context.Context, d *schema.ResourceData,
 			Refresh:    resourceZoneAssociationRefreshFunc(ctx, conn, CleanChangeID(aws.StringValue(output.ChangeInfo.Id)), d.Id()),
 		}
 
-		if _, err := wait.WaitForStateContext(ctx); err != nil {
+		if _, err := tfresource.WaitForStateContext(ctx, &wait); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Zone Association (%s) synchronization: %s", d.Id(), err)
 		}
 	}
@@ -122,7 +123,7 @@ func resourceZoneAssociationRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if vpcRegion == "" {
-		vpcRegion = meta.(*conns.AWSClient).Region
+		vpcRegion = meta.(*conns.AWSClient).Region(ctx)
 	}
 
 	hostedZoneSummary, err := GetZoneAssociation(ctx, conn, zoneID, vpcID, vpcRegion)
@@ -171,7 +172,7 @@ func resourceZoneAssociationDelete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if vpcRegion == "" {
-		vpcRegion = meta.(*conns.AWSClient).Region
+		vpcRegion = meta.(*conns.AWSClient).Region(ctx)
 	}
 
 	input := &route53.DisassociateVPCFromHostedZoneInput{
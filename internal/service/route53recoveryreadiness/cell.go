@@ -166,7 +166,7 @@ func resourceCellDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	gcinput := &route53recoveryreadiness.GetCellInput{
 		CellName: aws.String(d.Id()),
 	}
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+	err = tfresource.Retry(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		_, err := conn.GetCellWithContext(ctx, gcinput)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, route53recoveryreadiness.ErrCodeResourceNotFoundException) {
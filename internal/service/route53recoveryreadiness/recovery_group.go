@@ -157,7 +157,7 @@ func resourceRecoveryGroupDelete(ctx context.Context, d *schema.ResourceData, me
 		RecoveryGroupName: aws.String(d.Id()),
 	}
 
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+	err = tfresource.Retry(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		_, err := conn.GetRecoveryGroupWithContext(ctx, gcinput)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, route53recoveryreadiness.ErrCodeResourceNotFoundException) {
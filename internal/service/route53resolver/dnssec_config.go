@@ -97,7 +97,7 @@ func resourceDNSSECConfigRead(ctx context.Context, d *schema.ResourceData, meta
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "route53resolver",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
 		AccountID: ownerID,
 		Resource:  fmt.Sprintf("resolver-dnssec-config/%s", resourceID),
 	}.String()
@@ -202,7 +202,7 @@ func waitDNSSECConfigCreated(ctx context.Context, conn *route53resolver.Route53R
 		Timeout: dnssecConfigCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverDnssecConfig); ok {
 		return output, err
@@ -219,7 +219,7 @@ func waitDNSSECConfigDeleted(ctx context.Context, conn *route53resolver.Route53R
 		Timeout: dnssecConfigDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverDnssecConfig); ok {
 		return output, err
@@ -239,7 +239,7 @@ func waitFirewallRuleGroupAssociationCreated(ctx context.Context, conn *route53r
 		Timeout: firewallRuleGroupAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.FirewallRuleGroupAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -258,7 +258,7 @@ func waitFirewallRuleGroupAssociationUpdated(ctx context.Context, conn *route53r
 		Timeout: firewallRuleGroupAssociationUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.FirewallRuleGroupAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -277,7 +277,7 @@ func waitFirewallRuleGroupAssociationDeleted(ctx context.Context, conn *route53r
 		Timeout: firewallRuleGroupAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.FirewallRuleGroupAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
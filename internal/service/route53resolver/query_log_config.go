@@ -201,7 +201,7 @@ func waitQueryLogConfigCreated(ctx context.Context, conn *route53resolver.Route5
 		Timeout: queryLogConfigCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverQueryLogConfig); ok {
 		return output, err
@@ -218,7 +218,7 @@ func waitQueryLogConfigDeleted(ctx context.Context, conn *route53resolver.Route5
 		Timeout: queryLogConfigDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*route53resolver.ResolverQueryLogConfig); ok {
 		return output, err
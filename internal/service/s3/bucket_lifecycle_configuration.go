@@ -308,7 +308,7 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 	)
 	var lastOutput, output []types.LifecycleRule
 
-	err = retry.RetryContext(ctx, lifecycleConfigurationRulesSteadyTimeout, func() *retry.RetryError {
+	err = tfresource.Retry(ctx, lifecycleConfigurationRulesSteadyTimeout, func() *retry.RetryError {
 		var err error
 
 		time.Sleep(lifecycleConfigurationExtraRetryDelay)
@@ -520,7 +520,7 @@ func waitLifecycleRulesEquals(ctx context.Context, conn *s3.Client, bucket, expe
 		NotFoundChecks:            20,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]types.LifecycleRule); ok {
 		return output, err
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_s3_bucket", name="Bucket")
+func newBucketListResource() inttypes.ListResourceForSDK {
+	return &bucketListResource{}
+}
+
+type bucketListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+}
+
+type bucketListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
+
+func (l *bucketListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_bucket"
+}
+
+func (l *bucketListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list buckets whose name begins with this prefix.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only list buckets with all of these tags.",
+			},
+		},
+	}
+}
+
+func (l *bucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data bucketListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.S3Client(ctx)
+	region := awsClient.Region(ctx)
+
+	filter := framework.NewListFilter(ctx, data.NamePrefix, data.Tags)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		// ListBuckets returns all general purpose buckets owned by the caller, in all Regions.
+		output, err := conn.ListBuckets(ctx, &s3.ListBucketsInput{})
+
+		if err != nil {
+			yield(framework.NewListResultErrorDiagnostic("listing S3 Buckets", err))
+			return
+		}
+
+		for _, bucket := range output.Buckets {
+			name := aws.ToString(bucket.Name)
+
+			if !filter.MatchName(name) {
+				continue
+			}
+
+			bucketRegion, err := findBucketRegion(ctx, awsClient, name)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				yield(framework.NewListResultErrorDiagnostic("listing S3 Buckets", err))
+				return
+			}
+
+			if bucketRegion != region {
+				continue
+			}
+
+			if filter.HasTags() {
+				bucketTags, err := bucketListTags(ctx, conn, name)
+
+				if err != nil {
+					yield(framework.NewListResultErrorDiagnostic("listing S3 Buckets", err))
+					return
+				}
+
+				if !filter.MatchTags(bucketTags) {
+					continue
+				}
+			}
+
+			d := l.ResourceData()
+			d.SetId(name)
+			d.Set(names.AttrBucket, name)
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = name
+
+			if !l.SetResult(ctx, awsClient, request.IncludeResource, &result, d) {
+				continue
+			}
+
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
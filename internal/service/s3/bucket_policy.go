@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -52,6 +53,8 @@ func resourceBucketPolicy() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: tfiam.PolicyLintCustomizeDiff(names.AttrPolicy, tfiam.PolicyTypeResource),
 	}
 }
 
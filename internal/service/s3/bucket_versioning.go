@@ -350,7 +350,7 @@ func waitForBucketVersioningStatus(ctx context.Context, conn *s3.Client, bucket,
 		Delay:                     1 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*s3.GetBucketVersioningOutput); ok {
 		return output, err
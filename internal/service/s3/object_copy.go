@@ -645,7 +645,7 @@ func resourceObjectCopyDoCopy(ctx context.Context, d *schema.ResourceData, meta
 		input.TaggingDirective = types.TaggingDirective(v.(string))
 	}
 
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := tftags.DefaultConfigFromContext(ctx, meta.(*conns.AWSClient).DefaultTagsConfig)
 	tags := tftags.New(ctx, getContextTags(ctx))
 	tags = defaultTagsConfig.MergeTags(tags)
 	if len(tags) > 0 {
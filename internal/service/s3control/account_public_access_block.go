@@ -225,7 +225,7 @@ func waitPublicAccessBlockEqual(ctx context.Context, conn *s3control.Client, acc
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.PublicAccessBlockConfiguration); ok {
 		return output, err
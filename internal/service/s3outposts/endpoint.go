@@ -275,7 +275,7 @@ func waitEndpointStatusCreated(ctx context.Context, conn *s3outposts.S3Outposts,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*s3outposts.Endpoint); ok {
 		if failedReason := output.FailedReason; failedReason != nil {
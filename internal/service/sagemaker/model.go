@@ -470,7 +470,7 @@ func resourceModelDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	log.Printf("[INFO] Deleting SageMaker model: %s", d.Id())
 
-	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 5*time.Minute, func() *retry.RetryError {
 		_, err := conn.DeleteModelWithContext(ctx, deleteOpts)
 		if err == nil {
 			return nil
@@ -411,7 +411,7 @@ func StartNotebookInstance(ctx context.Context, conn *sagemaker.SageMaker, id st
 	}
 	// StartNotebookInstance sometimes doesn't take so we'll check for a state change and if
 	// it doesn't change we'll send another request
-	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 5*time.Minute, func() *retry.RetryError {
 		_, err := conn.StartNotebookInstanceWithContext(ctx, startOpts)
 		if err != nil {
 			return retry.NonRetryableError(fmt.Errorf("starting: %s", err))
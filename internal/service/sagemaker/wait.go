@@ -58,7 +58,7 @@ func WaitNotebookInstanceInService(ctx context.Context, conn *sagemaker.SageMake
 		Timeout: NotebookInstanceInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		if status := aws.StringValue(output.NotebookInstanceStatus); status == sagemaker.NotebookInstanceStatusFailed {
@@ -84,7 +84,7 @@ func WaitNotebookInstanceStarted(ctx context.Context, conn *sagemaker.SageMaker,
 		Timeout: 30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		if status := aws.StringValue(output.NotebookInstanceStatus); status == sagemaker.NotebookInstanceStatusFailed {
@@ -109,7 +109,7 @@ func WaitNotebookInstanceStopped(ctx context.Context, conn *sagemaker.SageMaker,
 		Timeout: NotebookInstanceStoppedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		if status := aws.StringValue(output.NotebookInstanceStatus); status == sagemaker.NotebookInstanceStatusFailed {
@@ -133,7 +133,7 @@ func WaitNotebookInstanceDeleted(ctx context.Context, conn *sagemaker.SageMaker,
 		Timeout: NotebookInstanceDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		if status := aws.StringValue(output.NotebookInstanceStatus); status == sagemaker.NotebookInstanceStatusFailed {
@@ -158,7 +158,7 @@ func WaitModelPackageGroupCompleted(ctx context.Context, conn *sagemaker.SageMak
 		Timeout: ModelPackageGroupCompletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeModelPackageGroupOutput); ok {
 		return output, err
@@ -178,7 +178,7 @@ func WaitModelPackageGroupDeleted(ctx context.Context, conn *sagemaker.SageMaker
 		Timeout: ModelPackageGroupDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeModelPackageGroupOutput); ok {
 		return output, err
@@ -199,7 +199,7 @@ func WaitImageCreated(ctx context.Context, conn *sagemaker.SageMaker, name strin
 		Timeout: ImageCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageOutput); ok {
 		return output, err
@@ -217,7 +217,7 @@ func WaitImageDeleted(ctx context.Context, conn *sagemaker.SageMaker, name strin
 		Timeout: ImageDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageOutput); ok {
 		return output, err
@@ -237,7 +237,7 @@ func WaitImageVersionCreated(ctx context.Context, conn *sagemaker.SageMaker, nam
 		Timeout: ImageVersionCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageVersionOutput); ok {
 		return output, err
@@ -255,7 +255,7 @@ func WaitImageVersionDeleted(ctx context.Context, conn *sagemaker.SageMaker, nam
 		Timeout: ImageVersionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageVersionOutput); ok {
 		return output, err
@@ -276,7 +276,7 @@ func WaitDomainInService(ctx context.Context, conn *sagemaker.SageMaker, domainI
 		Timeout: DomainInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeDomainOutput); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.DomainStatusFailed || status == sagemaker.DomainStatusUpdateFailed && reason != "" {
@@ -300,7 +300,7 @@ func WaitDomainDeleted(ctx context.Context, conn *sagemaker.SageMaker, domainID
 		Timeout: DomainDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeDomainOutput); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.DomainStatusDeleteFailed && reason != "" {
@@ -322,7 +322,7 @@ func WaitFeatureGroupCreated(ctx context.Context, conn *sagemaker.SageMaker, nam
 		Timeout: FeatureGroupCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFeatureGroupOutput); ok {
 		if status, reason := aws.StringValue(output.FeatureGroupStatus), aws.StringValue(output.FailureReason); status == sagemaker.FeatureGroupStatusCreateFailed && reason != "" {
@@ -344,7 +344,7 @@ func WaitFeatureGroupDeleted(ctx context.Context, conn *sagemaker.SageMaker, nam
 		Timeout: FeatureGroupDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFeatureGroupOutput); ok {
 		if status, reason := aws.StringValue(output.FeatureGroupStatus), aws.StringValue(output.FailureReason); status == sagemaker.FeatureGroupStatusDeleteFailed && reason != "" {
@@ -369,7 +369,7 @@ func WaitUserProfileInService(ctx context.Context, conn *sagemaker.SageMaker, do
 		Timeout: UserProfileInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeUserProfileOutput); ok {
 		return output, err
@@ -397,7 +397,7 @@ func WaitUserProfileDeleted(ctx context.Context, conn *sagemaker.SageMaker, doma
 		Timeout: UserProfileDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeUserProfileOutput); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.UserProfileStatusDeleteFailed && reason != "" {
@@ -419,7 +419,7 @@ func WaitAppInService(ctx context.Context, conn *sagemaker.SageMaker, domainID,
 		Timeout: AppInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeAppOutput); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.AppStatusFailed && reason != "" {
@@ -443,7 +443,7 @@ func WaitAppDeleted(ctx context.Context, conn *sagemaker.SageMaker, domainID, us
 		Timeout: AppDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeAppOutput); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.AppStatusFailed && reason != "" {
@@ -465,7 +465,7 @@ func WaitFlowDefinitionActive(ctx context.Context, conn *sagemaker.SageMaker, na
 		Timeout: FlowDefinitionActiveTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFlowDefinitionOutput); ok {
 		if status, reason := aws.StringValue(output.FlowDefinitionStatus), aws.StringValue(output.FailureReason); status == sagemaker.FlowDefinitionStatusFailed && reason != "" {
@@ -487,7 +487,7 @@ func WaitFlowDefinitionDeleted(ctx context.Context, conn *sagemaker.SageMaker, n
 		Timeout: FlowDefinitionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFlowDefinitionOutput); ok {
 		if status, reason := aws.StringValue(output.FlowDefinitionStatus), aws.StringValue(output.FailureReason); status == sagemaker.FlowDefinitionStatusFailed && reason != "" {
@@ -509,7 +509,7 @@ func WaitProjectDeleted(ctx context.Context, conn *sagemaker.SageMaker, name str
 		Timeout: ProjectDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeProjectOutput); ok {
 		if status, reason := aws.StringValue(output.ProjectStatus), aws.StringValue(output.ServiceCatalogProvisionedProductDetails.ProvisionedProductStatusMessage); status == sagemaker.ProjectStatusDeleteFailed && reason != "" {
@@ -531,7 +531,7 @@ func WaitProjectCreated(ctx context.Context, conn *sagemaker.SageMaker, name str
 		Timeout: ProjectCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeProjectOutput); ok {
 		if status, reason := aws.StringValue(output.ProjectStatus), aws.StringValue(output.ServiceCatalogProvisionedProductDetails.ProvisionedProductStatusMessage); status == sagemaker.ProjectStatusCreateFailed && reason != "" {
@@ -553,7 +553,7 @@ func WaitProjectUpdated(ctx context.Context, conn *sagemaker.SageMaker, name str
 		Timeout: ProjectCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeProjectOutput); ok {
 		if status, reason := aws.StringValue(output.ProjectStatus), aws.StringValue(output.ServiceCatalogProvisionedProductDetails.ProvisionedProductStatusMessage); status == sagemaker.ProjectStatusUpdateFailed && reason != "" {
@@ -574,7 +574,7 @@ func WaitWorkforceActive(ctx context.Context, conn *sagemaker.SageMaker, name st
 		Timeout: WorkforceActiveTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.Workforce); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.WorkforceStatusFailed && reason != "" {
@@ -595,7 +595,7 @@ func WaitWorkforceDeleted(ctx context.Context, conn *sagemaker.SageMaker, name s
 		Timeout: WorkforceDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.Workforce); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.WorkforceStatusFailed && reason != "" {
@@ -616,7 +616,7 @@ func WaitSpaceInService(ctx context.Context, conn *sagemaker.SageMaker, domainId
 		Timeout: SpaceInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeSpaceOutput); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.SpaceStatusUpdateFailed && reason != "" {
@@ -637,7 +637,7 @@ func WaitSpaceDeleted(ctx context.Context, conn *sagemaker.SageMaker, domainId,
 		Timeout: SpaceDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeSpaceOutput); ok {
 		if status, reason := aws.StringValue(output.Status), aws.StringValue(output.FailureReason); status == sagemaker.SpaceStatusDeleteFailed && reason != "" {
@@ -658,7 +658,7 @@ func WaitMonitoringScheduleScheduled(ctx context.Context, conn *sagemaker.SageMa
 		Timeout: MonitoringScheduleScheduledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeMonitoringScheduleOutput); ok {
 		if status, reason := aws.StringValue(output.MonitoringScheduleStatus), aws.StringValue(output.FailureReason); status == sagemaker.ScheduleStatusFailed && reason != "" {
@@ -679,7 +679,7 @@ func WaitMonitoringScheduleNotFound(ctx context.Context, conn *sagemaker.SageMak
 		Timeout: MonitoringScheduleStoppedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeMonitoringScheduleOutput); ok {
 		if status, reason := aws.StringValue(output.MonitoringScheduleStatus), aws.StringValue(output.FailureReason); status == sagemaker.ScheduleStatusFailed && reason != "" {
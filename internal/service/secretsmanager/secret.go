@@ -34,6 +34,7 @@ import (
 
 // @SDKResource("aws_secretsmanager_secret", name="Secret")
 // @Tags(identifierAttribute="id")
+// @IAMActions(create="secretsmanager:CreateSecret;secretsmanager:DescribeSecret;secretsmanager:GetResourcePolicy;secretsmanager:PutResourcePolicy", read="secretsmanager:DescribeSecret;secretsmanager:GetResourcePolicy", update="secretsmanager:DeleteResourcePolicy;secretsmanager:PutResourcePolicy;secretsmanager:RemoveRegionsFromReplication;secretsmanager:ReplicateSecretToRegions;secretsmanager:TagResource;secretsmanager:UntagResource;secretsmanager:UpdateSecret", delete="secretsmanager:DeleteSecret;secretsmanager:DescribeSecret")
 func resourceSecret() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSecretCreate,
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerSecretVersionEphemeral_string(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := acctest.EphemeralValuesDataSourceName
+	resourceName := "aws_secretsmanager_secret_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		TerraformVersionChecks:   acctest.EphemeralResourceTerraformVersionChecks,
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					testAccSecretVersionConfig_string(rName),
+					testAccSecretVersionEphemeralConfig_basic(),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.secret_binary_null", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.secret_string", "test-string"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.version_id", resourceName, "version_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretVersionEphemeral_binary(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := acctest.EphemeralValuesDataSourceName
+	resourceName := "aws_secretsmanager_secret_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		TerraformVersionChecks:   acctest.EphemeralResourceTerraformVersionChecks,
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					testAccSecretVersionConfig_binary(rName),
+					testAccSecretVersionEphemeralConfig_basic(),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.secret_binary", "test-binary"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.secret_string_null", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.version_id", resourceName, "version_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecretVersionEphemeralConfig_basic() string {
+	return acctest.ConfigCompose(
+		acctest.ConfigEphemeralValues(map[string]string{
+			"arn":                "ephemeral.aws_secretsmanager_secret_version.test.arn",
+			"secret_binary":      `coalesce(ephemeral.aws_secretsmanager_secret_version.test.secret_binary, "-")`,
+			"secret_binary_null": "ephemeral.aws_secretsmanager_secret_version.test.secret_binary == null",
+			"secret_string":      `coalesce(ephemeral.aws_secretsmanager_secret_version.test.secret_string, "-")`,
+			"secret_string_null": "ephemeral.aws_secretsmanager_secret_version.test.secret_string == null",
+			"version_id":         "ephemeral.aws_secretsmanager_secret_version.test.version_id",
+		}),
+		`
+ephemeral "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret_version.test.secret_id
+}
+`)
+}
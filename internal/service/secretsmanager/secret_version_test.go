@@ -187,6 +187,43 @@ func TestAccSecretsManagerSecretVersion_versionStagesExternalUpdate(t *testing.T
 	})
 }
 
+func TestAccSecretsManagerSecretVersion_stringWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var version secretsmanager.GetSecretValueOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		TerraformVersionChecks:   acctest.WriteOnlyAttributeTerraformVersionChecks,
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionConfig_stringWriteOnly(rName, "test-string", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecretVersionExists(ctx, resourceName, &version),
+					testAccCheckSecretVersionSecretString(&version, "test-string"),
+					resource.TestCheckResourceAttr(resourceName, "secret_string", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_string_wo"),
+					resource.TestCheckResourceAttr(resourceName, "secret_string_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccSecretVersionConfig_stringWriteOnly(rName, "test-string-updated", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecretVersionExists(ctx, resourceName, &version),
+					testAccCheckSecretVersionSecretString(&version, "test-string-updated"),
+					resource.TestCheckResourceAttr(resourceName, "secret_string", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_string_wo"),
+					resource.TestCheckResourceAttr(resourceName, "secret_string_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSecretsManagerSecretVersion_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var version secretsmanager.GetSecretValueOutput
@@ -322,6 +359,16 @@ func testAccCheckSecretVersionExists(ctx context.Context, n string, v *secretsma
 	}
 }
 
+func testAccCheckSecretVersionSecretString(v *secretsmanager.GetSecretValueOutput, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.ToString(v.SecretString); got != want {
+			return fmt.Errorf("Secrets Manager Secret Version secret string = %q, want %q", got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccSecretVersionConfig_string(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_secretsmanager_secret" "test" {
@@ -335,6 +382,20 @@ resource "aws_secretsmanager_secret_version" "test" {
 `, rName)
 }
 
+func testAccSecretVersionConfig_stringWriteOnly(rName, secretString string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id                = aws_secretsmanager_secret.test.id
+  secret_string_wo         = %[2]q
+  secret_string_wo_version = %[3]d
+}
+`, rName, secretString, version)
+}
+
 func testAccSecretVersionConfig_binary(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_secretsmanager_secret" "test" {
@@ -465,7 +465,7 @@ func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
 		return output, err
@@ -482,7 +482,7 @@ func waitSubscriberUpdated(ctx context.Context, conn *securitylake.Client, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
 		return output, err
@@ -499,7 +499,7 @@ func waitSubscriberDeleted(ctx context.Context, conn *securitylake.Client, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
 		return output, err
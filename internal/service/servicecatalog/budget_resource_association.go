@@ -59,7 +59,7 @@ func resourceBudgetResourceAssociationCreate(ctx context.Context, d *schema.Reso
 	}
 
 	var output *servicecatalog.AssociateBudgetWithResourceOutput
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		var err error
 
 		output, err = conn.AssociateBudgetWithResourceWithContext(ctx, input)
@@ -107,7 +107,7 @@ func resourceConstraintCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	var output *servicecatalog.CreateConstraintOutput
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		var err error
 
 		output, err = conn.CreateConstraintWithContext(ctx, input)
@@ -206,7 +206,7 @@ func resourceConstraintUpdate(ctx context.Context, d *schema.ResourceData, meta
 		input.Parameters = aws.String(d.Get(names.AttrParameters).(string))
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, err := conn.UpdateConstraintWithContext(ctx, input)
 
 		if tfawserr.ErrMessageContains(err, servicecatalog.ErrCodeInvalidParametersException, "profile does not exist") {
@@ -137,7 +137,7 @@ func resourceProvisioningArtifactCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	var output *servicecatalog.CreateProvisioningArtifactOutput
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		var err error
 
 		output, err = conn.CreateProvisioningArtifactWithContext(ctx, input)
@@ -256,7 +256,7 @@ func resourceProvisioningArtifactUpdate(ctx context.Context, d *schema.ResourceD
 			input.Name = aws.String(v.(string))
 		}
 
-		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+		err = tfresource.Retry(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 			_, err := conn.UpdateProvisioningArtifactWithContext(ctx, input)
 
 			if tfawserr.ErrMessageContains(err, servicecatalog.ErrCodeInvalidParametersException, "profile does not exist") {
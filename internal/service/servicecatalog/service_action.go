@@ -113,7 +113,7 @@ func resourceServiceActionCreate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	var output *servicecatalog.CreateServiceActionOutput
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		var err error
 
 		output, err = conn.CreateServiceActionWithContext(ctx, input)
@@ -204,7 +204,7 @@ func resourceServiceActionUpdate(ctx context.Context, d *schema.ResourceData, me
 		input.Name = aws.String(d.Get(names.AttrName).(string))
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, err := conn.UpdateServiceActionWithContext(ctx, input)
 
 		if tfawserr.ErrMessageContains(err, servicecatalog.ErrCodeInvalidParametersException, "profile does not exist") {
@@ -237,7 +237,7 @@ func resourceServiceActionDelete(ctx context.Context, d *schema.ResourceData, me
 		Id: aws.String(d.Id()),
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		_, err := conn.DeleteServiceActionWithContext(ctx, input)
 
 		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceInUseException) {
@@ -70,7 +70,7 @@ func resourceTagOptionCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	var output *servicecatalog.CreateTagOptionOutput
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		var err error
 
 		output, err = conn.CreateTagOptionWithContext(ctx, input)
@@ -164,7 +164,7 @@ func resourceTagOptionUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		input.Value = aws.String(d.Get(names.AttrValue).(string))
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, err := conn.UpdateTagOptionWithContext(ctx, input)
 
 		if tfawserr.ErrMessageContains(err, servicecatalog.ErrCodeInvalidParametersException, "profile does not exist") {
@@ -77,7 +77,7 @@ func resourceTagOptionResourceAssociationCreate(ctx context.Context, d *schema.R
 	}
 
 	var output *servicecatalog.AssociateTagOptionWithResourceOutput
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		var err error
 
 		output, err = conn.AssociateTagOptionWithResourceWithContext(ctx, input)
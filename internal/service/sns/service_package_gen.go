@@ -48,6 +48,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			IAMActions: &types.ServicePackageResourceIAMActions{
+				Create: []string{"sns:CreateTopic", "sns:GetTopicAttributes", "sns:ListTagsForResource", "sns:SetTopicAttributes", "sns:TagResource"},
+				Read:   []string{"sns:GetTopicAttributes", "sns:ListTagsForResource"},
+				Update: []string{"sns:SetTopicAttributes", "sns:TagResource", "sns:UntagResource"},
+				Delete: []string{"sns:DeleteTopic"},
+			},
 		},
 		{
 			Factory:  resourceTopicDataProtectionPolicy,
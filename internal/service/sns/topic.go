@@ -240,6 +240,7 @@ var (
 
 // @SDKResource("aws_sns_topic", name="Topic")
 // @Tags(identifierAttribute="id")
+// @IAMActions(create="sns:CreateTopic;sns:GetTopicAttributes;sns:ListTagsForResource;sns:SetTopicAttributes;sns:TagResource", read="sns:GetTopicAttributes;sns:ListTagsForResource", update="sns:SetTopicAttributes;sns:TagResource;sns:UntagResource", delete="sns:DeleteTopic")
 func resourceTopic() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTopicCreate,
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -57,6 +58,8 @@ func resourceTopicPolicy() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: tfiam.PolicyLintCustomizeDiff(names.AttrPolicy, tfiam.PolicyTypeResource),
 	}
 }
 
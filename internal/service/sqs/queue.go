@@ -189,6 +189,7 @@ var (
 
 // @SDKResource("aws_sqs_queue", name="Queue")
 // @Tags(identifierAttribute="id")
+// @IAMActions(create="sqs:CreateQueue;sqs:GetQueueAttributes;sqs:ListQueueTags;sqs:TagQueue", read="sqs:GetQueueAttributes;sqs:ListQueueTags", update="sqs:SetQueueAttributes;sqs:TagQueue;sqs:UntagQueue", delete="sqs:DeleteQueue;sqs:GetQueueAttributes")
 func resourceQueue() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceQueueCreate,
@@ -580,7 +581,7 @@ func waitQueueAttributesPropagated(ctx context.Context, conn *sqs.Client, url st
 		NotFoundChecks:            10,              // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -596,7 +597,7 @@ func waitQueueDeleted(ctx context.Context, conn *sqs.Client, url string) error {
 		NotFoundChecks:            5,               // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -44,6 +44,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			IAMActions: &types.ServicePackageResourceIAMActions{
+				Create: []string{"sqs:CreateQueue", "sqs:GetQueueAttributes", "sqs:ListQueueTags", "sqs:TagQueue"},
+				Read:   []string{"sqs:GetQueueAttributes", "sqs:ListQueueTags"},
+				Update: []string{"sqs:SetQueueAttributes", "sqs:TagQueue", "sqs:UntagQueue"},
+				Delete: []string{"sqs:DeleteQueue", "sqs:GetQueueAttributes"},
+			},
 		},
 		{
 			Factory:  resourceQueuePolicy,
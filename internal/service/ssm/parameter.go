@@ -32,6 +32,7 @@ const (
 
 // @SDKResource("aws_ssm_parameter", name="Parameter")
 // @Tags(identifierAttribute="id", resourceType="Parameter")
+// @IAMActions(create="ssm:AddTagsToResource;ssm:DescribeParameters;ssm:GetParameter;ssm:ListTagsForResource;ssm:PutParameter", read="ssm:DescribeParameters;ssm:GetParameter;ssm:ListTagsForResource", update="ssm:AddTagsToResource;ssm:PutParameter;ssm:RemoveTagsFromResource", delete="ssm:DeleteParameter")
 func ResourceParameter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceParameterCreate,
@@ -224,7 +225,7 @@ func resourceParameterRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	var resp *ssm.GetParameterOutput
-	err := retry.RetryContext(ctx, parameterCreationValidationTimeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, parameterCreationValidationTimeout, func() *retry.RetryError {
 		var err error
 		resp, err = conn.GetParameterWithContext(ctx, input)
 
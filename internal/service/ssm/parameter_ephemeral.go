@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(name="Parameter")
+func newParameterEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &parameterEphemeralResource{}, nil
+}
+
+type parameterEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (r *parameterEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_ssm_parameter"
+}
+
+func (r *parameterEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrValue: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrVersion: schema.Int64Attribute{
+				Computed: true,
+			},
+			"with_decryption": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to return decrypted SecureString values. Defaults to true.",
+			},
+		},
+	}
+}
+
+func (r *parameterEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data parameterEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMClient(ctx)
+
+	name := data.Name.ValueString()
+	withDecryption := true
+	if !data.WithDecryption.IsNull() && !data.WithDecryption.IsUnknown() {
+		withDecryption = data.WithDecryption.ValueBool()
+	}
+
+	output, err := findParameterByNameV2(ctx, conn, name, withDecryption)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSM Parameter (%s)", name), err.Error())
+
+		return
+	}
+
+	// Name is left as configured, which may be the parameter's ARN.
+	data.ARN = flex.StringToFramework(ctx, output.ARN)
+	data.Type = flex.StringValueToFramework(ctx, output.Type)
+	data.Value = flex.StringToFramework(ctx, output.Value)
+	data.Version = types.Int64Value(output.Version)
+	data.WithDecryption = types.BoolValue(withDecryption)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type parameterEphemeralResourceModel struct {
+	ARN            types.String `tfsdk:"arn"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Value          types.String `tfsdk:"value"`
+	Version        types.Int64  `tfsdk:"version"`
+	WithDecryption types.Bool   `tfsdk:"with_decryption"`
+}
+
+func findParameterByNameV2(ctx context.Context, conn *ssm.Client, name string, withDecryption bool) (*awstypes.Parameter, error) {
+	input := &ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(withDecryption),
+	}
+
+	output, err := conn.GetParameter(ctx, input)
+
+	if errs.IsA[*awstypes.ParameterNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Parameter == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Parameter, nil
+}
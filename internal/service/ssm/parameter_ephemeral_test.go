@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMParameterEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := acctest.EphemeralValuesDataSourceName
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		TerraformVersionChecks:   acctest.EphemeralResourceTerraformVersionChecks,
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterEphemeralConfig_basic(rName, "id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.type", "SecureString"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.value", "TestValue"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.version", resourceName, names.AttrVersion),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.with_decryption", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameterEphemeral_arn(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := acctest.EphemeralValuesDataSourceName
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		TerraformVersionChecks:   acctest.EphemeralResourceTerraformVersionChecks,
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterEphemeralConfig_basic(rName, names.AttrARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.arn", resourceName, names.AttrARN),
+					// The configured ARN is not replaced by the parameter's name.
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.name", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.value", "TestValue"),
+				),
+			},
+		},
+	})
+}
+
+func testAccParameterEphemeralConfig_basic(rName, nameAttribute string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigEphemeralValues(map[string]string{
+			"arn":             "ephemeral.aws_ssm_parameter.test.arn",
+			"name":            "ephemeral.aws_ssm_parameter.test.name",
+			"type":            "ephemeral.aws_ssm_parameter.test.type",
+			"value":           "ephemeral.aws_ssm_parameter.test.value",
+			"version":         "ephemeral.aws_ssm_parameter.test.version",
+			"with_decryption": "ephemeral.aws_ssm_parameter.test.with_decryption",
+		}),
+		fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "SecureString"
+  value = "TestValue"
+}
+
+ephemeral "aws_ssm_parameter" "test" {
+  name = aws_ssm_parameter.test.%[2]s
+}
+`, rName, nameAttribute))
+}
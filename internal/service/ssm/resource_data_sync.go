@@ -88,7 +88,7 @@ func resourceResourceDataSyncCreate(ctx context.Context, d *schema.ResourceData,
 		SyncName:      aws.String(name),
 	}
 
-	err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 1*time.Minute, func() *retry.RetryError {
 		_, err := conn.CreateResourceDataSyncWithContext(ctx, input)
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, ssm.ErrCodeResourceDataSyncInvalidConfigurationException, "S3 write failed for bucket") {
@@ -17,6 +17,15 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.ServicePackageEphemeralResource {
+	return []*types.ServicePackageEphemeralResource{
+		{
+			Factory: newParameterEphemeralResource,
+			Name:    "Parameter",
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{}
 }
@@ -105,6 +114,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "Parameter",
 			},
+			IAMActions: &types.ServicePackageResourceIAMActions{
+				Create: []string{"ssm:AddTagsToResource", "ssm:DescribeParameters", "ssm:GetParameter", "ssm:ListTagsForResource", "ssm:PutParameter"},
+				Read:   []string{"ssm:DescribeParameters", "ssm:GetParameter", "ssm:ListTagsForResource"},
+				Update: []string{"ssm:AddTagsToResource", "ssm:PutParameter", "ssm:RemoveTagsFromResource"},
+				Delete: []string{"ssm:DeleteParameter"},
+			},
 		},
 		{
 			Factory:  resourcePatchBaseline,
@@ -22,7 +22,7 @@ func waitAssociationSuccess(ctx context.Context, conn *ssm.SSM, id string, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ssm.AssociationDescription); ok && output.Overview != nil {
 		if status := aws.StringValue(output.Overview.Status); status == ssm.AssociationStatusNameFailed {
@@ -42,7 +42,7 @@ func waitServiceSettingUpdated(ctx context.Context, conn *ssm.SSM, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ssm.ServiceSetting); ok {
 		return output, err
@@ -59,7 +59,7 @@ func waitServiceSettingReset(ctx context.Context, conn *ssm.SSM, id string, time
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
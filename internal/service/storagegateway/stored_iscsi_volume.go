@@ -246,7 +246,7 @@ func resourceStorediSCSIVolumeDelete(ctx context.Context, d *schema.ResourceData
 	}
 
 	log.Printf("[DEBUG] Deleting Storage Gateway Stored iSCSI volume: %s", input)
-	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, 2*time.Minute, func() *retry.RetryError {
 		_, err := conn.DeleteVolumeWithContext(ctx, input)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, storagegateway.ErrorCodeVolumeNotFound) {
@@ -126,7 +126,7 @@ func resourceMedicalVocabularyRead(ctx context.Context, d *schema.ResourceData,
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "transcribe",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
 		Resource:  fmt.Sprintf("medical-vocabulary/%s", d.Id()),
 	}.String()
 
@@ -201,7 +201,7 @@ func waitMedicalVocabularyCreated(ctx context.Context, conn *transcribe.Client,
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetMedicalVocabularyOutput); ok {
 		return out, err
 	}
@@ -220,7 +220,7 @@ func waitMedicalVocabularyUpdated(ctx context.Context, conn *transcribe.Client,
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetMedicalVocabularyOutput); ok {
 		return out, err
 	}
@@ -237,7 +237,7 @@ func waitMedicalVocabularyDeleted(ctx context.Context, conn *transcribe.Client,
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetMedicalVocabularyOutput); ok {
 		return out, err
 	}
@@ -224,7 +224,7 @@ func waitServiceNetworkVPCAssociationCreated(ctx context.Context, conn *vpclatti
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
 		return out, err
 	}
@@ -240,7 +240,7 @@ func waitServiceNetworkVPCAssociationDeleted(ctx context.Context, conn *vpclatti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
 		return out, err
 	}
@@ -9,12 +9,40 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 )
 
+type contextKey int
+
+const (
+	regionContextKey contextKey = iota
+	resourceTypeContextKey
+)
+
 func Context(region string) context.Context {
 	ctx := context.Background()
 
-	ctx = tfsdklog.RegisterStdlogSink(ctx)
+	ctx = tfsdklog.ContextWithStandardLogging(ctx, "sweeper")
 
 	ctx = logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionContextKey, region)
+
 	return ctx
 }
+
+// withResourceType returns a copy of the sweeper context with the resource type being swept.
+func withResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = logWithResourceType(ctx, resourceType)
+
+	return context.WithValue(ctx, resourceTypeContextKey, resourceType)
+}
+
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionContextKey).(string)
+
+	return v
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeContextKey).(string)
+
+	return v
+}
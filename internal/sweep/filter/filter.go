@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package filter selects the resources deleted by sweepers.
+package filter
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// CreationTimeAttributes are the names of attributes holding a resource's creation time as an RFC 3339 timestamp,
+// in order of precedence.
+var CreationTimeAttributes = []string{
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+	"create_date",
+	"create_time",
+	"created_time",
+	"creation_timestamp",
+	"launch_time",
+}
+
+// Resource describes a resource to be swept.
+type Resource struct {
+	// Type is the Terraform resource type, if known.
+	Type string
+	// ID is the resource's identifier.
+	ID string
+	// Name is the resource's name, if it has one.
+	Name string
+	// Tags are the resource's tags, or nil if they are not known.
+	Tags map[string]string
+	// CreatedAt is the resource's creation time, or the zero time if it is not known.
+	CreatedAt time.Time
+}
+
+// ParseCreationTime parses a creation time attribute value, returning the zero time if it cannot be parsed.
+func ParseCreationTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+// Filter selects the resources to be swept.
+// The zero value selects all resources.
+type Filter struct {
+	// Tags that a resource must have. An empty value matches any value.
+	Tags map[string]string
+	// NamePrefixes of which a resource's name (or ID if it has no name) must have at least one.
+	NamePrefixes []string
+	// MinAge is the minimum time since a resource's creation.
+	MinAge time.Duration
+	// Exclude contains patterns (see path.Match) matching the names or IDs of resources that must not be swept.
+	Exclude []string
+}
+
+// IsZero returns whether the filter selects all resources.
+func (f Filter) IsZero() bool {
+	return len(f.Tags) == 0 && len(f.NamePrefixes) == 0 && f.MinAge == 0 && len(f.Exclude) == 0
+}
+
+// RequiresRefresh returns whether the filter needs resource attributes that are typically only known after
+// the resource has been read.
+func (f Filter) RequiresRefresh() bool {
+	return len(f.Tags) > 0 || len(f.NamePrefixes) > 0 || f.MinAge > 0
+}
+
+// Match returns whether the resource is selected by the filter.
+// If it is not selected, the reason is returned.
+func (f Filter) Match(r Resource, now time.Time) (bool, string) {
+	for _, pattern := range f.Exclude {
+		for _, v := range []string{r.ID, r.Name} {
+			if v == "" {
+				continue
+			}
+
+			if ok, _ := path.Match(pattern, v); ok {
+				return false, fmt.Sprintf("excluded by %q", pattern)
+			}
+		}
+	}
+
+	if len(f.NamePrefixes) > 0 {
+		name := r.Name
+		if name == "" {
+			name = r.ID
+		}
+
+		if !hasAnyPrefix(name, f.NamePrefixes) {
+			return false, fmt.Sprintf("name %q does not have any of the prefixes %q", name, f.NamePrefixes)
+		}
+	}
+
+	for k, v := range f.Tags {
+		if tv, ok := r.Tags[k]; !ok {
+			return false, fmt.Sprintf("tag %q not set", k)
+		} else if v != "" && tv != v {
+			return false, fmt.Sprintf("tag %q value %q does not match %q", k, tv, v)
+		}
+	}
+
+	if f.MinAge > 0 {
+		if r.CreatedAt.IsZero() {
+			return false, "creation time not known"
+		}
+
+		if age := now.Sub(r.CreatedAt); age < f.MinAge {
+			return false, fmt.Sprintf("created %s ago, less than %s", age.Round(time.Second), f.MinAge)
+		}
+	}
+
+	return true, ""
+}
+
+// ParseTags parses a comma-separated list of tags of the form "key=value" or "key".
+func ParseTags(s string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	for _, v := range ParseList(s) {
+		k, v, _ := strings.Cut(v, "=")
+
+		if k = strings.TrimSpace(k); k == "" {
+			return nil, fmt.Errorf("invalid tag filter %q: empty key", s)
+		}
+
+		tags[k] = strings.TrimSpace(v)
+	}
+
+	return tags, nil
+}
+
+// ParseList parses a comma-separated list, ignoring empty elements.
+func ParseList(s string) []string {
+	var list []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
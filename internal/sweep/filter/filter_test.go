@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+)
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		filter filter.Filter
+		input  filter.Resource
+		want   bool
+	}{
+		"zero filter": {
+			input: filter.Resource{ID: "i-123"},
+			want:  true,
+		},
+		"excluded ID": {
+			filter: filter.Filter{Exclude: []string{"i-123"}},
+			input:  filter.Resource{ID: "i-123"},
+		},
+		"excluded name pattern": {
+			filter: filter.Filter{Exclude: []string{"keep-*"}},
+			input:  filter.Resource{ID: "i-123", Name: "keep-me"},
+		},
+		"not excluded": {
+			filter: filter.Filter{Exclude: []string{"keep-*"}},
+			input:  filter.Resource{ID: "i-123", Name: "tf-acc-test-1"},
+			want:   true,
+		},
+		"name prefix match": {
+			filter: filter.Filter{NamePrefixes: []string{"terraform-", "tf-acc-test"}},
+			input:  filter.Resource{ID: "i-123", Name: "tf-acc-test-1"},
+			want:   true,
+		},
+		"name prefix no match": {
+			filter: filter.Filter{NamePrefixes: []string{"tf-acc-test"}},
+			input:  filter.Resource{ID: "i-123", Name: "production"},
+		},
+		"name prefix matches ID without name": {
+			filter: filter.Filter{NamePrefixes: []string{"tf-acc-test"}},
+			input:  filter.Resource{ID: "tf-acc-test-1"},
+			want:   true,
+		},
+		"tag value match": {
+			filter: filter.Filter{Tags: map[string]string{"env": "sandbox"}},
+			input:  filter.Resource{ID: "i-123", Tags: map[string]string{"env": "sandbox", "team": "a"}},
+			want:   true,
+		},
+		"tag value no match": {
+			filter: filter.Filter{Tags: map[string]string{"env": "sandbox"}},
+			input:  filter.Resource{ID: "i-123", Tags: map[string]string{"env": "production"}},
+		},
+		"tag key match": {
+			filter: filter.Filter{Tags: map[string]string{"env": ""}},
+			input:  filter.Resource{ID: "i-123", Tags: map[string]string{"env": "production"}},
+			want:   true,
+		},
+		"tag not set": {
+			filter: filter.Filter{Tags: map[string]string{"env": ""}},
+			input:  filter.Resource{ID: "i-123", Tags: map[string]string{"team": "a"}},
+		},
+		"tags not known": {
+			filter: filter.Filter{Tags: map[string]string{"env": ""}},
+			input:  filter.Resource{ID: "i-123"},
+		},
+		"old enough": {
+			filter: filter.Filter{MinAge: 24 * time.Hour},
+			input:  filter.Resource{ID: "i-123", CreatedAt: now.Add(-48 * time.Hour)},
+			want:   true,
+		},
+		"too new": {
+			filter: filter.Filter{MinAge: 24 * time.Hour},
+			input:  filter.Resource{ID: "i-123", CreatedAt: now.Add(-1 * time.Hour)},
+		},
+		"creation time not known": {
+			filter: filter.Filter{MinAge: 24 * time.Hour},
+			input:  filter.Resource{ID: "i-123"},
+		},
+		"all criteria": {
+			filter: filter.Filter{
+				Tags:         map[string]string{"env": "sandbox"},
+				NamePrefixes: []string{"tf-acc-test"},
+				MinAge:       time.Hour,
+				Exclude:      []string{"tf-acc-test-keep*"},
+			},
+			input: filter.Resource{
+				ID:        "i-123",
+				Name:      "tf-acc-test-1",
+				Tags:      map[string]string{"env": "sandbox"},
+				CreatedAt: now.Add(-2 * time.Hour),
+			},
+			want: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, reason := testCase.filter.Match(testCase.input, now)
+
+			if got != testCase.want {
+				t.Errorf("got %t (%s), want %t", got, reason, testCase.want)
+			}
+
+			if !got && reason == "" {
+				t.Error("expected reason")
+			}
+		})
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input   string
+		want    map[string]string
+		wantErr bool
+	}{
+		"empty": {
+			want: map[string]string{},
+		},
+		"keys and values": {
+			input: "env=sandbox, owner ,team=a=b",
+			want:  map[string]string{"env": "sandbox", "owner": "", "team": "a=b"},
+		},
+		"empty key": {
+			input:   "=sandbox",
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := filter.ParseTags(testCase.input)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); err == nil && diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseCreationTime(t *testing.T) {
+	t.Parallel()
+
+	if got, want := filter.ParseCreationTime("2024-06-01T12:00:00.5Z"), time.Date(2024, 6, 1, 12, 0, 0, 500000000, time.UTC); !got.Equal(want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	if got := filter.ParseCreationTime("1717243200"); !got.IsZero() {
+		t.Errorf("got %s, want zero time", got)
+	}
+}
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -19,7 +20,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/tagging"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -49,33 +53,12 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 }
 
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
-	resource, err := sr.factory(ctx)
+	ctx, resource, state, err := sr.state(ctx)
 
 	if err != nil {
 		return err
 	}
 
-	metadata := resourceMetadata(ctx, resource)
-	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
-
-	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
-
-	schemaResp := fwresource.SchemaResponse{}
-	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
-
-	state := tfsdk.State{
-		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
-		Schema: schemaResp.Schema,
-	}
-
-	for _, attr := range sr.attributes {
-		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
-		}
-		ctx = tflog.SetField(ctx, attr.path, attr.value)
-	}
-
 	tflog.Info(ctx, "Sweeping resource")
 
 	jitter := time.Duration(rand.Int63n(int64(1*time.Second))) - 1*time.Second/2
@@ -118,6 +101,156 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe returns a description of the resource to be deleted.
+func (sr *sweepResource) Describe(ctx context.Context, refresh bool) (filter.Resource, error) {
+	ctx, resource, state, err := sr.state(ctx)
+
+	if err != nil {
+		return filter.Resource{}, err
+	}
+
+	r := filter.Resource{
+		Type: resourceMetadata(ctx, resource).TypeName,
+	}
+
+	t, tagged := tagging.FrameworkResource(ctx, sr.meta, r.Type)
+
+	if refresh {
+		if tagged {
+			ctx = t.NewContext(ctx, sr.meta)
+		}
+
+		response := fwresource.ReadResponse{State: state}
+		resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+		if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+			return r, err
+		}
+
+		// The resource has been removed from state as it no longer exists.
+		if response.State.Raw.IsNull() {
+			return r, nil
+		}
+
+		state = response.State
+	}
+
+	r.ID = stringAttribute(state, names.AttrID)
+	if r.ID == "" && len(sr.attributes) > 0 {
+		r.ID = fmt.Sprint(sr.attributes[0].value)
+	}
+	r.Name = stringAttribute(state, names.AttrName)
+	r.Tags = mapAttribute(state, names.AttrTagsAll)
+	if len(r.Tags) == 0 {
+		r.Tags = mapAttribute(state, names.AttrTags)
+	}
+	// Transparently tagged resources' tags are set by the provider's interceptors, which are not run here.
+	if refresh && tagged && len(r.Tags) == 0 {
+		tags, err := t.ListTags(ctx, sr.meta, stringAttribute(state, t.IdentifierAttribute()))
+		if err != nil {
+			return r, fmt.Errorf("listing tags: %w", err)
+		}
+
+		r.Tags = tags
+	}
+	for _, k := range filter.CreationTimeAttributes {
+		if v := stringAttribute(state, k); v != "" {
+			r.CreatedAt = filter.ParseCreationTime(v)
+			break
+		}
+	}
+
+	return r, nil
+}
+
+// state returns the configured resource and its state, populated from the sweepable's attributes.
+func (sr *sweepResource) state(ctx context.Context) (context.Context, fwresource.ResourceWithConfigure, tfsdk.State, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return ctx, nil, tfsdk.State{}, err
+	}
+
+	metadata := resourceMetadata(ctx, resource)
+	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
+
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
+
+	schemaResp := fwresource.SchemaResponse{}
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResp.Schema,
+	}
+
+	for _, attr := range sr.attributes {
+		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
+		if d.HasError() {
+			return ctx, nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
+		}
+		ctx = tflog.SetField(ctx, attr.path, attr.value)
+	}
+
+	return ctx, resource, state, nil
+}
+
+// stringAttribute returns the value of the specified top-level string attribute, or "" if it is not set.
+func stringAttribute(state tfsdk.State, name string) string {
+	v, ok := attributeValue(state, name)
+
+	if !ok {
+		return ""
+	}
+
+	var s string
+	if err := v.As(&s); err != nil {
+		return ""
+	}
+
+	return s
+}
+
+// mapAttribute returns the value of the specified top-level map of strings attribute, or nil if it is not set.
+func mapAttribute(state tfsdk.State, name string) map[string]string {
+	v, ok := attributeValue(state, name)
+
+	if !ok {
+		return nil
+	}
+
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		return nil
+	}
+
+	tags := make(map[string]string, len(m))
+	for k, v := range m {
+		var s string
+		if err := v.As(&s); err == nil {
+			tags[k] = s
+		}
+	}
+
+	return tags
+}
+
+func attributeValue(state tfsdk.State, name string) (tftypes.Value, bool) {
+	v, _, err := tftypes.WalkAttributePath(state.Raw, tftypes.NewAttributePath().WithAttributeName(name))
+
+	if err != nil {
+		return tftypes.Value{}, false
+	}
+
+	tv, ok := v.(tftypes.Value)
+
+	if !ok || tv.IsNull() || !tv.IsKnown() {
+		return tftypes.Value{}, false
+	}
+
+	return tv, true
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
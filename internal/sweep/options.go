@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+)
+
+// options controls which resources are swept and how sweeps are reported.
+type options struct {
+	dryRun     bool
+	filter     filter.Filter
+	reportPath string
+}
+
+// describe returns whether resources must be described before being swept.
+func (o options) describe() bool {
+	return o.dryRun || !o.filter.IsZero() || o.reportPath != ""
+}
+
+// sweepOptions returns the options configured via environment variables.
+var sweepOptions = sync.OnceValues(optionsFromEnv)
+
+func optionsFromEnv() (options, error) {
+	var o options
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+
+		if err != nil {
+			return o, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+
+		o.dryRun = dryRun
+	}
+
+	if v := os.Getenv(envvar.SweepTags); v != "" {
+		tags, err := filter.ParseTags(v)
+
+		if err != nil {
+			return o, fmt.Errorf("environment variable %s: %w", envvar.SweepTags, err)
+		}
+
+		o.filter.Tags = tags
+	}
+
+	o.filter.NamePrefixes = filter.ParseList(os.Getenv(envvar.SweepNamePrefixes))
+
+	if v := os.Getenv(envvar.SweepMinAge); v != "" {
+		d, err := time.ParseDuration(v)
+
+		if err != nil {
+			return o, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+
+		o.filter.MinAge = d
+	}
+
+	o.filter.Exclude = filter.ParseList(os.Getenv(envvar.SweepExclude))
+
+	for _, pattern := range o.filter.Exclude {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return o, fmt.Errorf("environment variable %s: pattern %q: %w", envvar.SweepExclude, pattern, err)
+		}
+	}
+
+	o.reportPath = os.Getenv(envvar.SweepReport)
+
+	return o, nil
+}
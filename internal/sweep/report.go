@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+type reportStatus string
+
+const (
+	reportStatusDeleted     reportStatus = "deleted"
+	reportStatusFailed      reportStatus = "failed"
+	reportStatusSkipped     reportStatus = "skipped"
+	reportStatusWouldDelete reportStatus = "would_delete" // Dry run.
+)
+
+type reportEntry struct {
+	ResourceType string       `json:"-"`
+	Region       string       `json:"-"`
+	ID           string       `json:"id"`
+	Name         string       `json:"name,omitempty"`
+	Status       reportStatus `json:"status"`
+	Reason       string       `json:"reason,omitempty"`
+}
+
+type reportResourceType struct {
+	ResourceType string        `json:"resource_type"`
+	Region       string        `json:"region"`
+	Deleted      int           `json:"deleted"`
+	Failed       int           `json:"failed"`
+	Skipped      int           `json:"skipped"`
+	WouldDelete  int           `json:"would_delete"`
+	Resources    []reportEntry `json:"resources"`
+}
+
+type reportDocument struct {
+	DryRun        bool                  `json:"dry_run"`
+	ResourceTypes []*reportResourceType `json:"resource_types"`
+}
+
+// report records the outcome of sweeping each resource.
+// The report is shared by all sweepers and is rewritten in full each time a sweeper completes,
+// so that it is complete however the sweep run ends.
+type report struct {
+	mu      sync.Mutex
+	entries []reportEntry
+}
+
+var sweepReport report
+
+func (r *report) add(entry reportEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, entry)
+}
+
+// write writes the report to the specified file, as CSV if the file has a ".csv" extension and otherwise as JSON.
+func (r *report) write(path string, dryRun bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var (
+		b   []byte
+		err error
+	)
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		b, err = r.csv()
+	} else {
+		b, err = r.json(dryRun)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0644)
+}
+
+func (r *report) json(dryRun bool) ([]byte, error) {
+	doc := reportDocument{
+		DryRun:        dryRun,
+		ResourceTypes: make([]*reportResourceType, 0),
+	}
+
+	for _, entry := range r.entries {
+		i := slices.IndexFunc(doc.ResourceTypes, func(v *reportResourceType) bool {
+			return v.ResourceType == entry.ResourceType && v.Region == entry.Region
+		})
+		if i < 0 {
+			doc.ResourceTypes = append(doc.ResourceTypes, &reportResourceType{
+				ResourceType: entry.ResourceType,
+				Region:       entry.Region,
+			})
+			i = len(doc.ResourceTypes) - 1
+		}
+
+		v := doc.ResourceTypes[i]
+		switch entry.Status {
+		case reportStatusDeleted:
+			v.Deleted++
+		case reportStatusFailed:
+			v.Failed++
+		case reportStatusSkipped:
+			v.Skipped++
+		case reportStatusWouldDelete:
+			v.WouldDelete++
+		}
+		v.Resources = append(v.Resources, entry)
+	}
+
+	slices.SortFunc(doc.ResourceTypes, func(a, b *reportResourceType) int {
+		return cmp.Or(cmp.Compare(a.ResourceType, b.ResourceType), cmp.Compare(a.Region, b.Region))
+	})
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+func (r *report) csv() ([]byte, error) {
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+
+	records := [][]string{{"resource_type", "region", "id", "name", "status", "reason"}}
+	for _, entry := range r.entries {
+		records = append(records, []string{entry.ResourceType, entry.Region, entry.ID, entry.Name, string(entry.Status), entry.Reason})
+	}
+
+	if err := w.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
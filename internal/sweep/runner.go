@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const defaultSweeperParallelism = 4
+
+// registeredSweepers are all registered sweepers, keyed by name.
+var registeredSweepers = make(map[string]*resource.Sweeper)
+
+// directSweepers are the names of registered sweepers that delete resources directly,
+// rather than returning them to SweepOrchestrator.
+var directSweepers = make(map[string]bool)
+
+// AddTestSweepers registers a sweeper with the sweeper runner.
+// Sweepers registered with AddTestSweepers are run by TestMain.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	resource.AddTestSweepers(name, s)
+
+	registeredSweepers[name] = s
+}
+
+// AddDirectTestSweepers registers a sweeper that deletes resources directly, rather than returning them to SweepOrchestrator.
+// Such sweepers cannot honour dry-run mode, filters or sweep reports, so are skipped when any of these are configured.
+func AddDirectTestSweepers(name string, s *resource.Sweeper) {
+	AddTestSweepers(name, s)
+
+	directSweepers[name] = true
+}
+
+// TestMain runs the registered sweepers if the -sweep flag is set, otherwise tests are run as normal.
+//
+// It supports the same -sweep, -sweep-run and -sweep-allow-failures flags as resource.TestMain,
+// but sweepers run concurrently, in dependency order, with up to TF_AWS_SWEEP_PARALLELISM running at once.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+
+	if regions == "" {
+		os.Exit(m.Run())
+	}
+
+	parallelism, err := sweeperParallelism()
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	allowFailures, _ := strconv.ParseBool(flagValue("sweep-allow-failures"))
+
+	if err := runSweepers(strings.Split(regions, ","), filterSweepers(flagValue("sweep-run"), registeredSweepers), allowFailures, parallelism); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+}
+
+// flagValue returns the value of a flag defined by the resource package, or "" if it is not defined.
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+func sweeperParallelism() (int, error) {
+	v := os.Getenv(envvar.SweepParallelism)
+
+	if v == "" {
+		return defaultSweeperParallelism, nil
+	}
+
+	n, err := strconv.Atoi(v)
+
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("environment variable %s: must be a positive integer, got %q", envvar.SweepParallelism, v)
+	}
+
+	return n, nil
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma-separated, case-insensitive
+// values in f, together with their dependencies (transitively). All sweepers are returned if f is empty.
+func filterSweepers(f string, sweepers map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	if f == "" {
+		return sweepers
+	}
+
+	result := make(map[string]*resource.Sweeper)
+
+	var add func(string)
+	add = func(name string) {
+		s, ok := sweepers[name]
+		if !ok {
+			return
+		}
+
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		result[name] = s
+
+		for _, dependency := range s.Dependencies {
+			add(dependency)
+		}
+	}
+
+	for _, v := range strings.Split(strings.ToLower(f), ",") {
+		for name := range sweepers {
+			if strings.Contains(strings.ToLower(name), v) {
+				add(name)
+			}
+		}
+	}
+
+	return result
+}
+
+// sweeperGraph returns the dependency graph of the specified sweepers.
+// Sweepers with dependencies that are not in the graph cannot be run and are returned with an error.
+func sweeperGraph(sweepers map[string]*resource.Sweeper) (*depgraph.Graph, map[string]error) {
+	g := depgraph.New()
+	invalid := make(map[string]error)
+
+	names := slices.Sorted(maps.Keys(sweepers))
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if err := g.AddDependency(name, dependency); err != nil {
+				invalid[name] = fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+			}
+		}
+	}
+
+	return g, invalid
+}
+
+// runSweepers runs the specified sweepers in each region.
+// A sweeper is only run once all of its dependencies have been run, and independent sweepers are run concurrently.
+// Unless allowFailures is true, no further sweepers are started in a region once one fails.
+func runSweepers(regions []string, sweepers map[string]*resource.Sweeper, allowFailures bool, parallelism int) error {
+	g, invalid := sweeperGraph(sweepers)
+
+	var sweeperErrorFound bool
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		var mu sync.Mutex
+		results := make(map[string]error)
+
+		start := time.Now()
+		log.Printf("[DEBUG] Running Sweepers for region (%s) with parallelism %d:\n", region, parallelism)
+
+		err := g.Walk(context.Background(), parallelism, func(_ context.Context, name string) error {
+			err := invalid[name]
+
+			if err == nil {
+				err = runSweeper(region, sweepers[name])
+			} else {
+				log.Printf("[ERROR] %s", err)
+			}
+
+			mu.Lock()
+			results[name] = err
+			mu.Unlock()
+
+			if allowFailures {
+				return nil
+			}
+
+			return err
+		})
+
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+		var failed []string
+		log.Printf("Sweeper Tests for region (%s) ran successfully:\n", region)
+		for _, name := range slices.Sorted(maps.Keys(results)) {
+			if results[name] == nil {
+				log.Printf("\t- %s\n", name)
+			} else {
+				failed = append(failed, name)
+			}
+		}
+
+		if len(failed) > 0 {
+			sweeperErrorFound = true
+
+			log.Printf("Sweeper Tests for region (%s) ran unsuccessfully:\n", region)
+			for _, name := range failed {
+				log.Printf("\t- %s: %s\n", name, results[name])
+			}
+		}
+
+		if err != nil {
+			return fmt.Errorf("sweepers for region (%s) failed: %w", region, err)
+		}
+	}
+
+	if sweeperErrorFound {
+		return errors.New("at least one sweeper failed")
+	}
+
+	return nil
+}
+
+func runSweeper(region string, s *resource.Sweeper) error {
+	opts, err := sweepOptions()
+	if err != nil {
+		return err
+	}
+
+	if directSweepers[s.Name] && opts.describe() {
+		const reason = "sweeper deletes resources directly and does not support dry run, filters or reports"
+
+		log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): %s", s.Name, region, reason)
+
+		sweepReport.add(reportEntry{
+			ResourceType: s.Name,
+			Region:       region,
+			Status:       reportStatusSkipped,
+			Reason:       reason,
+		})
+		if opts.reportPath != "" {
+			if err := sweepReport.write(opts.reportPath, opts.dryRun); err != nil {
+				log.Printf("[WARN] Writing sweep report: %s", err)
+			}
+		}
+
+		return nil
+	}
+
+	log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", s.Name, region)
+
+	start := time.Now()
+	err = s.F(region)
+
+	log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", s.Name, region, time.Since(start))
+
+	if err != nil {
+		log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", s.Name, region, err)
+	}
+
+	return err
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRunSweepersDryRunSkipsDirectSweepers(t *testing.T) { //nolint:paralleltest // Modifies the sweep options and report.
+	const (
+		region = "us-west-2" //lintignore:AWSAT003
+		direct = "aws_test_direct"
+		orch   = "aws_test_orchestrated"
+	)
+
+	saved := sweepOptions
+	t.Cleanup(func() {
+		sweepOptions = saved
+		delete(directSweepers, direct)
+		sweepReport = report{}
+	})
+	sweepOptions = func() (options, error) {
+		return options{dryRun: true}, nil
+	}
+	directSweepers[direct] = true
+
+	ran := make(map[string]bool)
+	sweepers := map[string]*resource.Sweeper{
+		direct: {
+			Name: direct,
+			F: func(string) error {
+				ran[direct] = true
+				return nil
+			},
+		},
+		orch: {
+			Name:         orch,
+			Dependencies: []string{direct},
+			F: func(string) error {
+				ran[orch] = true
+				return nil
+			},
+		},
+	}
+
+	if err := runSweepers([]string{region}, sweepers, false, 1); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if ran[direct] {
+		t.Errorf("sweeper %s deletes resources directly and was run in dry-run mode", direct)
+	}
+	if !ran[orch] {
+		t.Errorf("sweeper %s was not run in dry-run mode", orch)
+	}
+
+	if got, want := len(sweepReport.entries), 1; got != want {
+		t.Fatalf("got %d report entries, want %d", got, want)
+	}
+	if entry := sweepReport.entries[0]; entry.ResourceType != direct || entry.Status != reportStatusSkipped {
+		t.Errorf("got report entry %+v, want %s %s", entry, direct, reportStatusSkipped)
+	}
+}
@@ -5,6 +5,7 @@ package sdk
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -16,8 +17,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/tagging"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -89,6 +94,45 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe returns a description of the resource to be deleted.
+func (sr *sweepResource) Describe(ctx context.Context, refresh bool) (filter.Resource, error) {
+	if !refresh {
+		return describeResource(sr.resource, sr.d), nil
+	}
+
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+
+	t, tagged := tagging.SDKResource(ctx, sr.meta, sr.resource)
+	if tagged {
+		ctx = t.NewContext(ctx, sr.meta)
+	}
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return filter.Resource{}, err
+	}
+
+	r := describeResource(sr.resource, sr.d)
+
+	// Transparently tagged resources' tags are set by the provider's interceptors, which are not run here.
+	if tagged && r.ID != "" && len(r.Tags) == 0 {
+		var identifier string
+		if identifierAttribute := t.IdentifierAttribute(); identifierAttribute == names.AttrID {
+			identifier = sr.d.Id()
+		} else {
+			identifier, _ = sr.d.Get(identifierAttribute).(string)
+		}
+
+		tags, err := t.ListTags(ctx, sr.meta, identifier)
+		if err != nil {
+			return r, fmt.Errorf("listing tags: %w", err)
+		}
+
+		r.Tags = tags
+	}
+
+	return r, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -121,6 +165,44 @@ func deleteResource(ctx context.Context, resource *schema.Resource, d *schema.Re
 	return resource.Delete(d, meta)
 }
 
+func describeResource(resource *schema.Resource, d *schema.ResourceData) filter.Resource {
+	r := filter.Resource{
+		ID: d.Id(),
+	}
+
+	if r.ID == "" {
+		return r
+	}
+
+	s := resource.SchemaMap()
+
+	if _, ok := s[names.AttrName]; ok {
+		if v, ok := d.Get(names.AttrName).(string); ok {
+			r.Name = v
+		}
+	}
+
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		if _, ok := s[k]; ok {
+			if v, ok := d.Get(k).(map[string]interface{}); ok && len(v) > 0 {
+				r.Tags = flex.ExpandStringValueMap(v)
+				break
+			}
+		}
+	}
+
+	for _, k := range filter.CreationTimeAttributes {
+		if _, ok := s[k]; ok {
+			if v, ok := d.Get(k).(string); ok && v != "" {
+				r.CreatedAt = filter.ParseCreationTime(v)
+				break
+			}
+		}
+	}
+
+	return r
+}
+
 func ReadResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) error {
 	if resource.ReadContext != nil || resource.ReadWithoutTimeout != nil {
 		var diags diag.Diagnostics
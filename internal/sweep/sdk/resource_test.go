@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSweepResourceDescribeTransparentTagging(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sp := &testServicePackage{
+		tags: map[string]map[string]string{
+			"arn:aws:test:us-west-2:123456789012:thing/tf-acc-test-1": { //lintignore:AWSAT003,AWSAT005
+				"env":      "sandbox",
+				"aws:test": "system",
+			},
+		},
+	}
+	meta := &conns.AWSClient{
+		ServicePackages: map[string]conns.ServicePackage{
+			sp.ServicePackageName(): sp,
+		},
+	}
+
+	r := testResource()
+	d := r.Data(nil)
+	d.SetId("tf-acc-test-1")
+
+	got, err := sdk.NewSweepResource(r, d, meta).Describe(ctx, true)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := filter.Resource{
+		ID:   "tf-acc-test-1",
+		Name: "tf-acc-test-1",
+		Tags: map[string]string{
+			"env": "sandbox",
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+// testResource returns a transparently tagged resource whose Read handler does not set tags.
+func testResource() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: testResourceRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func testResourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	d.Set(names.AttrARN, "arn:aws:test:us-west-2:123456789012:thing/"+d.Id()) //lintignore:AWSAT003,AWSAT005
+	d.Set(names.AttrName, d.Id())
+
+	return nil
+}
+
+type testServicePackage struct {
+	tags map[string]map[string]string
+}
+
+func (p *testServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (p *testServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (p *testServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (p *testServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  testResource,
+			TypeName: "aws_test_thing",
+			Name:     "Thing",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+	}
+}
+
+func (p *testServicePackage) ServicePackageName() string {
+	return "test"
+}
+
+func (p *testServicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = option.Some(tftags.New(ctx, p.tags[identifier]))
+	}
+
+	return nil
+}
@@ -8,15 +8,18 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -36,8 +39,14 @@ var ServicePackages []conns.ServicePackage
 // This prevents client re-initialization for every resource with no benefit.
 var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
 
+// sweeperClientsLock serializes access to sweeperClients by concurrently running sweepers.
+var sweeperClientsLock sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -69,24 +78,28 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := &awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
+		assumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = []*awsbase.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -105,7 +118,20 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// Describable is implemented by Sweepables that can describe the resource they delete.
+// Only Sweepables that are Describable can be filtered.
+type Describable interface {
+	// Describe returns a description of the resource to be deleted.
+	// If refresh is true the resource is read first, and a resource that no longer exists is described with an empty ID.
+	Describe(ctx context.Context, refresh bool) (filter.Resource, error)
+}
+
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	opts, err := sweepOptions()
+	if err != nil {
+		return err
+	}
+
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
@@ -116,11 +142,87 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 		sweepable := sweepable
 
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			return sweepOne(ctx, opts, sweepable, optFns...)
 		})
 	}
 
-	return g.Wait().ErrorOrNil()
+	err = g.Wait().ErrorOrNil()
+
+	if opts.reportPath != "" {
+		if err := sweepReport.write(opts.reportPath, opts.dryRun); err != nil {
+			tflog.Warn(ctx, "Writing sweep report", map[string]any{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return err
+}
+
+// sweepOne deletes a single resource, subject to the configured filter and dry-run mode.
+func sweepOne(ctx context.Context, opts options, sweepable Sweepable, optFns ...tfresource.OptionsFunc) error {
+	if !opts.describe() {
+		return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+	}
+
+	entry := reportEntry{
+		ResourceType: resourceTypeFromContext(ctx),
+		Region:       regionFromContext(ctx),
+	}
+	result := func(status reportStatus, reason string) {
+		entry.Status, entry.Reason = status, reason
+		sweepReport.add(entry)
+	}
+
+	var described filter.Resource
+	if v, ok := sweepable.(Describable); ok {
+		refresh := opts.filter.RequiresRefresh()
+		r, err := v.Describe(ctx, refresh)
+
+		if err != nil {
+			result(reportStatusFailed, err.Error())
+			return err
+		}
+
+		described = r
+		entry.ID, entry.Name = described.ID, described.Name
+		if described.Type != "" {
+			entry.ResourceType = described.Type
+		}
+
+		if refresh && described.ID == "" {
+			result(reportStatusSkipped, "not found")
+			return nil
+		}
+	} else if !opts.filter.IsZero() {
+		result(reportStatusSkipped, "resource cannot be filtered")
+		return nil
+	}
+
+	ctx = tflog.SetField(ctx, "id", entry.ID)
+
+	if ok, reason := opts.filter.Match(described, time.Now()); !ok {
+		tflog.Info(ctx, "Skipping resource", map[string]any{
+			"reason": reason,
+		})
+		result(reportStatusSkipped, reason)
+		return nil
+	}
+
+	if opts.dryRun {
+		tflog.Info(ctx, "Dry run, not sweeping resource")
+		result(reportStatusWouldDelete, "")
+		return nil
+	}
+
+	if err := sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...); err != nil {
+		result(reportStatusFailed, err.Error())
+		return err
+	}
+
+	result(reportStatusDeleted, "")
+
+	return nil
 }
 
 // Deprecated: Use awsv1.SkipSweepError
@@ -137,11 +239,11 @@ func PartitionDNSSuffix(region string) string {
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
 
 func Register(name string, f SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
+	AddTestSweepers(name, &resource.Sweeper{
 		Name: name,
 		F: func(region string) error {
 			ctx := Context(region)
-			ctx = logWithResourceType(ctx, name)
+			ctx = withResourceType(ctx, name)
 
 			client, err := SharedRegionalSweepClient(ctx, region)
 			if err != nil {
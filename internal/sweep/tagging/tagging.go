@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tagging lists the tags of swept resources that use transparent tagging.
+//
+// Transparent tagging sets a resource's `tags` and `tags_all` attributes in the provider's interceptors,
+// which are not run when a sweeper reads a resource, so tags are listed here in the same way.
+package tagging
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// Resource is a resource type that uses transparent tagging.
+type Resource struct {
+	servicePackage conns.ServicePackage
+	typeName       string
+	name           string
+	tags           *types.ServicePackageResourceTags
+}
+
+// IdentifierAttribute returns the attribute holding the identifier passed to ListTags.
+func (r Resource) IdentifierAttribute() string {
+	return r.tags.IdentifierAttribute
+}
+
+// NewContext returns a Context enhanced with the resource and tagging information set by the provider before calling a CRUD handler.
+func (r Resource) NewContext(ctx context.Context, meta *conns.AWSClient) context.Context {
+	servicePackageName := r.servicePackage.ServicePackageName()
+
+	ctx = conns.NewResourceContext(ctx, servicePackageName, r.name)
+	ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResource(r.typeName, servicePackageName), meta.IgnoreTagsConfig)
+
+	return ctx
+}
+
+// ListTags returns the resource's tags, excluding system tags.
+// Tags are listed using the service package's ListTags method unless the resource's Read handler has already set them in Context.
+// ctx must have been returned by NewContext.
+func (r Resource) ListTags(ctx context.Context, meta *conns.AWSClient, identifier string) (map[string]string, error) {
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	if tagsInContext.TagsOut.IsNone() && identifier != "" {
+		var err error
+
+		if v, ok := r.servicePackage.(interface {
+			ListTags(context.Context, any, string) error
+		}); ok {
+			err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
+		} else if v, ok := r.servicePackage.(interface {
+			ListTags(context.Context, any, string, string) error
+		}); ok && r.tags.ResourceType != "" {
+			err = v.ListTags(ctx, meta, identifier, r.tags.ResourceType) // Sets tags in Context
+		} else {
+			tflog.Warn(ctx, "No ListTags method found", map[string]interface{}{
+				"ServicePackage": r.servicePackage.ServicePackageName(),
+				"ResourceType":   r.tags.ResourceType,
+			})
+		}
+
+		// ISO partitions may not support tagging, giving error.
+		if errs.IsUnsupportedOperationInPartitionError(meta.Partition, err) {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(r.servicePackage.ServicePackageName()).Map(), nil
+}
+
+// SDKResource returns the transparent tagging information for the specified Plugin SDK resource.
+// The resource is identified by its Read handler, as sweepers create resources from the same factories registered by service packages.
+func SDKResource(ctx context.Context, meta *conns.AWSClient, resource *schema.Resource) (Resource, bool) {
+	if resource.ReadWithoutTimeout == nil {
+		return Resource{}, false
+	}
+
+	sdkResources.once.Do(func() {
+		sdkResources.m = make(map[uintptr]Resource)
+
+		for _, sp := range meta.ServicePackages {
+			for _, v := range sp.SDKResources(ctx) {
+				if v.Tags == nil {
+					continue
+				}
+
+				if read := v.Factory().ReadWithoutTimeout; read != nil {
+					sdkResources.m[reflect.ValueOf(read).Pointer()] = Resource{
+						servicePackage: sp,
+						typeName:       v.TypeName,
+						name:           v.Name,
+						tags:           v.Tags,
+					}
+				}
+			}
+		}
+	})
+
+	r, ok := sdkResources.m[reflect.ValueOf(resource.ReadWithoutTimeout).Pointer()]
+
+	return r, ok
+}
+
+// FrameworkResource returns the transparent tagging information for the specified Plugin Framework resource type.
+func FrameworkResource(ctx context.Context, meta *conns.AWSClient, typeName string) (Resource, bool) {
+	frameworkResources.once.Do(func() {
+		frameworkResources.m = make(map[string]Resource)
+
+		for _, sp := range meta.ServicePackages {
+			for _, v := range sp.FrameworkResources(ctx) {
+				if v.Tags == nil {
+					continue
+				}
+
+				r, err := v.Factory(ctx)
+				if err != nil {
+					continue
+				}
+
+				var response fwresource.MetadataResponse
+				r.Metadata(ctx, fwresource.MetadataRequest{}, &response)
+
+				frameworkResources.m[response.TypeName] = Resource{
+					servicePackage: sp,
+					typeName:       response.TypeName,
+					name:           v.Name,
+					tags:           v.Tags,
+				}
+			}
+		}
+	})
+
+	r, ok := frameworkResources.m[typeName]
+
+	return r, ok
+}
+
+// The transparently tagged resources of all service packages are indexed on first use.
+// All sweeper clients share the same service packages.
+var (
+	sdkResources struct {
+		once sync.Once
+		m    map[uintptr]Resource
+	}
+	frameworkResources struct {
+		once sync.Once
+		m    map[string]Resource
+	}
+)
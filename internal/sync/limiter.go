@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter limits the rate at which, and the number of, concurrent operations that are started.
+// The zero value places no limits on operations.
+type Limiter struct {
+	interval  time.Duration // Minimum interval between the starts of operations; zero for no rate limit.
+	semaphore Semaphore     // Nil for no concurrency limit.
+
+	lock sync.Mutex
+	next time.Time // Earliest time at which the next operation can start.
+}
+
+// NewLimiter returns a new Limiter.
+// requestsPerSecond is the maximum sustained rate at which operations are started; zero for no rate limit.
+// maxInFlight is the maximum number of concurrent operations; zero for no concurrency limit.
+func NewLimiter(requestsPerSecond float64, maxInFlight int) *Limiter {
+	l := &Limiter{}
+
+	if requestsPerSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+	if maxInFlight > 0 {
+		l.semaphore = NewSemaphore(maxInFlight)
+	}
+
+	return l
+}
+
+// Wait blocks until an operation can be started, or until the context is done.
+// Each successful call to Wait must be followed by a call to Done once the operation has finished.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if l.semaphore != nil {
+		if err := l.semaphore.Acquire(ctx); err != nil {
+			return err
+		}
+	}
+
+	if delay := l.reserve(); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			l.Done()
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// Done signals that an operation started after a successful call to Wait has finished.
+func (l *Limiter) Done() {
+	if l.semaphore != nil {
+		l.semaphore.Notify()
+	}
+}
+
+// reserve reserves the next start time and returns the delay until that time.
+func (l *Limiter) reserve() time.Duration {
+	if l.interval == 0 {
+		return 0
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	start := l.next
+	if start.Before(now) {
+		start = now
+	}
+	l.next = start.Add(l.interval)
+
+	return start.Sub(now)
+}
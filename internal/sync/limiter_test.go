@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLimiterRate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := NewLimiter(100, 0)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("Wait: %s", err)
+		}
+		l.Done()
+	}
+
+	// The first operation starts immediately and the remainder are spaced 10ms apart.
+	if got, want := time.Since(start), 40*time.Millisecond; got < want {
+		t.Errorf("got elapsed %s, want at least %s", got, want)
+	}
+}
+
+func TestLimiterMaxInFlight(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := NewLimiter(0, 2)
+
+	var inFlight, maxInFlight atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := l.Wait(ctx); err != nil {
+				t.Errorf("Wait: %s", err)
+				return
+			}
+			defer l.Done()
+
+			n := inFlight.Add(1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			inFlight.Add(-1)
+		}()
+	}
+	wg.Wait()
+
+	if got, want := maxInFlight.Load(), int32(2); got > want {
+		t.Errorf("got max in flight %d, want at most %d", got, want)
+	}
+}
+
+func TestLimiterContextDone(t *testing.T) {
+	t.Parallel()
+
+	l := NewLimiter(0, 1)
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	l.Done()
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Errorf("Wait: %s", err)
+	}
+}
+
+func TestLimiterZeroValue(t *testing.T) {
+	t.Parallel()
+
+	var l Limiter
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Errorf("Wait: %s", err)
+	}
+	l.Done()
+}
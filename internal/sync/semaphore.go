@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"log"
+)
+
+// Semaphore can be used to limit concurrent executions.
+// This can be used to work with resources with low quotas.
+type Semaphore chan struct{}
+
+// NewSemaphore returns a new semaphore with the specified capacity.
+func NewSemaphore(limit int) Semaphore {
+	return make(Semaphore, limit)
+}
+
+// Wait waits for a semaphore before continuing.
+func (s Semaphore) Wait() {
+	s <- struct{}{}
+}
+
+// Acquire waits for a semaphore before continuing, or until the context is done.
+func (s Semaphore) Acquire(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Notify releases a semaphore.
+func (s Semaphore) Notify() {
+	// Make the Notify non-blocking. This can happen if a Wait was never issued
+	select {
+	case <-s:
+	default:
+		log.Println("[WARN] Notifying semaphore without Wait")
+	}
+}
@@ -36,6 +36,16 @@ func FromContext(ctx context.Context) (*InContext, bool) {
 	return v, ok
 }
 
+// DefaultConfigFromContext returns the default tags configuration in Context, which has been scoped to the resource type.
+// If Context contains no tagging information, the specified provider-wide configuration is returned.
+func DefaultConfigFromContext(ctx context.Context, defaultConfig *DefaultConfig) *DefaultConfig {
+	if v, ok := FromContext(ctx); ok {
+		return v.DefaultConfig
+	}
+
+	return defaultConfig
+}
+
 type keyType int
 
 var tagKey keyType
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDefaultConfigFromContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	providerConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Environment": "test",
+		}),
+		Rules: []DefaultRule{
+			{
+				ResourceType: "aws_iam_*",
+				ExcludeKeys:  New(ctx, []string{"Environment"}),
+			},
+		},
+	}
+
+	if got, want := DefaultConfigFromContext(ctx, providerConfig), providerConfig; got != want {
+		t.Errorf("DefaultConfigFromContext() without tagging information = %v, want %v", got, want)
+	}
+
+	scopedConfig := providerConfig.ForResource("aws_iam_role", "iam")
+	ctx = NewContext(ctx, scopedConfig, nil)
+
+	got := DefaultConfigFromContext(ctx, providerConfig)
+	if got != scopedConfig {
+		t.Errorf("DefaultConfigFromContext() = %v, want %v", got, scopedConfig)
+	}
+	if len(got.Tags) != 0 {
+		t.Errorf("DefaultConfigFromContext() tags = %v, want none", got.Tags)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strconv"
@@ -32,7 +33,61 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	Tags  KeyValueTags
+	Rules []DefaultRule
+}
+
+// DefaultRule contains tags to default, and tag keys to exclude from defaulting,
+// for the resources matched by resource type name glob and/or service package name.
+type DefaultRule struct {
+	ResourceType string // Resource type name glob, e.g. "aws_iam_*"
+	Service      string // Service package name, e.g. "ec2"
+	Tags         KeyValueTags
+	ExcludeKeys  KeyValueTags
+}
+
+// Matches returns whether the rule applies to the specified resource type.
+// A rule with no resource type or service matches no resources.
+func (r DefaultRule) Matches(typeName, servicePackageName string) bool {
+	if r.ResourceType == "" && r.Service == "" {
+		return false
+	}
+
+	if r.ResourceType != "" {
+		// Resource type names contain no path separators so filepath.Match implements simple globbing.
+		if ok, _ := filepath.Match(r.ResourceType, typeName); !ok {
+			return false
+		}
+	}
+
+	if r.Service != "" && r.Service != servicePackageName {
+		return false
+	}
+
+	return true
+}
+
+// ForResource returns the DefaultConfig that applies to the specified resource type.
+// Matching rules are applied in order on top of the provider-wide tags:
+// each rule's tags are merged, overriding existing values, and then its excluded keys are removed.
+func (dc *DefaultConfig) ForResource(typeName, servicePackageName string) *DefaultConfig {
+	if dc == nil || len(dc.Rules) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+
+	for _, rule := range dc.Rules {
+		if !rule.Matches(typeName, servicePackageName) {
+			continue
+		}
+
+		tags = tags.Merge(rule.Tags).Ignore(rule.ExcludeKeys)
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -317,6 +317,111 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"CostCenter": "1234",
+			"Owner":      "team",
+		}),
+		Rules: []DefaultRule{
+			{
+				ResourceType: "aws_iam_*",
+				ExcludeKeys:  New(ctx, []string{"CostCenter"}),
+			},
+			{
+				Service: "ec2",
+				Tags: New(ctx, map[string]string{
+					"Backup": "daily",
+					"Owner":  "network",
+				}),
+			},
+			{
+				ResourceType: "aws_ec2_*",
+				Service:      "ec2",
+				ExcludeKeys:  New(ctx, []string{"Backup", "Owner"}),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		typeName           string
+		servicePackageName string
+		want               map[string]string
+	}{
+		{
+			name:               "nil config",
+			defaultConfig:      nil,
+			typeName:           "aws_iam_role",
+			servicePackageName: "iam",
+			want:               map[string]string{},
+		},
+		{
+			name:               "no matching rules",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_s3_bucket",
+			servicePackageName: "s3",
+			want: map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "team",
+			},
+		},
+		{
+			name:               "resource type glob exclusion",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_iam_role",
+			servicePackageName: "iam",
+			want: map[string]string{
+				"Owner": "team",
+			},
+		},
+		{
+			name:               "service tags",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_vpc",
+			servicePackageName: "ec2",
+			want: map[string]string{
+				"Backup":     "daily",
+				"CostCenter": "1234",
+				"Owner":      "network",
+			},
+		},
+		{
+			name:               "later rule overrides earlier rule",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_ec2_host",
+			servicePackageName: "ec2",
+			want: map[string]string{
+				"CostCenter": "1234",
+			},
+		},
+		{
+			name:               "resource type glob and service must both match",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_ec2_instance_state",
+			servicePackageName: "ec2instance",
+			want: map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "team",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.typeName, testCase.servicePackageName)
+			testKeyValueTagsVerifyMap(t, got.GetTags().Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
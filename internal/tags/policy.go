@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+// Valid values for a tag policy's enforcement.
+const (
+	PolicyEnforcementError = "error"
+	PolicyEnforcementWarn  = "warn"
+)
+
+func PolicyEnforcementValues() []string {
+	return []string{
+		PolicyEnforcementError,
+		PolicyEnforcementWarn,
+	}
+}
+
+// Valid values for a tag policy's key case.
+const (
+	PolicyKeyCaseLower  = "lower"
+	PolicyKeyCasePascal = "pascal"
+	PolicyKeyCaseUpper  = "upper"
+)
+
+func PolicyKeyCaseValues() []string {
+	return []string{
+		PolicyKeyCaseLower,
+		PolicyKeyCasePascal,
+		PolicyKeyCaseUpper,
+	}
+}
+
+var policyKeyCasePascalRegexp = regexache.MustCompile(`^[A-Z][0-9A-Za-z]*$`)
+
+// PolicyConfig contains the rules that a resource's tags, including any default tags, must satisfy.
+type PolicyConfig struct {
+	Enforcement  string // PolicyEnforcementError (the default) or PolicyEnforcementWarn
+	KeyCase      string // Case that all tag keys must use, if any
+	RequiredKeys []string
+	Rules        []PolicyRule
+}
+
+// PolicyRule constrains the case and value of a single tag key.
+type PolicyRule struct {
+	// Key is the tag key with the required case. Tag keys are matched case-insensitively.
+	Key string
+	// AllowedValues are the allowed tag values. A trailing "*" matches any suffix.
+	AllowedValues []string
+	ValueRegexp   *regexp.Regexp
+}
+
+// IsWarning returns whether policy violations are warnings rather than errors.
+func (pc *PolicyConfig) IsWarning() bool {
+	return pc != nil && pc.Enforcement == PolicyEnforcementWarn
+}
+
+// Violations returns a description of each way in which the specified tags do not satisfy the policy.
+func (pc *PolicyConfig) Violations(tags KeyValueTags) []string {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []string
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	// System tags are not subject to the policy.
+	keys = slices.DeleteFunc(keys, func(k string) bool {
+		return strings.HasPrefix(k, awsTagKeyPrefix)
+	})
+
+	findKey := func(key string) (string, bool) {
+		for _, k := range keys {
+			if strings.EqualFold(k, key) {
+				return k, true
+			}
+		}
+
+		return "", false
+	}
+
+	for _, key := range pc.RequiredKeys {
+		if _, ok := findKey(key); !ok {
+			violations = append(violations, fmt.Sprintf("required tag key (%s) is missing", key))
+		}
+	}
+
+	if pc.KeyCase != "" {
+		for _, k := range keys {
+			if !isPolicyKeyCase(k, pc.KeyCase) {
+				violations = append(violations, fmt.Sprintf("tag key (%s) is not %s case", k, pc.KeyCase))
+			}
+		}
+	}
+
+	for _, rule := range pc.Rules {
+		k, ok := findKey(rule.Key)
+		if !ok {
+			continue
+		}
+
+		if k != rule.Key {
+			violations = append(violations, fmt.Sprintf("tag key (%s) must be written as %s", k, rule.Key))
+		}
+
+		v := tags.KeyValue(k)
+		if v == nil {
+			v = new(string)
+		}
+
+		if len(rule.AllowedValues) > 0 && !slices.ContainsFunc(rule.AllowedValues, func(allowed string) bool {
+			return policyValueMatches(allowed, *v)
+		}) {
+			violations = append(violations, fmt.Sprintf("tag %s value (%s) is not one of the allowed values (%s)", k, *v, strings.Join(rule.AllowedValues, ", ")))
+		}
+
+		if rule.ValueRegexp != nil && !rule.ValueRegexp.MatchString(*v) {
+			violations = append(violations, fmt.Sprintf("tag %s value (%s) does not match %q", k, *v, rule.ValueRegexp.String()))
+		}
+	}
+
+	return violations
+}
+
+// ViolationsForChange returns the policy violations of a resource's new tags if the resource is being created or its tags change.
+// The tags of existing resources are otherwise not checked, so that adopting or changing a policy doesn't block unrelated updates.
+func (pc *PolicyConfig) ViolationsForChange(isNewResource bool, oldTags, newTags KeyValueTags) []string {
+	if !isNewResource && oldTags.DeepEqual(newTags) {
+		return nil
+	}
+
+	return pc.Violations(newTags)
+}
+
+func isPolicyKeyCase(key, keyCase string) bool {
+	switch keyCase {
+	case PolicyKeyCaseLower:
+		return key == strings.ToLower(key)
+	case PolicyKeyCaseUpper:
+		return key == strings.ToUpper(key)
+	case PolicyKeyCasePascal:
+		return policyKeyCasePascalRegexp.MatchString(key)
+	default:
+		return true
+	}
+}
+
+func policyValueMatches(allowed, value string) bool {
+	if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+		return strings.HasPrefix(value, prefix)
+	}
+
+	return allowed == value
+}
+
+// PolicyRulesFromOrganizationsPolicy returns tag policy rules from an AWS Organizations tag policy document.
+// Both policy documents, using the "@@assign" inheritance operator, and effective policy documents are supported.
+// The "enforced_for" element is ignored, rules apply to all resources.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html.
+func PolicyRulesFromOrganizationsPolicy(document string) ([]PolicyRule, error) {
+	var policy struct {
+		Tags map[string]struct {
+			TagKey   json.RawMessage `json:"tag_key"`
+			TagValue json.RawMessage `json:"tag_value"`
+		} `json:"tags"`
+	}
+
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return nil, fmt.Errorf("parsing AWS Organizations tag policy: %w", err)
+	}
+
+	tagNames := make([]string, 0, len(policy.Tags))
+	for name := range policy.Tags {
+		tagNames = append(tagNames, name)
+	}
+	slices.Sort(tagNames)
+
+	rules := make([]PolicyRule, 0, len(tagNames))
+
+	for _, name := range tagNames {
+		tag := policy.Tags[name]
+		rule := PolicyRule{
+			Key: name,
+		}
+
+		if len(tag.TagKey) > 0 {
+			var key string
+			if err := unmarshalOrganizationsPolicyValue(tag.TagKey, &key); err != nil {
+				return nil, fmt.Errorf("parsing AWS Organizations tag policy tag (%s) tag_key: %w", name, err)
+			}
+
+			if !strings.EqualFold(key, name) {
+				return nil, fmt.Errorf("AWS Organizations tag policy tag (%s) tag_key (%s) does not match", name, key)
+			}
+
+			rule.Key = key
+		}
+
+		if len(tag.TagValue) > 0 {
+			if err := unmarshalOrganizationsPolicyValue(tag.TagValue, &rule.AllowedValues); err != nil {
+				return nil, fmt.Errorf("parsing AWS Organizations tag policy tag (%s) tag_value: %w", name, err)
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// unmarshalOrganizationsPolicyValue unmarshals a policy value that may be wrapped in an "@@assign" operator.
+func unmarshalOrganizationsPolicyValue(data json.RawMessage, v any) error {
+	var operators map[string]json.RawMessage
+	if err := json.Unmarshal(data, &operators); err == nil {
+		assign, ok := operators["@@assign"]
+		if !ok {
+			return fmt.Errorf("no @@assign operator")
+		}
+
+		data = assign
+	}
+
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		tags         map[string]string
+		want         []string
+	}{
+		{
+			name:         "nil config",
+			policyConfig: nil,
+			tags:         map[string]string{"key1": "value1"},
+		},
+		{
+			name: "compliant",
+			policyConfig: &PolicyConfig{
+				KeyCase:      PolicyKeyCasePascal,
+				RequiredKeys: []string{"CostCenter", "Environment"},
+				Rules: []PolicyRule{
+					{Key: "CostCenter", AllowedValues: []string{"100", "2*"}},
+					{Key: "Environment", ValueRegexp: regexp.MustCompile(`^(dev|prod)$`)},
+				},
+			},
+			tags: map[string]string{
+				"aws:cloudformation:stack-name": "ignored",
+				"CostCenter":                    "200",
+				"Environment":                   "prod",
+			},
+		},
+		{
+			name: "missing required keys",
+			policyConfig: &PolicyConfig{
+				RequiredKeys: []string{"CostCenter", "Owner"},
+			},
+			tags: map[string]string{"costcenter": "100"},
+			want: []string{
+				"required tag key (Owner) is missing",
+			},
+		},
+		{
+			name: "key case",
+			policyConfig: &PolicyConfig{
+				KeyCase: PolicyKeyCaseLower,
+			},
+			tags: map[string]string{"owner": "a", "Owner2": "b"},
+			want: []string{
+				"tag key (Owner2) is not lower case",
+			},
+		},
+		{
+			name: "rule key case and values",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "CostCenter", AllowedValues: []string{"100"}},
+					{Key: "Environment", ValueRegexp: regexp.MustCompile(`^(dev|prod)$`)},
+				},
+			},
+			tags: map[string]string{"costcenter": "300", "Environment": "test"},
+			want: []string{
+				"tag key (costcenter) must be written as CostCenter",
+				"tag costcenter value (300) is not one of the allowed values (100)",
+				`tag Environment value (test) does not match "^(dev|prod)$"`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policyConfig.Violations(New(ctx, testCase.tags))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigViolationsForChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policyConfig := &PolicyConfig{
+		RequiredKeys: []string{"Owner"},
+	}
+	testCases := []struct {
+		name          string
+		isNewResource bool
+		oldTags       map[string]string
+		newTags       map[string]string
+		want          []string
+	}{
+		{
+			name:          "create",
+			isNewResource: true,
+			newTags:       map[string]string{"key1": "value1"},
+			want: []string{
+				"required tag key (Owner) is missing",
+			},
+		},
+		{
+			name:    "update tags unchanged",
+			oldTags: map[string]string{"key1": "value1"},
+			newTags: map[string]string{"key1": "value1"},
+		},
+		{
+			name:    "update tags changed",
+			oldTags: map[string]string{"key1": "value1"},
+			newTags: map[string]string{"key1": "value2"},
+			want: []string{
+				"required tag key (Owner) is missing",
+			},
+		},
+		{
+			name:    "update tags removed",
+			oldTags: map[string]string{"key1": "value1", "Owner": "me"},
+			newTags: map[string]string{"key1": "value1"},
+			want: []string{
+				"required tag key (Owner) is missing",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := policyConfig.ViolationsForChange(testCase.isNewResource, New(ctx, testCase.oldTags), New(ctx, testCase.newTags))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyRulesFromOrganizationsPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		document  string
+		want      []PolicyRule
+		expectErr bool
+	}{
+		{
+			name: "policy",
+			document: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200*"]},
+      "enforced_for": {"@@assign": ["ec2:instance"]}
+    },
+    "project": {
+      "tag_key": {"@@assign": "Project"}
+    }
+  }
+}`,
+			want: []PolicyRule{
+				{Key: "CostCenter", AllowedValues: []string{"100", "200*"}},
+				{Key: "Project"},
+			},
+		},
+		{
+			name:     "effective policy",
+			document: `{"tags": {"costcenter": {"tag_key": "CostCenter", "tag_value": ["100"]}}}`,
+			want: []PolicyRule{
+				{Key: "CostCenter", AllowedValues: []string{"100"}},
+			},
+		},
+		{
+			name:      "mismatched tag key",
+			document:  `{"tags": {"costcenter": {"tag_key": {"@@assign": "Owner"}}}}`,
+			expectErr: true,
+		},
+		{
+			name:      "invalid JSON",
+			document:  `{"tags":`,
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := PolicyRulesFromOrganizationsPolicy(testCase.document)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Fatalf("err %t, want %t (%v)", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ProtectConfig contains the tags that protect a resource from being destroyed, either directly or by replacement.
+type ProtectConfig struct {
+	// TagKeys are tag keys that protect a resource whatever their value.
+	TagKeys []string
+	// Tags are tags that protect a resource when both key and value match.
+	Tags KeyValueTags
+}
+
+// Match returns a description of the first of the specified tags that protects the resource from being destroyed, if any.
+// Tag keys and values are matched case-sensitively.
+func (pc *ProtectConfig) Match(tags KeyValueTags) (string, bool) {
+	if pc == nil {
+		return "", false
+	}
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		if slices.Contains(pc.TagKeys, k) {
+			return k, true
+		}
+
+		if !pc.Tags.KeyExists(k) {
+			continue
+		}
+
+		want, got := pc.Tags.KeyValue(k), tags.KeyValue(k)
+		if want == nil {
+			want = new(string)
+		}
+		if got == nil {
+			got = new(string)
+		}
+
+		if *want == *got {
+			return fmt.Sprintf("%s=%s", k, *got), true
+		}
+	}
+
+	return "", false
+}
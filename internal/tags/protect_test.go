@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestProtectConfigMatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		protectConfig *ProtectConfig
+		tags          map[string]string
+		want          string
+		wantOK        bool
+	}{
+		{
+			name:          "nil config",
+			protectConfig: nil,
+			tags:          map[string]string{"DoNotDelete": "true"},
+		},
+		{
+			name: "no tags",
+			protectConfig: &ProtectConfig{
+				Tags: New(ctx, map[string]string{"DoNotDelete": "true"}),
+			},
+		},
+		{
+			name: "tag matches",
+			protectConfig: &ProtectConfig{
+				Tags: New(ctx, map[string]string{"DoNotDelete": "true"}),
+			},
+			tags:   map[string]string{"DoNotDelete": "true", "Name": "test"},
+			want:   "DoNotDelete=true",
+			wantOK: true,
+		},
+		{
+			name: "tag value differs",
+			protectConfig: &ProtectConfig{
+				Tags: New(ctx, map[string]string{"DoNotDelete": "true"}),
+			},
+			tags: map[string]string{"DoNotDelete": "false"},
+		},
+		{
+			name: "tag key case differs",
+			protectConfig: &ProtectConfig{
+				Tags: New(ctx, map[string]string{"DoNotDelete": "true"}),
+			},
+			tags: map[string]string{"donotdelete": "true"},
+		},
+		{
+			name: "tag key matches",
+			protectConfig: &ProtectConfig{
+				TagKeys: []string{"Protected"},
+			},
+			tags:   map[string]string{"Protected": "anything"},
+			want:   "Protected",
+			wantOK: true,
+		},
+		{
+			name: "empty value",
+			protectConfig: &ProtectConfig{
+				Tags: New(ctx, map[string]string{"Protected": ""}),
+			},
+			tags:   map[string]string{"Protected": ""},
+			want:   "Protected=",
+			wantOK: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := testCase.protectConfig.Match(New(ctx, testCase.tags))
+
+			if got, want := ok, testCase.wantOK; got != want {
+				t.Errorf("Match ok = %t, want %t", got, want)
+			}
+			if got, want := got, testCase.want; got != want {
+				t.Errorf("Match = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -9,12 +9,15 @@ import (
 	"fmt"
 	"math/rand"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Retryable is a function that is used to decide if a function's error is retryable or not.
@@ -25,17 +28,23 @@ type Retryable func(error) (bool, error)
 
 // RetryWhen retries the function `f` when the error it returns satisfies `retryable`.
 // `f` is retried until `timeout` expires.
-func RetryWhen(ctx context.Context, timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
-	var output interface{}
-
-	err := Retry(ctx, timeout, func() *retry.RetryError {
+func RetryWhen(ctx context.Context, timeout time.Duration, f func() (interface{}, error), retryable Retryable) (output interface{}, err error) {
+	ctx, span := startLoopSpan(ctx, "RetryWhen", timeout)
+	var attempts atomic.Int64
+	defer func() {
+		endLoopSpan(span, attempts.Load(), err)
+	}()
+
+	err = Retry(ctx, timeout, func() *retry.RetryError {
 		var err error
 		var again bool
 
+		n := attempts.Add(1)
 		output, err = f()
 		again, err = retryable(err)
 
 		if again {
+			span.AddEvent(tracing.EventRetry, trace.WithAttributes(tracing.AttrAttempts.Int64(n)))
 			return retry.RetryableError(err)
 		}
 
@@ -47,6 +56,7 @@ func RetryWhen(ctx context.Context, timeout time.Duration, f func() (interface{}
 	})
 
 	if TimedOut(err) {
+		attempts.Add(1)
 		output, err = f()
 	}
 
@@ -60,17 +70,23 @@ func RetryWhen(ctx context.Context, timeout time.Duration, f func() (interface{}
 // RetryGWhen is the generic version of RetryWhen which obviates the need for a type
 // assertion after the call. It retries the function `f` when the error it returns
 // satisfies `retryable`. `f` is retried until `timeout` expires.
-func RetryGWhen[T any](ctx context.Context, timeout time.Duration, f func() (T, error), retryable Retryable) (T, error) {
-	var output T
-
-	err := Retry(ctx, timeout, func() *retry.RetryError {
+func RetryGWhen[T any](ctx context.Context, timeout time.Duration, f func() (T, error), retryable Retryable) (output T, err error) {
+	ctx, span := startLoopSpan(ctx, "RetryWhen", timeout)
+	var attempts atomic.Int64
+	defer func() {
+		endLoopSpan(span, attempts.Load(), err)
+	}()
+
+	err = Retry(ctx, timeout, func() *retry.RetryError {
 		var err error
 		var again bool
 
+		n := attempts.Add(1)
 		output, err = f()
 		again, err = retryable(err)
 
 		if again {
+			span.AddEvent(tracing.EventRetry, trace.WithAttributes(tracing.AttrAttempts.Int64(n)))
 			return retry.RetryableError(err)
 		}
 
@@ -82,6 +98,7 @@ func RetryGWhen[T any](ctx context.Context, timeout time.Duration, f func() (T,
 	})
 
 	if TimedOut(err) {
+		attempts.Add(1)
 		output, err = f()
 	}
 
@@ -389,7 +406,7 @@ func Retry(ctx context.Context, timeout time.Duration, f retry.RetryFunc, optFns
 
 	options.Apply(c)
 
-	_, waitErr := c.WaitForStateContext(ctx)
+	_, waitErr := WaitForStateContext(ctx, c)
 
 	// Need to acquire the lock here to be able to avoid race using resultErr as
 	// the return value
@@ -419,3 +436,14 @@ func (d deadline) Remaining() time.Duration {
 		return v
 	}
 }
+
+// startLoopSpan starts a span for a retry or wait loop.
+func startLoopSpan(ctx context.Context, name string, timeout time.Duration) (context.Context, trace.Span) {
+	return tracing.Start(ctx, name, tracing.AttrTimeout.String(timeout.String()))
+}
+
+// endLoopSpan ends a retry or wait loop's span, recording the number of attempts made.
+func endLoopSpan(span trace.Span, attempts int64, err error) {
+	span.SetAttributes(tracing.AttrAttempts.Int64(attempts))
+	tracing.End(span, err)
+}
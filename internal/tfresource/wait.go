@@ -5,9 +5,12 @@ package tfresource
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type WaitOpts struct {
@@ -17,6 +20,43 @@ type WaitOpts struct {
 	PollInterval              time.Duration // Override MinTimeout/backoff and only poll this often.
 }
 
+const (
+	// sleeplessPollInterval is the poll interval used by state change waits when sleepless waits are enabled.
+	sleeplessPollInterval = 1 * time.Millisecond
+)
+
+// sleepless controls whether state change waits skip their configured delays and poll intervals.
+// It is process-wide and is only ever enabled while replaying recorded (VCR) acceptance tests.
+var sleepless atomic.Bool
+
+// SetSleepless enables or disables sleepless state change waits for the whole process.
+// Sleepless waits are only for replaying recorded (VCR) acceptance tests, where the remote
+// state changes being waited for have already happened and waiting serves no purpose.
+// SetSleepless must never be called outside of VCR replay: against real AWS APIs,
+// sleepless waits poll without pausing and so would throttle the provider's requests.
+func SetSleepless(v bool) {
+	sleepless.Store(v)
+}
+
+// IsSleepless returns whether sleepless state change waits are enabled.
+func IsSleepless() bool {
+	return sleepless.Load()
+}
+
+// WaitForStateContext waits for the state change described by `conf` to complete.
+// If sleepless waits are enabled the configured Delay, MinTimeout and PollInterval are overridden
+// so that the state is refreshed without pausing. Timeout is still honored.
+// All state change waits should be made via this function rather than by calling `conf.WaitForStateContext` directly.
+func WaitForStateContext(ctx context.Context, conf *retry.StateChangeConf) (interface{}, error) {
+	if IsSleepless() {
+		conf.Delay = 0
+		conf.MinTimeout = 0
+		conf.PollInterval = sleeplessPollInterval
+	}
+
+	return conf.WaitForStateContext(ctx)
+}
+
 const (
 	targetStateError = "ERROR"
 	targetStateFalse = "FALSE"
@@ -27,8 +67,18 @@ const (
 // If `f` returns an error, return immediately with that error.
 // If `timeout` is exceeded before `f` returns `true`, return an error.
 // Waits between calls to `f` using exponential backoff, except when waiting for the target state to reoccur.
-func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error), opts WaitOpts) error {
+func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error), opts WaitOpts) (err error) {
+	ctx, span := startLoopSpan(ctx, "WaitUntil", timeout)
+	var attempts atomic.Int64
+	defer func() {
+		endLoopSpan(span, attempts.Load(), err)
+	}()
+
 	refresh := func() (interface{}, string, error) {
+		if n := attempts.Add(1); n > 1 {
+			span.AddEvent(tracing.EventRetry, trace.WithAttributes(tracing.AttrAttempts.Int64(n)))
+		}
+
 		done, err := f()
 
 		if err != nil {
@@ -53,7 +103,7 @@ func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error
 		PollInterval:              opts.PollInterval,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err = WaitForStateContext(ctx, stateConf)
 
 	return err
 }
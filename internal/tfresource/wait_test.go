@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -70,3 +71,41 @@ func TestWaitUntil(t *testing.T) { //nolint:tparallel
 		})
 	}
 }
+
+func TestWaitForStateContextSleepless(t *testing.T) { //nolint:paralleltest // modifies global state
+	ctx := acctest.Context(t)
+
+	tfresource.SetSleepless(true)
+	t.Cleanup(func() {
+		tfresource.SetSleepless(false)
+	})
+
+	var refreshCount int32
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			if atomic.AddInt32(&refreshCount, 1) < 5 {
+				return 42, "pending", nil
+			}
+
+			return 42, "done", nil
+		},
+		Timeout:    time.Hour,
+		Delay:      time.Hour,
+		MinTimeout: time.Hour,
+	}
+
+	start := time.Now()
+	if _, err := tfresource.WaitForStateContext(ctx, stateConf); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("sleepless wait took %s", elapsed)
+	}
+
+	if got, want := atomic.LoadInt32(&refreshCount), int32(5); got != want {
+		t.Errorf("got %d refreshes, expected %d", got, want)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AppendSDKv2Middlewares registers middleware that creates a span for each AWS SDK for Go v2 API call.
+// Each attempt, retry and throttling error is recorded on the API call's span.
+func AppendSDKv2Middlewares(cfg *aws_sdkv2.Config) {
+	otelaws.AppendMiddlewares(&cfg.APIOptions)
+	cfg.APIOptions = append(cfg.APIOptions, addAttemptMiddleware)
+}
+
+func addAttemptMiddleware(stack *middleware.Stack) error {
+	if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TracingAttemptState", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		ctx = middleware.WithStackValue(ctx, attemptStateKey{}, &attemptState{})
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After); err != nil {
+		return err
+	}
+
+	// Finalize middleware added after the Retry middleware is run for each attempt.
+	return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("TracingAttempt", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		state, ok := middleware.GetStackValue(ctx, attemptStateKey{}).(*attemptState)
+		if !ok {
+			return next.HandleFinalize(ctx, in)
+		}
+
+		span := trace.SpanFromContext(ctx)
+
+		state.attempts++
+		if state.attempts > 1 {
+			span.AddEvent(EventRetry, trace.WithAttributes(AttrAttempts.Int(state.attempts)))
+		}
+		span.SetAttributes(AttrAttempts.Int(state.attempts))
+
+		out, metadata, err := next.HandleFinalize(ctx, in)
+
+		if err != nil && retry_sdkv2.IsErrorThrottles(retry_sdkv2.DefaultThrottles).IsErrorThrottle(err) == aws_sdkv2.TrueTernary {
+			state.throttles++
+			span.AddEvent(EventThrottle, trace.WithAttributes(attribute.String("error", err.Error())))
+			span.SetAttributes(AttrThrottles.Int(state.throttles))
+		}
+
+		return out, metadata, err
+	}), middleware.After)
+}
+
+// attemptState counts the attempts made by a single API call.
+type attemptState struct {
+	attempts  int
+	throttles int
+}
+
+type attemptStateKey struct{}
+
+// AddSDKv1Handlers registers request handlers that create a span for each AWS SDK for Go v1 API call.
+func AddSDKv1Handlers(handlers *request_sdkv1.Handlers) {
+	handlers.Validate.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "tracing.Start",
+		Fn: func(r *request_sdkv1.Request) {
+			ctx, _ := Start(r.Context(), r.ClientInfo.ServiceID+"."+r.Operation.Name,
+				attrAWSSystem.String(awsSystemValue),
+				attrAWSService.String(r.ClientInfo.ServiceID),
+				attrAWSOperation.String(r.Operation.Name),
+				attrAWSRegion.String(aws_sdkv1.StringValue(r.Config.Region)),
+			)
+			r.SetContext(ctx)
+		},
+	})
+	handlers.AfterRetry.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tracing.AfterRetry",
+		Fn: func(r *request_sdkv1.Request) {
+			span := trace.SpanFromContext(r.Context())
+
+			if r.Error != nil && request_sdkv1.IsErrorThrottle(r.Error) {
+				span.AddEvent(EventThrottle, trace.WithAttributes(attribute.String("error", r.Error.Error())))
+			}
+
+			if r.WillRetry() {
+				span.AddEvent(EventRetry, trace.WithAttributes(AttrAttempts.Int(r.RetryCount+1)))
+			}
+		},
+	})
+	handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tracing.End",
+		Fn: func(r *request_sdkv1.Request) {
+			span := trace.SpanFromContext(r.Context())
+
+			span.SetAttributes(AttrAttempts.Int(r.RetryCount + 1))
+			if r.RequestID != "" {
+				span.SetAttributes(attrAWSRequestID.String(r.RequestID))
+			}
+			if r.HTTPResponse != nil {
+				span.SetAttributes(attrHTTPStatusCode.Int(r.HTTPResponse.StatusCode))
+			}
+
+			End(span, r.Error)
+		},
+	})
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// NewProviderServer returns a terraform-plugin-go protocol v5 provider server that creates a span for each Terraform RPC
+// that operates on a resource, data source or function, before delegating to the specified server.
+func NewProviderServer(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return &providerServer{
+		ProviderServer: server,
+	}
+}
+
+var (
+	_ tfprotov5.ProviderServerWithActions      = &providerServer{}
+	_ tfprotov5.ProviderServerWithListResource = &providerServer{}
+)
+
+type providerServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s *providerServer) ConfigureProvider(ctx context.Context, request *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	ctx, span := Start(ctx, "ConfigureProvider")
+	response, err := s.ProviderServer.ConfigureProvider(ctx, request)
+	End(span, rpcError(response, err))
+
+	return response, err
+}
+
+func (s *providerServer) ValidateResourceTypeConfig(ctx context.Context, request *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	ctx, span := Start(ctx, "ValidateResourceTypeConfig", AttrResourceType.String(request.TypeName))
+	response, err := s.ProviderServer.ValidateResourceTypeConfig(ctx, request)
+	End(span, rpcError(response, err))
+
+	return response, err
+}
+
+func (s *providerServer) UpgradeResourceState(ctx context.Context, request *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	ctx, span := Start(ctx, "UpgradeResourceState", AttrResourceType.String(request.TypeName))
+	response, err := s.ProviderServer.UpgradeResourceState(ctx, request)
+	End(span, rpcError(response, err))
+
+	return response, err
+}
+
+func (s *providerServer) ReadResource(ctx context.Context, request *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	ctx, span := Start(ctx, "ReadResource", AttrResourceType.String(request.TypeName))
+	response, err := s.ProviderServer.ReadResource(ctx, request)
+	End(span, rpcError(response, err))
+
+	return response, err
+}
+
+func (s *providerServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx, span := Start(ctx, "PlanResourceChange", AttrResourceType.String(request.TypeName))
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+	End(span, rpcError(response, err))
+
+	return response, err
+}
+
+func (s *providerServer) ApplyResourceChange(ctx context.Context, request *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	ctx, span := Start(ctx, "ApplyResourceChange", AttrResourceType.String(request.TypeName))
+	response, err := s.ProviderServer.ApplyResourceChange(ctx, request)
+	End(span, rpcError(response, err))
+
+	return response, err
+}
+
+func (s *providerServer) ImportResourceState(ctx context.Context, request *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	ctx, span := Start(ctx, "ImportResourceState", AttrResourceType.String(request.TypeName))
+	response, err := s.ProviderServer.ImportResourceState(ctx, request)
+	End(span, rpcError(response, err))
+
+	return response, err
+}
+
+func (s *providerServer) MoveResourceState(ctx context.Context, request *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	ctx, span := Start(ctx, "MoveResourceState", AttrResourceType.String(request.TargetTypeName))
+	response, err := s.ProviderServer.MoveResourceState(ctx, request)
+	End(span, rpcError(response, err))
+
+	return response, err
+}
+
+func (s *providerServer) ValidateDataSourceConfig(ctx context.Context, request *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	ctx, span := Start(ctx, "ValidateDataSourceConfig", AttrResourceType.String(request.TypeName))
+	response, err := s.ProviderServer.ValidateDataSourceConfig(ctx, request)
+	End(span, rpcError(response, err))
+
+	return response, err
+}
+
+func (s *providerServer) ReadDataSource(ctx context.Context, request *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	ctx, span := Start(ctx, "ReadDataSource", AttrResourceType.String(request.TypeName))
+	response, err := s.ProviderServer.ReadDataSource(ctx, request)
+	End(span, rpcError(response, err))
+
+	return response, err
+}
+
+func (s *providerServer) CallFunction(ctx context.Context, request *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	ctx, span := Start(ctx, "CallFunction", attribute.String("tf_aws.function_name", request.Name))
+	response, err := s.ProviderServer.CallFunction(ctx, request)
+	if err == nil && response != nil && response.Error != nil {
+		err = errors.New(response.Error.Text)
+	}
+	End(span, err)
+
+	return response, err
+}
+
+// List resource and action RPCs are passed through, untraced, if the wrapped server supports them.
+
+func (s *providerServer) ValidateListResourceConfig(ctx context.Context, request *tfprotov5.ValidateListResourceConfigRequest) (*tfprotov5.ValidateListResourceConfigResponse, error) {
+	v, ok := s.ProviderServer.(tfprotov5.ProviderServerWithListResource)
+	if !ok {
+		return nil, errUnsupportedRPC("ValidateListResourceConfig")
+	}
+
+	return v.ValidateListResourceConfig(ctx, request)
+}
+
+func (s *providerServer) ListResource(ctx context.Context, request *tfprotov5.ListResourceRequest) (*tfprotov5.ListResourceServerStream, error) {
+	v, ok := s.ProviderServer.(tfprotov5.ProviderServerWithListResource)
+	if !ok {
+		return nil, errUnsupportedRPC("ListResource")
+	}
+
+	return v.ListResource(ctx, request)
+}
+
+func (s *providerServer) ValidateActionConfig(ctx context.Context, request *tfprotov5.ValidateActionConfigRequest) (*tfprotov5.ValidateActionConfigResponse, error) {
+	v, ok := s.ProviderServer.(tfprotov5.ProviderServerWithActions)
+	if !ok {
+		return nil, errUnsupportedRPC("ValidateActionConfig")
+	}
+
+	return v.ValidateActionConfig(ctx, request)
+}
+
+func (s *providerServer) PlanAction(ctx context.Context, request *tfprotov5.PlanActionRequest) (*tfprotov5.PlanActionResponse, error) {
+	v, ok := s.ProviderServer.(tfprotov5.ProviderServerWithActions)
+	if !ok {
+		return nil, errUnsupportedRPC("PlanAction")
+	}
+
+	return v.PlanAction(ctx, request)
+}
+
+func (s *providerServer) InvokeAction(ctx context.Context, request *tfprotov5.InvokeActionRequest) (*tfprotov5.InvokeActionServerStream, error) {
+	v, ok := s.ProviderServer.(tfprotov5.ProviderServerWithActions)
+	if !ok {
+		return nil, errUnsupportedRPC("InvokeAction")
+	}
+
+	return v.InvokeAction(ctx, request)
+}
+
+func errUnsupportedRPC(name string) error {
+	return fmt.Errorf("%s RPC is not supported by the provider server", name)
+}
+
+// rpcError returns the RPC's error or an error summarizing any error diagnostics in the RPC's response.
+func rpcError(response any, err error) error {
+	if err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(response)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return nil
+	}
+
+	diags, ok := v.Elem().FieldByName("Diagnostics").Interface().([]*tfprotov5.Diagnostic)
+	if !ok {
+		return nil
+	}
+
+	var summaries []string
+	for _, d := range diags {
+		if d != nil && d.Severity == tfprotov5.DiagnosticSeverityError {
+			summaries = append(summaries, d.Summary)
+		}
+	}
+
+	if len(summaries) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(summaries, "; "))
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tracing implements opt-in OpenTelemetry tracing of the provider's
+// Terraform RPCs, CRUD handlers, retry and wait loops, and AWS API calls.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/hashicorp/terraform-provider-aws"
+	serviceName         = "terraform-provider-aws"
+)
+
+// Valid values for the tracing exporter.
+const (
+	ExporterFile = "file"
+	ExporterOTLP = "otlp"
+)
+
+func ExporterValues() []string {
+	return []string{
+		ExporterFile,
+		ExporterOTLP,
+	}
+}
+
+// Span attribute keys.
+const (
+	AttrAttempts       = attribute.Key("tf_aws.attempts")
+	AttrOperation      = attribute.Key("tf_aws.operation")
+	AttrResourceName   = attribute.Key("tf_aws.resource_name")
+	AttrResourceType   = attribute.Key("tf_aws.resource_type")
+	AttrServicePackage = attribute.Key("tf_aws.service_package")
+	AttrThrottles      = attribute.Key("tf_aws.throttles")
+	AttrTimeout        = attribute.Key("tf_aws.timeout")
+
+	attrAWSOperation   = attribute.Key("rpc.method")
+	attrAWSRegion      = attribute.Key("aws.region")
+	attrAWSRequestID   = attribute.Key("aws.request_id")
+	attrAWSService     = attribute.Key("rpc.service")
+	attrAWSSystem      = attribute.Key("rpc.system")
+	attrHTTPStatusCode = attribute.Key("http.status_code")
+	attrServiceName    = attribute.Key("service.name")
+	attrServiceVersion = attribute.Key("service.version")
+)
+
+// Span event names.
+const (
+	EventRetry    = "retry"
+	EventThrottle = "throttle"
+)
+
+const (
+	awsSystemValue = "aws-api"
+)
+
+// Config is the provider's tracing configuration.
+type Config struct {
+	Endpoint string            // OTLP/HTTP endpoint URL. If empty, the OTEL_EXPORTER_OTLP_* environment variables are used.
+	Exporter string            // ExporterOTLP or ExporterFile
+	FilePath string            // File that spans are written to by ExporterFile
+	Headers  map[string]string // Additional HTTP headers sent by ExporterOTLP
+	Insecure bool              // Whether ExporterOTLP uses HTTP rather than HTTPS
+}
+
+var (
+	tracerProviderMu sync.Mutex
+	tracerProvider   *sdktrace.TracerProvider
+	exporterFile     *os.File
+)
+
+// Configure configures the process-wide tracer provider.
+// All provider configurations, including aliased configurations, share a single process so only the first call has any effect.
+func Configure(ctx context.Context, config *Config) error {
+	if config == nil {
+		return nil
+	}
+
+	tracerProviderMu.Lock()
+	defer tracerProviderMu.Unlock()
+
+	if tracerProvider != nil {
+		tflog.Debug(ctx, "tracing already configured")
+		return nil
+	}
+
+	var exporter sdktrace.SpanExporter
+
+	switch config.Exporter {
+	case ExporterFile:
+		if config.FilePath == "" {
+			return errors.New("file_path is required for the file exporter")
+		}
+
+		f, err := os.OpenFile(config.FilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return fmt.Errorf("opening tracing file (%s): %w", config.FilePath, err)
+		}
+
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return fmt.Errorf("creating file exporter: %w", err)
+		}
+
+		exporterFile = f
+	case ExporterOTLP:
+		var opts []otlptracehttp.Option
+
+		if config.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(config.Endpoint))
+		}
+		if len(config.Headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(config.Headers))
+		}
+		if config.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+
+		var err error
+		exporter, err = otlptracehttp.New(ctx, opts...)
+		if err != nil {
+			return fmt.Errorf("creating OTLP exporter: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported exporter (%s)", config.Exporter)
+	}
+
+	tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attrServiceName.String(serviceName),
+			attrServiceVersion.String(version.ProviderVersion),
+		)),
+	)
+	otel.SetTracerProvider(tracerProvider)
+
+	tflog.Info(ctx, "tracing configured", map[string]any{
+		"tf_aws.tracing.exporter": config.Exporter,
+	})
+
+	return nil
+}
+
+// Enabled returns whether tracing has been configured.
+func Enabled() bool {
+	tracerProviderMu.Lock()
+	defer tracerProviderMu.Unlock()
+
+	return tracerProvider != nil
+}
+
+// Shutdown flushes any buffered spans and stops the tracer provider.
+// It must be called before the provider process exits.
+func Shutdown(ctx context.Context) error {
+	tracerProviderMu.Lock()
+	defer tracerProviderMu.Unlock()
+
+	if tracerProvider == nil {
+		return nil
+	}
+
+	err := tracerProvider.Shutdown(ctx)
+	tracerProvider = nil
+
+	if exporterFile != nil {
+		err = errors.Join(err, exporterFile.Close())
+		exporterFile = nil
+	}
+
+	return err
+}
+
+// Start starts a span with the specified name and attributes.
+// The returned span is a no-op if tracing is not configured.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End ends the span, recording any error.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestConfigureFileExporter(t *testing.T) {
+	ctx := context.Background()
+	filePath := filepath.Join(t.TempDir(), "spans.json")
+
+	if err := Configure(ctx, &Config{Exporter: ExporterFile, FilePath: filePath}); err != nil {
+		t.Fatalf("Configure: %s", err)
+	}
+	if !Enabled() {
+		t.Fatal("expected tracing to be enabled")
+	}
+
+	ctx, span := Start(ctx, "Create", AttrOperation.String("Create"))
+	_, child := Start(ctx, "RetryWhen")
+	End(child, errors.New("retryable"))
+	End(span, nil)
+
+	if err := Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %s", err)
+	}
+	if Enabled() {
+		t.Fatal("expected tracing to be disabled")
+	}
+
+	b, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("reading spans: %s", err)
+	}
+
+	for _, want := range []string{`"Name":"Create"`, `"Name":"RetryWhen"`, `"Description":"retryable"`, `"tf_aws.operation"`} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("spans do not contain %s", want)
+		}
+	}
+}
+
+func TestConfigureErrors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name   string
+		config *Config
+	}{
+		{
+			name:   "file exporter without path",
+			config: &Config{Exporter: ExporterFile},
+		},
+		{
+			name:   "unsupported exporter",
+			config: &Config{Exporter: "zipkin"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := Configure(ctx, testCase.config); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestRPCError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		response any
+		err      error
+		want     string
+	}{
+		{
+			name:     "nil response",
+			response: (*tfprotov5.ReadResourceResponse)(nil),
+		},
+		{
+			name: "warnings only",
+			response: &tfprotov5.ReadResourceResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{Severity: tfprotov5.DiagnosticSeverityWarning, Summary: "warning"},
+				},
+			},
+		},
+		{
+			name: "errors",
+			response: &tfprotov5.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{Severity: tfprotov5.DiagnosticSeverityError, Summary: "error 1"},
+					{Severity: tfprotov5.DiagnosticSeverityWarning, Summary: "warning"},
+					{Severity: tfprotov5.DiagnosticSeverityError, Summary: "error 2"},
+				},
+			},
+			want: "error 1; error 2",
+		},
+		{
+			name:     "RPC error",
+			response: &tfprotov5.ReadResourceResponse{},
+			err:      errors.New("RPC error"),
+			want:     "RPC error",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			if err := rpcError(testCase.response, testCase.err); err != nil {
+				got = err.Error()
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
@@ -103,7 +103,7 @@ func TestSub(t *testing.T) {
 	now := time.Now()
 	tz, err := time.LoadLocation("America/Vancouver")
 	if err != nil {
-		t.Fatalf(err.Error())
+		t.Fatal(err)
 	}
 
 	testcases := map[string]struct {
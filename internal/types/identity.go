@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultIdentityIDSeparator is the separator used to join the values of multiple identity attributes
+// to form a resource's ID. It matches flex.ResourceIdSeparator.
+const DefaultIdentityIDSeparator = ","
+
+// Identity represents a resource's identity.
+// In addition to the resource's own identity attributes, every identity includes
+// the AWS account ID and, for regional resources, the AWS Region.
+type Identity struct {
+	IsGlobalResource bool
+	Attributes       []IdentityAttribute
+	IDSeparator      string // Separator used to join required attribute values to form the resource's ID
+}
+
+// IdentityAttribute represents a resource-specific identity attribute.
+// Required attributes form the resource's ID, in order.
+type IdentityAttribute struct {
+	Name     string
+	Required bool
+}
+
+// StringIdentityAttribute returns a string-valued identity attribute.
+func StringIdentityAttribute(name string, required bool) IdentityAttribute {
+	return IdentityAttribute{
+		Name:     name,
+		Required: required,
+	}
+}
+
+// IdentityOptsFunc configures an Identity.
+type IdentityOptsFunc func(*Identity)
+
+// WithIDSeparator sets the separator used to join identity attribute values to form the resource's ID.
+func WithIDSeparator(separator string) IdentityOptsFunc {
+	return func(i *Identity) {
+		i.IDSeparator = separator
+	}
+}
+
+// RegionalSingleParameterIdentity returns the identity of a regional resource
+// whose ID is the value of the specified attribute.
+func RegionalSingleParameterIdentity(name string) Identity {
+	return RegionalParameterizedIdentity([]IdentityAttribute{StringIdentityAttribute(name, true)})
+}
+
+// GlobalSingleParameterIdentity returns the identity of a global resource
+// whose ID is the value of the specified attribute.
+func GlobalSingleParameterIdentity(name string) Identity {
+	return GlobalParameterizedIdentity([]IdentityAttribute{StringIdentityAttribute(name, true)})
+}
+
+// RegionalParameterizedIdentity returns the identity of a regional resource
+// whose ID is formed from the values of the specified attributes.
+func RegionalParameterizedIdentity(attributes []IdentityAttribute, opts ...IdentityOptsFunc) Identity {
+	return newIdentity(false, attributes, opts...)
+}
+
+// GlobalParameterizedIdentity returns the identity of a global resource
+// whose ID is formed from the values of the specified attributes.
+func GlobalParameterizedIdentity(attributes []IdentityAttribute, opts ...IdentityOptsFunc) Identity {
+	return newIdentity(true, attributes, opts...)
+}
+
+func newIdentity(isGlobalResource bool, attributes []IdentityAttribute, opts ...IdentityOptsFunc) Identity {
+	identity := Identity{
+		IsGlobalResource: isGlobalResource,
+		Attributes:       attributes,
+		IDSeparator:      DefaultIdentityIDSeparator,
+	}
+
+	for _, opt := range opts {
+		opt(&identity)
+	}
+
+	return identity
+}
+
+// HasAttributes returns whether the identity has any resource-specific attributes.
+func (i Identity) HasAttributes() bool {
+	return len(i.Attributes) > 0
+}
+
+// IDAttributes returns the names of the attributes whose values form the resource's ID, in order.
+func (i Identity) IDAttributes() []string {
+	var names []string
+
+	for _, v := range i.Attributes {
+		if v.Required {
+			names = append(names, v.Name)
+		}
+	}
+
+	return names
+}
+
+// FormatID returns the resource ID formed from the specified identity attribute values.
+func (i Identity) FormatID(values map[string]string) (string, error) {
+	names := i.IDAttributes()
+	parts := make([]string, 0, len(names))
+
+	for _, name := range names {
+		v := values[name]
+
+		if v == "" {
+			return "", fmt.Errorf("identity attribute %q is required", name)
+		}
+
+		if len(names) > 1 && strings.Contains(v, i.IDSeparator) {
+			return "", fmt.Errorf("identity attribute %q value (%s) contains the ID separator (%s)", name, v, i.IDSeparator)
+		}
+
+		parts = append(parts, v)
+	}
+
+	return strings.Join(parts, i.IDSeparator), nil
+}
+
+// ParseID returns the identity attribute values contained in the specified resource ID.
+func (i Identity) ParseID(id string) (map[string]string, error) {
+	names := i.IDAttributes()
+
+	if len(names) == 1 {
+		if id == "" {
+			return nil, fmt.Errorf("empty ID")
+		}
+
+		return map[string]string{names[0]: id}, nil
+	}
+
+	parts := strings.Split(id, i.IDSeparator)
+
+	if len(parts) != len(names) {
+		return nil, fmt.Errorf("unexpected format for ID (%s), expected %d parts separated by (%s)", id, len(names), i.IDSeparator)
+	}
+
+	values := make(map[string]string, len(names))
+
+	for idx, name := range names {
+		if parts[idx] == "" {
+			return nil, fmt.Errorf("unexpected format for ID (%s), %s is empty", id, name)
+		}
+
+		values[name] = parts[idx]
+	}
+
+	return values, nil
+}
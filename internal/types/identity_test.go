@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIdentityIDRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name       string
+		Identity   Identity
+		Values     map[string]string
+		ExpectedID string
+		ExpectErr  bool
+	}{
+		{
+			Name:       "single parameter",
+			Identity:   RegionalSingleParameterIdentity("name"),
+			Values:     map[string]string{"name": "a,b/c"},
+			ExpectedID: "a,b/c",
+		},
+		{
+			Name: "multiple parameters",
+			Identity: GlobalParameterizedIdentity([]IdentityAttribute{
+				StringIdentityAttribute("bucket", true),
+				StringIdentityAttribute("key", true),
+				StringIdentityAttribute("description", false),
+			}),
+			Values:     map[string]string{"bucket": "b", "key": "k"},
+			ExpectedID: "b,k",
+		},
+		{
+			Name: "custom separator",
+			Identity: RegionalParameterizedIdentity([]IdentityAttribute{
+				StringIdentityAttribute("function_name", true),
+				StringIdentityAttribute("qualifier", true),
+			}, WithIDSeparator(":")),
+			Values:     map[string]string{"function_name": "f", "qualifier": "1"},
+			ExpectedID: "f:1",
+		},
+		{
+			Name: "missing value",
+			Identity: RegionalParameterizedIdentity([]IdentityAttribute{
+				StringIdentityAttribute("bucket", true),
+				StringIdentityAttribute("key", true),
+			}),
+			Values:    map[string]string{"bucket": "b"},
+			ExpectErr: true,
+		},
+		{
+			Name: "value contains separator",
+			Identity: RegionalParameterizedIdentity([]IdentityAttribute{
+				StringIdentityAttribute("bucket", true),
+				StringIdentityAttribute("key", true),
+			}),
+			Values:    map[string]string{"bucket": "b", "key": "k,l"},
+			ExpectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := testCase.Identity.FormatID(testCase.Values)
+
+			if got, want := err != nil, testCase.ExpectErr; got != want {
+				t.Fatalf("FormatID err %t, want %t (%v)", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := id, testCase.ExpectedID; got != want {
+				t.Errorf("FormatID = %q, want %q", got, want)
+			}
+
+			values, err := testCase.Identity.ParseID(id)
+
+			if err != nil {
+				t.Fatalf("ParseID: %s", err)
+			}
+
+			if diff := cmp.Diff(values, testCase.Values); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,6 +19,14 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceIAMActions represents the IAM actions required by a resource's CRUD handlers.
+type ServicePackageResourceIAMActions struct {
+	Create []string
+	Read   []string
+	Update []string
+	Delete []string
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
@@ -25,12 +35,21 @@ type ServicePackageFrameworkDataSource struct {
 	Tags    *ServicePackageResourceTags
 }
 
+// ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
+// implemented by a service package.
+type ServicePackageEphemeralResource struct {
+	Factory func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
+	Name    string
+}
+
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory    func(context.Context) (resource.ResourceWithConfigure, error)
+	Name       string
+	Tags       *ServicePackageResourceTags
+	Identity   Identity
+	IAMActions *ServicePackageResourceIAMActions
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -45,8 +64,27 @@ type ServicePackageSDKDataSource struct {
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
+	Factory    func() *schema.Resource
+	TypeName   string
+	Name       string
+	Tags       *ServicePackageResourceTags
+	Identity   Identity
+	IAMActions *ServicePackageResourceIAMActions
+}
+
+// ListResourceForSDK is a Terraform Plugin Framework list resource
+// that enumerates instances of a Terraform Plugin SDK resource.
+type ListResourceForSDK interface {
+	list.ListResourceWithConfigure
+	list.ListResourceWithRawV5Schemas
+	SetIdentitySpec(Identity)
+	SetResourceSchema(*schema.Resource)
+}
+
+// ServicePackageSDKListResource represents a Terraform Plugin Framework list resource
+// for a Terraform Plugin SDK resource implemented by a service package.
+type ServicePackageSDKListResource struct {
+	Factory  func() ListResourceForSDK
 	TypeName string
 	Name     string
-	Tags     *ServicePackageResourceTags
 }
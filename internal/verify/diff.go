@@ -6,10 +6,12 @@ package verify
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -22,7 +24,7 @@ import (
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
 func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := tftags.DefaultConfigFromContext(ctx, meta.(*conns.AWSClient).DefaultTagsConfig)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
@@ -42,6 +44,12 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	policyConfig := meta.(*conns.AWSClient).TagPolicyConfig
+	oldTagsAll, _ := diff.GetChange("tags_all")
+	if err := checkTagPolicy(ctx, policyConfig, policyConfig.ViolationsForChange(diff.Id() == "", tftags.New(ctx, oldTagsAll), allTags)); err != nil {
+		return err
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
@@ -103,6 +111,51 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 	return nil
 }
 
+// checkTagPolicy reports a resource's violations of any provider-level tag policy.
+// Violations are returned as an error unless the policy's enforcement is "warn", in which case they are returned as plan warnings.
+func checkTagPolicy(ctx context.Context, policyConfig *tftags.PolicyConfig, violations []string) error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	if policyConfig.IsWarning() {
+		for _, v := range violations {
+			sdkv2.AddPlanWarning(ctx, sdkv2.PlanWarning{
+				Attribute: "tags",
+				Summary:   "Tag policy violation",
+				Detail:    v,
+			})
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("tag policy violations:\n\t%s", strings.Join(violations, "\n\t"))
+}
+
+// RegionAllowedDiff returns a CustomizeDiffFunc that verifies that the AWS Region
+// configured in the specified attribute is permitted by the provider's
+// `allowed_regions` and `forbidden_regions` settings.
+// Unknown and empty values are not verified.
+func RegionAllowedDiff(key string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+
+		region, ok := diff.Get(key).(string)
+		if !ok {
+			return nil
+		}
+
+		if err := meta.(*conns.AWSClient).VerifyRegionAllowed(ctx, region); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+
+		return nil
+	}
+}
+
 // SuppressEquivalentRoundedTime returns a difference suppression function that compares
 // two time value with the specified layout rounded to the specified duration.
 func SuppressEquivalentRoundedTime(layout string, d time.Duration) schema.SchemaDiffSuppressFunc {
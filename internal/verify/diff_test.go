@@ -4,8 +4,13 @@
 package verify
 
 import (
+	"context"
 	"testing"
 	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestSuppressEquivalentRoundedTime(t *testing.T) {
@@ -60,3 +65,53 @@ func TestSuppressEquivalentRoundedTime(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	violations := []string{"required tag key (Owner) is missing"}
+	testCases := map[string]struct {
+		policyConfig *tftags.PolicyConfig
+		violations   []string
+		wantWarnings []sdkv2.PlanWarning
+		expectErr    bool
+	}{
+		"no violations": {
+			policyConfig: &tftags.PolicyConfig{Enforcement: tftags.PolicyEnforcementError},
+		},
+		"error": {
+			policyConfig: &tftags.PolicyConfig{Enforcement: tftags.PolicyEnforcementError},
+			violations:   violations,
+			expectErr:    true,
+		},
+		"warn": {
+			policyConfig: &tftags.PolicyConfig{Enforcement: tftags.PolicyEnforcementWarn},
+			violations:   violations,
+			wantWarnings: []sdkv2.PlanWarning{
+				{
+					Attribute: "tags",
+					Summary:   "Tag policy violation",
+					Detail:    "required tag key (Owner) is missing",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, planWarnings := sdkv2.ContextWithPlanWarnings(context.Background())
+
+			err := checkTagPolicy(ctx, testCase.policyConfig, testCase.violations)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Fatalf("err %t, want %t (%v)", got, want, err)
+			}
+
+			if diff := cmp.Diff(planWarnings(), testCase.wantWarnings); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
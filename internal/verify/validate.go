@@ -220,6 +220,15 @@ func ValidIAMPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
 	return //nolint:nakedret // Just a long function.
 }
 
+// ValidateRegionName validates that the specified string is a valid AWS Region code.
+func ValidateRegionName(region string) error {
+	if !regionRegexp.MatchString(region) {
+		return fmt.Errorf("%q is not a valid AWS Region Code", region)
+	}
+
+	return nil
+}
+
 // ValidateIPv4CIDRBlock validates that the specified CIDR block is valid:
 // - The CIDR block parses to an IP address and network
 // - The IP address is an IPv4 address
@@ -4,9 +4,16 @@
 package verify
 
 import (
+	"strings"
+
 	"gopkg.in/yaml.v2"
 )
 
+const (
+	// ImportIDRegionSeparator separates a resource's import ID from an optional overriding AWS Region.
+	ImportIDRegionSeparator = "@"
+)
+
 const UUIDRegexPattern = `[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[ab89][0-9a-f]{3}-[0-9a-f]{12}`
 
 // Takes a value containing YAML string and passes it through
@@ -25,3 +32,19 @@ func checkYAMLString(yamlString interface{}) (string, error) {
 
 	return s, err
 }
+
+// ParseImportIDRegion splits an import ID of the form `id@region`.
+// Only a valid AWS Region code is accepted as the suffix so that IDs that themselves contain '@' are unaffected.
+func ParseImportIDRegion(v string) (string, string, bool) {
+	i := strings.LastIndex(v, ImportIDRegionSeparator)
+	if i < 1 {
+		return v, "", false
+	}
+
+	id, region := v[:i], v[i+len(ImportIDRegionSeparator):]
+	if err := ValidateRegionName(region); err != nil {
+		return v, "", false
+	}
+
+	return id, region, true
+}
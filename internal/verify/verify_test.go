@@ -44,3 +44,68 @@ abc:
 		t.Fatalf("Got:\n\n%s\n\nExpected:\n\n%s\n", actual, invalidYaml)
 	}
 }
+
+func TestParseImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input      string
+		wantID     string
+		wantRegion string
+		wantOK     bool
+	}{
+		"empty": {
+			input:  "",
+			wantID: "",
+		},
+		"no region": {
+			input:  "sg-0123456789abcdef0",
+			wantID: "sg-0123456789abcdef0",
+		},
+		"region": {
+			input:      "sg-0123456789abcdef0@eu-west-1",
+			wantID:     "sg-0123456789abcdef0",
+			wantRegion: "eu-west-1",
+			wantOK:     true,
+		},
+		"GovCloud region": {
+			input:      "sg-0123456789abcdef0@us-gov-west-1",
+			wantID:     "sg-0123456789abcdef0",
+			wantRegion: "us-gov-west-1",
+			wantOK:     true,
+		},
+		"ID contains separator": {
+			input:  "user@example.com",
+			wantID: "user@example.com",
+		},
+		"ID contains separator and region": {
+			input:      "user@example.com@eu-west-1",
+			wantID:     "user@example.com",
+			wantRegion: "eu-west-1",
+			wantOK:     true,
+		},
+		"only region": {
+			input:  "@eu-west-1",
+			wantID: "@eu-west-1",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotID, gotRegion, gotOK := ParseImportIDRegion(testCase.input)
+
+			if got, want := gotOK, testCase.wantOK; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+			if got, want := gotID, testCase.wantID; got != want {
+				t.Errorf("id = %q, want %q", got, want)
+			}
+			if got, want := gotRegion, testCase.wantRegion; got != want {
+				t.Errorf("region = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 )
 
 func main() {
@@ -38,6 +39,11 @@ func main() {
 		serveOpts...,
 	)
 
+	// Flush any spans buffered by the tracer provider.
+	if err := tracing.Shutdown(context.Background()); err != nil {
+		log.Printf("[WARN] shutting down tracing: %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
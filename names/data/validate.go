@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package data
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Validate checks that the service record is internally consistent.
+// Checks that involve other records, such as uniqueness, are the caller's responsibility.
+func (sr ServiceRecord) Validate() error {
+	if sr.HumanFriendly() == "" {
+		return errors.New("HumanFriendly cannot be blank")
+	}
+
+	if err := sr.validate(); err != nil {
+		return fmt.Errorf("for service %s, %w", sr.HumanFriendly(), err)
+	}
+
+	return nil
+}
+
+func (sr ServiceRecord) validate() error {
+	if sr.AWSCLIV2Command() != "" && strings.ReplaceAll(sr.AWSCLIV2Command(), "-", "") != sr.AWSCLIV2CommandNoDashes() {
+		return fmt.Errorf("AWSCLIV2CommandNoDashes must be the same as AWSCLIV2Command without dashes (%s)", strings.ReplaceAll(sr.AWSCLIV2Command(), "-", ""))
+	}
+
+	if sr.ProviderPackageCorrect() != "" && sr.AWSCLIV2CommandNoDashes() != "" && sr.GoV2Package() != "" {
+		if len(sr.AWSCLIV2CommandNoDashes()) < len(sr.GoV2Package()) && sr.ProviderPackageCorrect() != sr.AWSCLIV2CommandNoDashes() {
+			return fmt.Errorf("ProviderPackageCorrect must be shorter of AWSCLIV2CommandNoDashes (%s) and GoV2Package (%s)", sr.AWSCLIV2CommandNoDashes(), sr.GoV2Package())
+		}
+
+		if len(sr.AWSCLIV2CommandNoDashes()) > len(sr.GoV2Package()) && sr.ProviderPackageCorrect() != sr.GoV2Package() {
+			return fmt.Errorf("ProviderPackageCorrect must be shorter of AWSCLIV2CommandNoDashes (%s) and GoV2Package (%s)", sr.AWSCLIV2CommandNoDashes(), sr.GoV2Package())
+		}
+	}
+
+	if sr.AWSCLIV2CommandNoDashes() == "" && sr.GoV2Package() == "" && !sr.Exclude() {
+		return errors.New("if Exclude is blank, either AWSCLIV2CommandNoDashes or GoV2Package must have values")
+	}
+
+	if sr.ProviderPackageActual() != "" && sr.ProviderPackageCorrect() == "" {
+		return errors.New("ProviderPackageActual can't be non-blank if ProviderPackageCorrect is blank")
+	}
+
+	if sr.ProviderPackageActual() == "" && sr.ProviderPackageCorrect() == "" && !sr.Exclude() {
+		return errors.New("ProviderPackageActual and ProviderPackageCorrect cannot both be blank unless Exclude is non-blank")
+	}
+
+	if sr.ProviderPackageCorrect() != "" && sr.ProviderPackageActual() == sr.ProviderPackageCorrect() {
+		return errors.New("ProviderPackageActual should only be used if different from ProviderPackageCorrect")
+	}
+
+	if sr.ProviderPackage() != "" && slices.Contains(sr.Aliases(), sr.ProviderPackage()) {
+		return errors.New("Aliases should not include ProviderPackageActual, if not blank, or ProviderPackageCorrect, if not blank and ProviderPackageActual is blank")
+	}
+
+	if !sr.ClientSDKV1() && !sr.ClientSDKV2() && !sr.Exclude() {
+		return errors.New("at least one of ClientSDKV1 or ClientSDKV2 must have a value if Exclude is blank")
+	}
+
+	if sr.ClientSDKV1() && (sr.GoV1Package() == "" || sr.GoV1ClientTypeName() == "") {
+		return errors.New("SDKVersion is set to 1 so neither GoV1Package nor GoV1ClientTypeName can be blank")
+	}
+
+	if sr.ClientSDKV2() && sr.GoV2Package() == "" {
+		return errors.New("SDKVersion is set to 2 so GoV2Package cannot be blank")
+	}
+
+	if sr.ResourcePrefixCorrect() == "" && !sr.Exclude() {
+		return errors.New("ResourcePrefixCorrect must have a value if Exclude is blank")
+	}
+
+	if sr.ResourcePrefixCorrect() != "" && sr.ResourcePrefixCorrect() != fmt.Sprintf("aws_%s_", sr.ProviderPackageCorrect()) {
+		return errors.New("ResourcePrefixCorrect should be aws_<package>_, where <package> is ProviderPackageCorrect")
+	}
+
+	if sr.ResourcePrefixCorrect() != "" && sr.ResourcePrefixActual() == sr.ResourcePrefixCorrect() {
+		return errors.New("ResourcePrefixActual should not be the same as ResourcePrefixCorrect, set ResourcePrefixActual to blank")
+	}
+
+	if sr.SplitPackageRealPackage() != "" && (sr.ProviderPackageCorrect() == "" || sr.FilePrefix() == "" || sr.ResourcePrefixActual() == "") {
+		return errors.New("if SplitPackageRealPackage has a value, ProviderPackageCorrect, ResourcePrefixActual and FilePrefix must have values")
+	}
+
+	if sr.SplitPackageRealPackage() == "" && sr.FilePrefix() != "" {
+		return errors.New("if SplitPackageRealPackge is blank, FilePrefix must also be blank")
+	}
+
+	if sr.Brand() != "AWS" && sr.Brand() != "Amazon" && sr.Brand() != "" {
+		return fmt.Errorf("Brand must be AWS, Amazon, or blank; found %s", sr.Brand())
+	}
+
+	if (!sr.Exclude() || (sr.Exclude() && sr.AllowedSubcategory() != "")) && len(sr.DocPrefix()) == 0 {
+		return errors.New("DocPrefix cannot be blank unless Exclude is non-blank and AllowedSubcategory is blank")
+	}
+
+	if err := errors.Join(
+		checkAllLowercase("AWSCLIV2Command", sr.AWSCLIV2Command()),
+		checkAllLowercase("AWSCLIV2CommandNoDashes", sr.AWSCLIV2CommandNoDashes()),
+		checkAllLowercase("GoV1Package", sr.GoV1Package()),
+		checkAllLowercase("GoV2Package", sr.GoV2Package()),
+		checkAllLowercase("ProviderPackageActual", sr.ProviderPackageActual()),
+		checkAllLowercase("ProviderPackageCorrect", sr.ProviderPackageCorrect()),
+		checkAllLowercase("SplitPackageRealPackage", sr.SplitPackageRealPackage()),
+		checkAllLowercase("Aliases", sr.Aliases()...),
+		checkAllLowercase("ResourcePrefixActual", sr.ResourcePrefixActual()),
+		checkAllLowercase("ResourcePrefixCorrect", sr.ResourcePrefixCorrect()),
+		checkAllLowercase("FilePrefix", sr.FilePrefix()),
+		checkAllLowercase("DocPrefix", sr.DocPrefix()...),
+		checkNotAllLowercase("ProviderNameUpper", sr.ProviderNameUpper()),
+		checkNotAllLowercase("GoV1ClientTypeName", sr.GoV1ClientTypeName()),
+		checkNotAllLowercase("HumanFriendly", sr.HumanFriendly()),
+	); err != nil {
+		return err
+	}
+
+	if !sr.Exclude() && sr.AllowedSubcategory() != "" {
+		return errors.New("AllowedSubcategory can only be non-blank if Exclude is non-blank")
+	}
+
+	if sr.Exclude() && sr.Note() == "" {
+		return errors.New("if Exclude is not blank, include a Note why")
+	}
+
+	if sr.Exclude() && sr.AllowedSubcategory() == "" {
+		return nil
+	}
+
+	if (sr.DeprecatedEnvVar() != "") != (sr.TFAWSEnvVar() != "") {
+		return errors.New("either both DeprecatedEnvVar and TFAWSEnvVar must be specified or neither can be")
+	}
+
+	if sr.SDKID() == "" && !sr.Exclude() {
+		return errors.New("SDKID is required unless Exclude is set")
+	}
+
+	if sr.EndpointAPICall() == "" && !sr.NotImplemented() && !sr.Exclude() {
+		return errors.New("EndpointAPICall is required for unless NotImplemented or Exclude is set")
+	}
+
+	return nil
+}
+
+func checkAllLowercase(name string, values ...string) error {
+	for _, value := range values {
+		if value != "" && strings.ToLower(value) != value {
+			return fmt.Errorf("%s should not include uppercase letters (%s)", name, value)
+		}
+	}
+
+	return nil
+}
+
+func checkNotAllLowercase(name, value string) error {
+	if value != "" && strings.ToLower(value) == value {
+		return fmt.Errorf("%s should be properly capitalized; it does not include uppercase letters (%s)", name, value)
+	}
+
+	return nil
+}
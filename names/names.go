@@ -160,6 +160,13 @@ const (
 
 	// AWS ISOB (US) partition's regions.
 	USISOBEast1RegionID = "us-isob-east-1" // US ISOB East (Ohio).
+
+	// AWS ISOE (Europe) partition's regions.
+	EUISOEWest1RegionID = "eu-isoe-west-1" // EU ISOE West.
+
+	// AWS ISOF partition's regions.
+	USISOFEast1RegionID  = "us-isof-east-1"  // US ISOF East.
+	USISOFSouth1RegionID = "us-isof-south-1" // US ISOF South.
 )
 
 func DNSSuffixForPartition(partition string) string {
@@ -181,6 +188,40 @@ func DNSSuffixForPartition(partition string) string {
 	}
 }
 
+// DualStackDNSSuffixForPartition returns the domain suffix of dual-stack (IPv4 and IPv6) endpoints for the specified AWS partition.
+// An empty string is returned if the partition does not support dual-stack endpoints.
+func DualStackDNSSuffixForPartition(partition string) string {
+	switch partition {
+	case StandardPartitionID, USGovCloudPartitionID:
+		return "api.aws"
+	case ChinaPartitionID:
+		return "api.amazonwebservices.com.cn"
+	default:
+		return ""
+	}
+}
+
+// ServicePrincipalNameForPartition returns the IAM service principal name for the specified service in the specified AWS partition.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_principal.html#principal-services.
+func ServicePrincipalNameForPartition(service, partition string) string {
+	if service == "" || partition == "" {
+		return ""
+	}
+
+	switch partition {
+	case ChinaPartitionID:
+		// A small number of services in the China partition use the partition's domain suffix.
+		switch service {
+		case "codedeploy", "elasticmapreduce", "logs":
+			return fmt.Sprintf("%s.%s", service, DNSSuffixForPartition(partition))
+		}
+	case ISOPartitionID, ISOBPartitionID, ISOEPartitionID, ISOFPartitionID:
+		return fmt.Sprintf("%s.%s", service, DNSSuffixForPartition(partition))
+	}
+
+	return fmt.Sprintf("%s.%s", service, DNSSuffixForPartition(StandardPartitionID))
+}
+
 func IsOptInRegion(region string) bool {
 	switch region {
 	case AFSouth1RegionID,
@@ -198,6 +239,31 @@ func IsOptInRegion(region string) bool {
 	}
 }
 
+// IsGlobalService returns whether or not the specified service's resources are global,
+// i.e. are not scoped to a single AWS Region.
+func IsGlobalService(service string) bool {
+	switch service {
+	case Account,
+		Budgets,
+		CE,
+		CloudFront, CloudFrontKeyValueStore,
+		CUR,
+		GlobalAccelerator,
+		IAM,
+		NetworkManager,
+		Organizations,
+		Route53, Route53Domains,
+		Route53RecoveryControlConfig, Route53RecoveryReadiness,
+		Shield,
+		WAF:
+		return true
+	default:
+		return false
+	}
+}
+
+// PartitionForRegion returns the AWS partition for the specified AWS Region.
+// Regions not known to the provider are matched by name prefix, falling back to the AWS Standard partition.
 func PartitionForRegion(region string) string {
 	switch region {
 	case "":
@@ -208,8 +274,27 @@ func PartitionForRegion(region string) string {
 		return ISOPartitionID
 	case USISOBEast1RegionID:
 		return ISOBPartitionID
+	case EUISOEWest1RegionID:
+		return ISOEPartitionID
+	case USISOFEast1RegionID, USISOFSouth1RegionID:
+		return ISOFPartitionID
 	case USGovEast1RegionID, USGovWest1RegionID:
 		return USGovCloudPartitionID
+	}
+
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return ChinaPartitionID
+	case strings.HasPrefix(region, "us-isob-"):
+		return ISOBPartitionID
+	case strings.HasPrefix(region, "us-isof-"):
+		return ISOFPartitionID
+	case strings.HasPrefix(region, "us-iso-"):
+		return ISOPartitionID
+	case strings.HasPrefix(region, "eu-isoe-"):
+		return ISOEPartitionID
+	case strings.HasPrefix(region, "us-gov-"):
+		return USGovCloudPartitionID
 	default:
 		return StandardPartitionID
 	}
@@ -105,6 +105,53 @@ func TestIsOptInRegion(t *testing.T) {
 	}
 }
 
+func TestIsGlobalService(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			expected: false,
+		},
+		{
+			name:     "IAM",
+			input:    IAM,
+			expected: true,
+		},
+		{
+			name:     "Route 53",
+			input:    Route53,
+			expected: true,
+		},
+		{
+			name:     "EC2",
+			input:    EC2,
+			expected: false,
+		},
+		{
+			name:     "S3",
+			input:    S3,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := IsGlobalService(testCase.input), testCase.expected; got != want {
+				t.Errorf("got: %t, expected: %t", got, want)
+			}
+		})
+	}
+}
+
 func TestPartitionForRegion(t *testing.T) {
 	t.Parallel()
 
@@ -128,11 +175,31 @@ func TestPartitionForRegion(t *testing.T) {
 			input:    USGovWest1RegionID,
 			expected: USGovCloudPartitionID,
 		},
+		{
+			name:     "ISOE",
+			input:    EUISOEWest1RegionID,
+			expected: ISOEPartitionID,
+		},
+		{
+			name:     "ISOF",
+			input:    USISOFSouth1RegionID,
+			expected: ISOFPartitionID,
+		},
 		{
 			name:     "standard",
 			input:    USWest2RegionID,
 			expected: StandardPartitionID,
 		},
+		{
+			name:     "China prefix",
+			input:    "cn-south-1",
+			expected: ChinaPartitionID,
+		},
+		{
+			name:     "ISOB prefix",
+			input:    "us-isob-west-1",
+			expected: ISOBPartitionID,
+		},
 		{
 			name:     "default",
 			input:    "custom",
@@ -152,6 +219,112 @@ func TestPartitionForRegion(t *testing.T) {
 	}
 }
 
+func TestDualStackDNSSuffixForPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			expected: "",
+		},
+		{
+			name:     "China",
+			input:    ChinaPartitionID,
+			expected: "api.amazonwebservices.com.cn",
+		},
+		{
+			name:     "GovCloud",
+			input:    USGovCloudPartitionID,
+			expected: "api.aws",
+		},
+		{
+			name:     "ISO",
+			input:    ISOPartitionID,
+			expected: "",
+		},
+		{
+			name:     "standard",
+			input:    StandardPartitionID,
+			expected: "api.aws",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := DualStackDNSSuffixForPartition(testCase.input), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestServicePrincipalNameForPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		service   string
+		partition string
+		expected  string
+	}{
+		{
+			name:      "empty",
+			service:   "ec2",
+			partition: "",
+			expected:  "",
+		},
+		{
+			name:      "China",
+			service:   "ec2",
+			partition: ChinaPartitionID,
+			expected:  "ec2.amazonaws.com",
+		},
+		{
+			name:      "China exception",
+			service:   "logs",
+			partition: ChinaPartitionID,
+			expected:  "logs.amazonaws.com.cn",
+		},
+		{
+			name:      "GovCloud",
+			service:   "s3",
+			partition: USGovCloudPartitionID,
+			expected:  "s3.amazonaws.com",
+		},
+		{
+			name:      "ISO",
+			service:   "ec2",
+			partition: ISOPartitionID,
+			expected:  "ec2.c2s.ic.gov",
+		},
+		{
+			name:      "standard",
+			service:   "lambda",
+			partition: StandardPartitionID,
+			expected:  "lambda.amazonaws.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ServicePrincipalNameForPartition(testCase.service, testCase.partition), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
 func TestReverseDNS(t *testing.T) {
 	t.Parallel()
 
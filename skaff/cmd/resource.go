@@ -16,12 +16,18 @@ var (
 	v1            bool
 	pluginSDKV2   bool
 	includeTags   bool
+	apiModel      string
+	operations    resource.Operations
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if apiModel != "" {
+			return resource.CreateFromModel(name, snakeName, apiModel, operations, !clearComments, force)
+		}
+
 		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags)
 	},
 }
@@ -35,4 +41,9 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVarP(&apiModel, "model", "m", "", "generate the schema, model and finder from an AWS API model in Smithy JSON format (e.g., aws-sdk-go-v2/codegen/sdk-codegen/aws-models/bedrock-agent.json)")
+	resourceCmd.Flags().StringVar(&operations.Create, "create", "", "with --model, API operation that creates the resource (default Create<name>)")
+	resourceCmd.Flags().StringVar(&operations.Read, "read", "", "with --model, API operation that reads the resource (default Get<name> or Describe<name>)")
+	resourceCmd.Flags().StringVar(&operations.Update, "update", "", "with --model, API operation that updates the resource (default Update<name>, if any)")
+	resourceCmd.Flags().StringVar(&operations.Delete, "delete", "", "with --model, API operation that deletes the resource (default Delete<name>)")
 }
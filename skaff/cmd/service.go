@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/service"
+	"github.com/spf13/cobra"
+)
+
+var (
+	sdkPackage      string
+	cliCommand      string
+	humanFriendly   string
+	brand           string
+	sdkID           string
+	endpointAPICall string
+	customClient    bool
+	skipGenerate    bool
+)
+
+var serviceCmd = &cobra.Command{
+	Use:   "service",
+	Short: "Create scaffolding for a service",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return service.Create(sdkPackage, cliCommand, name, humanFriendly, brand, sdkID, endpointAPICall, customClient, !clearComments, force, !skipGenerate)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serviceCmd)
+	serviceCmd.Flags().StringVarP(&sdkPackage, "sdk-package", "k", "", "AWS SDK for Go v2 service package path (e.g., github.com/aws/aws-sdk-go-v2/service/bedrockagent)")
+	serviceCmd.Flags().StringVarP(&name, "name", "n", "", "properly capitalized name of the service, used in Go identifiers (e.g., BedrockAgent)")
+	serviceCmd.Flags().StringVarP(&humanFriendly, "human-friendly", "u", "", "human-friendly name of the service, without brand (e.g., Agents for Amazon Bedrock)")
+	serviceCmd.Flags().StringVarP(&sdkID, "sdk-id", "i", "", "service ID from the AWS SDK for Go v2 package's ServiceID constant (e.g., Bedrock Agent)")
+	serviceCmd.Flags().StringVarP(&endpointAPICall, "endpoint-api-call", "e", "", "API operation, without parameters, used by the generated endpoint tests (e.g., ListAgents)")
+	serviceCmd.Flags().StringVarP(&cliCommand, "cli-command", "l", "", "AWS CLI v2 command, if different from the SDK package name (e.g., bedrock-agent)")
+	serviceCmd.Flags().StringVarP(&brand, "brand", "b", "", "brand of the service, AWS or Amazon (default AWS for new services)")
+	serviceCmd.Flags().BoolVarP(&customClient, "custom-client", "x", false, "generate a service_package.go with a custom API client factory instead of the generated one")
+	serviceCmd.Flags().BoolVarP(&skipGenerate, "skip-generate", "g", false, "do not run the code generators and check their output")
+	serviceCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "do not include instructional comments in source")
+	serviceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+	serviceCmd.MarkFlagRequired("sdk-package")
+}
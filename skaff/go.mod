@@ -1,6 +1,6 @@
 module github.com/hashicorp/terraform-provider-aws/skaff
 
-go 1.22.2
+go 1.25.8
 
 require (
 	github.com/YakDriver/regexache v0.23.0
@@ -0,0 +1,1108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/smithy"
+)
+
+//go:embed resourcemodel.tmpl
+var resourceModelTmpl string
+
+//go:embed resourcemodeltest.tmpl
+var resourceModelTestTmpl string
+
+const (
+	traitIdempotencyToken = "smithy.api#idempotencyToken"
+)
+
+// Operations are the names of the API operations that implement a resource's lifecycle.
+// Operations that are not specified are looked up in the model by naming convention, e.g. CreateThing for resource Thing.
+type Operations struct {
+	Create string
+	Read   string
+	Update string
+	Delete string
+}
+
+type ModelTemplateData struct {
+	TemplateData
+
+	CreateOperation string
+	ReadOperation   string
+	UpdateOperation string
+	DeleteOperation string
+
+	// API object returned by the finder, e.g. "awstypes.Thing", and the field of the read operation's output that contains it, if any.
+	FindOutputType  string
+	FindOutputField string
+
+	// Input fields set from the resource ID.
+	ReadIDField   string
+	UpdateIDField string
+	DeleteIDField string
+
+	// How the resource ID is set after creation: from a field of the create operation's output or of the plan.
+	CreateIDOutputField string
+	CreateIDPlanField   string
+	CreateOutputStruct  string
+	CreateErrorID       string
+
+	ClientTokenField  string
+	NotFoundException string
+	TagsInRead        bool
+
+	SchemaAttributes string
+	SchemaBlocks     string
+	ModelFields      string
+	NestedModels     string
+	UpdateFields     []string
+
+	Status *StatusData
+
+	TestConfig string
+
+	// Notes lists parts of the API model that could not be translated and need attention.
+	Notes []string
+}
+
+// StatusData describes the resource's status field and the Go expressions for the values used by waiters,
+// e.g. "enum.Slice(awstypes.ThingStatusActive)".
+type StatusData struct {
+	Field          string
+	CreatedPending string
+	CreatedTarget  string
+	UpdatedPending string
+	UpdatedTarget  string
+	DeletedPending string
+}
+
+var (
+	statusCreatedTargetRegexp  = regexache.MustCompile(`(?i)^(ACTIVE|AVAILABLE|COMPLETED?|CREATED|ENABLED|IN_?SERVICE|PREPARED|READY|RUNNING|SUCCEEDED)$`)
+	statusCreatedPendingRegexp = regexache.MustCompile(`(?i)(CREATING|IN_PROGRESS|INITIALIZING|PENDING|PROVISIONING|STARTING|UPDATING)`)
+	statusUpdatedPendingRegexp = regexache.MustCompile(`(?i)(IN_PROGRESS|PENDING|UPDATING)`)
+	statusDeletedPendingRegexp = regexache.MustCompile(`(?i)DELETING`)
+)
+
+// attributeMode is how an attribute is configured, e.g. Required or Computed.
+type attributeMode struct {
+	required           bool
+	optional           bool
+	computed           bool
+	requiresReplace    bool
+	useStateForUnknown bool
+}
+
+// scalarType describes how a Smithy simple shape is represented in the Terraform Plugin Framework.
+type scalarType struct {
+	goType       string // Model struct field type.
+	schemaType   string // Schema attribute type.
+	customType   string
+	elementType  string // Element type in collections.
+	planModifier string // Plan modifier package prefix, e.g. "string" for stringplanmodifier.
+}
+
+// modelGenerator translates Smithy shapes into Terraform Plugin Framework schema and model source code.
+type modelGenerator struct {
+	model      *smithy.Model
+	attrConsts map[string]string // Terraform attribute name to names.Attr constant.
+	structs    map[string]string // Structure shape ID to model struct type name.
+	skip       map[string][]string
+	decls      []string // Nested model struct declarations.
+	stack      []string
+	notes      []string
+}
+
+// CreateFromModel creates a Terraform Plugin Framework resource whose schema, model and finder are generated from an AWS API model.
+func CreateFromModel(resName, snakeName, modelPath string, ops Operations, comments, force bool) error {
+	td, err := newTemplateData(resName, snakeName, comments, true, true, false)
+	if err != nil {
+		return err
+	}
+
+	m, err := smithy.Load(modelPath)
+	if err != nil {
+		return err
+	}
+
+	g := &modelGenerator{
+		model:      m,
+		attrConsts: readAttrConsts(filepath.Join("..", "..", "..", "names", "attr_constants.csv")),
+		structs:    make(map[string]string),
+		skip:       make(map[string][]string),
+	}
+
+	mtd, err := g.templateData(td, ops)
+	if err != nil {
+		return err
+	}
+
+	f := fmt.Sprintf("%s.go", td.ResourceSnake)
+	if err = writeGoTemplate("newres", f, resourceModelTmpl, force, mtd); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", td.ResourceSnake)
+	if err = writeGoTemplate("restest", tf, resourceModelTestTmpl, force, mtd); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", td.ServicePackage, td.ResourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	for _, note := range mtd.Notes {
+		fmt.Printf("NOTE: %s\n", note)
+	}
+
+	return nil
+}
+
+func (g *modelGenerator) templateData(td TemplateData, ops Operations) (ModelTemplateData, error) {
+	mtd := ModelTemplateData{TemplateData: td}
+	res := td.Resource
+
+	var err error
+	if mtd.CreateOperation, err = g.operation(ops.Create, "Create"+res); err != nil {
+		return mtd, err
+	}
+	if mtd.ReadOperation, err = g.operation(ops.Read, "Get"+res, "Describe"+res); err != nil {
+		return mtd, err
+	}
+	if mtd.DeleteOperation, err = g.operation(ops.Delete, "Delete"+res); err != nil {
+		return mtd, err
+	}
+	if mtd.UpdateOperation, err = g.operation(ops.Update, "Update"+res); err != nil && ops.Update != "" {
+		return mtd, err
+	}
+
+	createInput, createOutput, _, err := g.operationShapes(mtd.CreateOperation)
+	if err != nil {
+		return mtd, err
+	}
+	readInput, readOutput, readErrors, err := g.operationShapes(mtd.ReadOperation)
+	if err != nil {
+		return mtd, err
+	}
+	deleteInput, _, _, err := g.operationShapes(mtd.DeleteOperation)
+	if err != nil {
+		return mtd, err
+	}
+	var updateInput *smithy.Shape
+	if mtd.UpdateOperation != "" {
+		if updateInput, _, _, err = g.operationShapes(mtd.UpdateOperation); err != nil {
+			return mtd, err
+		}
+	}
+
+	// The resource is either the single structure in the read operation's output, or the output itself.
+	resource := readOutput
+	mtd.FindOutputType = fmt.Sprintf("%s.%sOutput", td.ServicePackage, mtd.ReadOperation)
+	if name, target, ok := g.singleStructureMember(readOutput); ok {
+		resource, _ = g.model.Shape(target)
+		mtd.FindOutputType = "awstypes." + smithy.Name(target)
+		mtd.FindOutputField = name
+	}
+
+	mtd.ReadIDField = g.identifierMember(readInput, res)
+	if mtd.ReadIDField == "" {
+		return mtd, fmt.Errorf("read operation (%s) input has no required string member to identify the resource", mtd.ReadOperation)
+	}
+	if mtd.DeleteIDField = g.identifierMember(deleteInput, res); mtd.DeleteIDField == "" {
+		g.note("the %s input has no required string member to identify the resource; set it in Delete", mtd.DeleteOperation)
+	}
+	if updateInput != nil {
+		if mtd.UpdateIDField = g.identifierMember(updateInput, res); mtd.UpdateIDField == "" {
+			g.note("the %s input has no required string member to identify the resource; set it in Update", mtd.UpdateOperation)
+		}
+	}
+
+	mtd.CreateErrorID = `""`
+	if _, ok := createInput.Members[mtd.ReadIDField]; ok && convert.ToSnakeCase(mtd.ReadIDField, "") != "id" {
+		mtd.CreateIDPlanField = goFieldName(mtd.ReadIDField)
+		mtd.CreateErrorID = fmt.Sprintf("plan.%s.String()", mtd.CreateIDPlanField)
+	} else if field := g.outputIDField(createOutput, mtd.ReadIDField); field != "" {
+		mtd.CreateIDOutputField = field
+		if v, _, ok := strings.Cut(field, "."); ok {
+			mtd.CreateOutputStruct = v
+		}
+	} else {
+		g.note("could not find %s in the %s output; set the resource ID in Create", mtd.ReadIDField, mtd.CreateOperation)
+	}
+
+	mtd.NotFoundException = "ResourceNotFoundException"
+	if i := slices.IndexFunc(readErrors, func(v string) bool { return strings.Contains(v, "NotFound") }); i >= 0 {
+		mtd.NotFoundException = readErrors[i]
+	} else {
+		g.note("the %s operation has no not found error; check the error handling in find%sByID", mtd.ReadOperation, res)
+	}
+
+	for name, member := range createInput.Members {
+		if _, ok := member.Traits[traitIdempotencyToken]; ok {
+			mtd.ClientTokenField = goFieldName(name)
+		}
+	}
+	_, mtd.IncludeTags = createInput.Members["Tags"]
+	_, mtd.TagsInRead = resource.Members["Tags"]
+
+	mtd.Status = g.status(resource)
+
+	if err := g.topLevel(&mtd, createInput, updateInput, resource); err != nil {
+		return mtd, err
+	}
+
+	mtd.NestedModels = strings.Join(g.decls, "\n")
+	mtd.Notes = g.notes
+
+	return mtd, nil
+}
+
+// operation returns the name of the first of the specified operations found in the model.
+func (g *modelGenerator) operation(names ...string) (string, error) {
+	var candidates []string
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+
+		if _, ok := g.model.Operation(name); ok {
+			return name, nil
+		}
+
+		candidates = append(candidates, name)
+
+		if name == names[0] {
+			// An explicitly specified operation must exist.
+			break
+		}
+	}
+
+	return "", fmt.Errorf("operation %s not found in model", strings.Join(candidates, " or "))
+}
+
+func (g *modelGenerator) operationShapes(name string) (*smithy.Shape, *smithy.Shape, []string, error) {
+	id, _ := g.model.Operation(name)
+	op := g.model.Shapes[id]
+
+	input, output := &smithy.Shape{Type: smithy.TypeStructure}, &smithy.Shape{Type: smithy.TypeStructure}
+	var err error
+
+	if op.Input != nil {
+		if input, err = g.model.Shape(op.Input.Target); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if op.Output != nil {
+		if output, err = g.model.Shape(op.Output.Target); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	var errs []string
+	for _, e := range op.Errors {
+		errs = append(errs, smithy.Name(e.Target))
+	}
+	slices.Sort(errs)
+
+	return input, output, errs, nil
+}
+
+func (g *modelGenerator) singleStructureMember(s *smithy.Shape) (string, string, bool) {
+	var name, target string
+	for n, m := range s.Members {
+		if v, err := g.model.Shape(m.Target); err == nil && v.Type == smithy.TypeStructure {
+			if name != "" {
+				return "", "", false
+			}
+			name, target = goFieldName(n), m.Target
+		}
+	}
+
+	return name, target, name != ""
+}
+
+// outputIDField returns the path of the identifier member in an operation's output, e.g. "Thing.ThingId".
+func (g *modelGenerator) outputIDField(output *smithy.Shape, id string) string {
+	candidates := []string{id}
+	if base, ok := strings.CutSuffix(id, "Identifier"); ok {
+		candidates = append(candidates, base+"Id", base+"Arn", base+"Name")
+	}
+
+	for _, c := range candidates {
+		if _, ok := output.Members[c]; ok {
+			return goFieldName(c)
+		}
+	}
+
+	if name, target, ok := g.singleStructureMember(output); ok {
+		s, _ := g.model.Shape(target)
+		for _, c := range candidates {
+			if _, ok := s.Members[c]; ok {
+				return name + "." + goFieldName(c)
+			}
+		}
+	}
+
+	return ""
+}
+
+// identifierMember returns the name of the required string input member that identifies the resource.
+func (g *modelGenerator) identifierMember(input *smithy.Shape, res string) string {
+	var required []string
+	for name, m := range input.Members {
+		if s, err := g.model.Shape(m.Target); err != nil || s.Type != smithy.TypeString || s.IsEnum() {
+			continue
+		}
+		if m.IsRequired() {
+			required = append(required, name)
+		}
+	}
+	slices.Sort(required)
+
+	for _, name := range []string{res + "Identifier", res + "Id", res + "Arn", res + "Name", "Identifier", "Id", "Arn", "Name"} {
+		if slices.Contains(required, name) {
+			return name
+		}
+	}
+
+	if len(required) > 0 {
+		return required[0]
+	}
+
+	return ""
+}
+
+// topLevel generates the resource's schema and model from the create and update operations' inputs and the resource's API object.
+func (g *modelGenerator) topLevel(mtd *ModelTemplateData, create, update, resource *smithy.Shape) error {
+	type member struct {
+		name     string
+		member   *smithy.Member
+		inCreate bool
+		inUpdate bool
+	}
+
+	members := make(map[string]*member)
+	for _, v := range []struct {
+		shape    *smithy.Shape
+		inCreate bool
+		inUpdate bool
+	}{
+		{create, true, false},
+		{update, false, true},
+		{resource, false, false},
+	} {
+		if v.shape == nil {
+			continue
+		}
+
+		for name, m := range v.shape.Members {
+			if name == "Tags" || name == mtd.UpdateIDField && v.inUpdate {
+				continue
+			}
+			if _, ok := m.Traits[traitIdempotencyToken]; ok {
+				continue
+			}
+
+			if _, ok := members[name]; !ok {
+				members[name] = &member{name: name, member: m}
+			}
+			members[name].inCreate = members[name].inCreate || v.inCreate
+			members[name].inUpdate = members[name].inUpdate || v.inUpdate
+		}
+	}
+
+	attributes, blocks := make(map[string]string), make(map[string]string)
+	var fields, testConfig []string
+
+	fields = append(fields, "ID types.String `tfsdk:\"id\"`")
+	attributes["id"] = "framework.IDAttribute()"
+	if mtd.IncludeTags {
+		fields = append(fields, "Tags types.Map `tfsdk:\"tags\"`", "TagsAll types.Map `tfsdk:\"tags_all\"`")
+		attributes["tags"] = "tftags.TagsAttribute()"
+		attributes["tags_all"] = "tftags.TagsAttributeComputedOnly()"
+	}
+
+	for _, name := range sortedKeys(members) {
+		m := members[name]
+		tfName := convert.ToSnakeCase(name, "")
+
+		if tfName == "id" {
+			continue // Set from the resource ID.
+		}
+
+		goType, ok, err := g.fieldType(m.member.Target)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			g.note("attribute %s (%s) is not supported and was omitted", tfName, smithy.Name(m.member.Target))
+			continue
+		}
+
+		if !m.inCreate && !m.inUpdate && (name == "Arn" || name == mtd.Resource+"Arn") {
+			fields = append(fields, fmt.Sprintf("%s %s `tfsdk:\"arn\"`", goFieldName(name), goType))
+			attributes["arn"] = "framework.ARNAttributeComputedOnly()"
+			continue
+		}
+
+		var mode attributeMode
+		switch {
+		case m.inCreate || m.inUpdate:
+			mode.required = m.inCreate && m.member.IsRequired()
+			mode.optional = !mode.required
+			mode.requiresReplace = !m.inUpdate
+		default:
+			mode.computed = true
+			mode.useStateForUnknown = true
+		}
+
+		src, isBlock, err := g.schema(m.member.Target, mode)
+		if err != nil {
+			return err
+		}
+
+		fields = append(fields, fmt.Sprintf("%s %s `tfsdk:%q`", goFieldName(name), goType, tfName))
+		if isBlock {
+			blocks[tfName] = src
+		} else {
+			attributes[tfName] = src
+		}
+
+		if m.inUpdate {
+			mtd.UpdateFields = append(mtd.UpdateFields, goFieldName(name))
+		}
+
+		if mode.required {
+			testConfig = append(testConfig, g.testValue(tfName, m.member.Target, isBlock))
+		}
+	}
+
+	if mtd.Status != nil {
+		fields = append(fields, "Timeouts timeouts.Value `tfsdk:\"timeouts\"`")
+		blocks["timeouts"] = "timeouts.Block(ctx, timeouts.Opts{\nCreate: true,\nUpdate: true,\nDelete: true,\n})"
+	}
+
+	slices.SortFunc(fields, compareFields)
+
+	mtd.SchemaAttributes = g.schemaEntries(attributes)
+	mtd.SchemaBlocks = g.schemaEntries(blocks)
+	mtd.ModelFields = strings.Join(fields, "\n")
+
+	if !slices.ContainsFunc(testConfig, func(v string) bool { return strings.Contains(v, "%[1]q") }) {
+		testConfig = append([]string{"  # TODO: Use the random name, %[1]q, to name or tag the resource."}, testConfig...)
+	}
+	mtd.TestConfig = strings.Join(testConfig, "\n")
+
+	return nil
+}
+
+// compareFields orders model struct fields by their Terraform attribute names.
+func compareFields(a, b string) int {
+	tag := func(s string) string {
+		_, t, _ := strings.Cut(s, "`")
+		return t
+	}
+
+	return strings.Compare(tag(a), tag(b))
+}
+
+// status returns the resource's status field and the values that waiters wait for, or nil if the resource has no status.
+func (g *modelGenerator) status(resource *smithy.Shape) *StatusData {
+	name, values := g.statusField(resource)
+	if name == "" {
+		return nil
+	}
+
+	enumType := "awstypes." + smithy.Name(resource.Members[name].Target)
+	var createdPending, createdTarget, updatedPending, deletedPending []string
+
+	for _, v := range values {
+		constant := enumType + v.Name
+
+		switch {
+		case statusCreatedTargetRegexp.MatchString(v.Value):
+			createdTarget = append(createdTarget, constant)
+			deletedPending = append(deletedPending, constant)
+		case statusDeletedPendingRegexp.MatchString(v.Value):
+			deletedPending = append(deletedPending, constant)
+		case statusCreatedPendingRegexp.MatchString(v.Value):
+			createdPending = append(createdPending, constant)
+			if statusUpdatedPendingRegexp.MatchString(v.Value) {
+				updatedPending = append(updatedPending, constant)
+			}
+		}
+	}
+
+	if len(createdTarget) == 0 {
+		g.note("could not determine the target values of %s for waiters", name)
+		return nil
+	}
+
+	return &StatusData{
+		Field:          goFieldName(name),
+		CreatedPending: enumSlice(createdPending),
+		CreatedTarget:  enumSlice(createdTarget),
+		UpdatedPending: enumSlice(updatedPending),
+		UpdatedTarget:  enumSlice(createdTarget),
+		DeletedPending: enumSlice(deletedPending),
+	}
+}
+
+// enumSlice returns the Go expression for a slice of enum values.
+func enumSlice(constants []string) string {
+	if len(constants) == 0 {
+		return "[]string{}"
+	}
+
+	return fmt.Sprintf("enum.Slice(%s)", strings.Join(constants, ", "))
+}
+
+// statusField returns the name of the resource's status member and its values.
+func (g *modelGenerator) statusField(resource *smithy.Shape) (string, []smithy.EnumValue) {
+	for _, name := range []string{"Status", "State"} {
+		for n, m := range resource.Members {
+			if n != name && !strings.HasSuffix(n, name) {
+				continue
+			}
+
+			if s, err := g.model.Shape(m.Target); err == nil && s.IsEnum() {
+				return n, s.EnumValues()
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// scalar returns the representation of a simple shape, or false if the shape is not simple.
+func (g *modelGenerator) scalar(target string) (scalarType, bool, error) {
+	s, err := g.model.Shape(target)
+	if err != nil {
+		return scalarType{}, false, err
+	}
+
+	if s.IsEnum() {
+		enumType := "awstypes." + smithy.Name(target)
+		return scalarType{
+			goType:       fmt.Sprintf("fwtypes.StringEnum[%s]", enumType),
+			schemaType:   "String",
+			customType:   fmt.Sprintf("fwtypes.StringEnumType[%s]()", enumType),
+			elementType:  "types.StringType",
+			planModifier: "string",
+		}, true, nil
+	}
+
+	switch s.Type {
+	case smithy.TypeString, smithy.TypeBlob:
+		return scalarType{goType: "types.String", schemaType: "String", elementType: "types.StringType", planModifier: "string"}, true, nil
+	case smithy.TypeBoolean:
+		return scalarType{goType: "types.Bool", schemaType: "Bool", elementType: "types.BoolType", planModifier: "bool"}, true, nil
+	case smithy.TypeByte, smithy.TypeShort, smithy.TypeInteger, smithy.TypeLong, smithy.TypeBigInteger, smithy.TypeIntEnum:
+		return scalarType{goType: "types.Int64", schemaType: "Int64", elementType: "types.Int64Type", planModifier: "int64"}, true, nil
+	case smithy.TypeFloat, smithy.TypeDouble, smithy.TypeBigDecimal:
+		return scalarType{goType: "types.Float64", schemaType: "Float64", elementType: "types.Float64Type", planModifier: "float64"}, true, nil
+	case smithy.TypeTimestamp:
+		return scalarType{
+			goType:       "timetypes.RFC3339",
+			schemaType:   "String",
+			customType:   "timetypes.RFC3339Type{}",
+			elementType:  "timetypes.RFC3339Type{}",
+			planModifier: "string",
+		}, true, nil
+	}
+
+	return scalarType{}, false, nil
+}
+
+// fieldType returns the model struct field type for a shape, or false if the shape is not supported.
+// Model structs are generated for structures.
+func (g *modelGenerator) fieldType(target string) (string, bool, error) {
+	if t, ok, err := g.scalar(target); err != nil || ok {
+		return t.goType, ok, err
+	}
+
+	s, _ := g.model.Shape(target)
+
+	switch s.Type {
+	case smithy.TypeList, smithy.TypeSet:
+		collection := "List"
+		if s.IsSet() {
+			collection = "Set"
+		}
+
+		if t, ok, err := g.scalar(s.Member.Target); err != nil {
+			return "", false, err
+		} else if ok {
+			if t.elementType == "types.StringType" {
+				return fmt.Sprintf("fwtypes.%sValueOf[types.String]", collection), true, nil
+			}
+			return "types." + collection, true, nil
+		}
+
+		name, ok, err := g.structType(s.Member.Target)
+		if err != nil || !ok {
+			return "", ok, err
+		}
+
+		return fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", collection, name), true, nil
+
+	case smithy.TypeMap:
+		t, ok, err := g.scalar(s.Value.Target)
+		if err != nil || !ok {
+			return "", false, err
+		}
+
+		if t.elementType == "types.StringType" {
+			return "fwtypes.MapValueOf[types.String]", true, nil
+		}
+		return "types.Map", true, nil
+
+	case smithy.TypeStructure, smithy.TypeUnion:
+		name, ok, err := g.structType(target)
+		if err != nil || !ok {
+			return "", ok, err
+		}
+
+		return fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", name), true, nil
+	}
+
+	return "", false, nil
+}
+
+// structType returns the name of the model struct for a structure, generating it if necessary.
+func (g *modelGenerator) structType(target string) (string, bool, error) {
+	if name, ok := g.structs[target]; ok {
+		return name, true, nil
+	}
+
+	s, err := g.model.Shape(target)
+	if err != nil {
+		return "", false, err
+	}
+
+	if s.Type != smithy.TypeStructure && s.Type != smithy.TypeUnion {
+		return "", false, nil
+	}
+
+	name := convert.ToLowercasePrefix(smithy.Name(target)) + "Data"
+	g.structs[target] = name
+	g.stack = append(g.stack, target)
+	defer func() { g.stack = g.stack[:len(g.stack)-1] }()
+
+	var fields []string
+	for _, memberName := range sortedKeys(s.Members) {
+		m := s.Members[memberName]
+
+		if slices.Contains(g.stack, g.structTarget(m.Target)) {
+			g.note("recursive attribute %s in %s was omitted", convert.ToSnakeCase(memberName, ""), smithy.Name(target))
+			g.skip[target] = append(g.skip[target], memberName)
+			continue
+		}
+
+		goType, ok, err := g.fieldType(m.Target)
+		if err != nil {
+			return "", false, err
+		}
+		if !ok {
+			g.note("attribute %s in %s (%s) is not supported and was omitted", convert.ToSnakeCase(memberName, ""), smithy.Name(target), smithy.Name(m.Target))
+			g.skip[target] = append(g.skip[target], memberName)
+			continue
+		}
+
+		fields = append(fields, fmt.Sprintf("%s %s `tfsdk:%q`", goFieldName(memberName), goType, convert.ToSnakeCase(memberName, "")))
+	}
+
+	slices.SortFunc(fields, compareFields)
+
+	g.decls = append(g.decls, fmt.Sprintf("type %s struct {\n%s\n}\n", name, strings.Join(fields, "\n")))
+
+	return name, true, nil
+}
+
+// structTarget returns the ID of the structure that a shape is or contains, or "".
+func (g *modelGenerator) structTarget(target string) string {
+	s, err := g.model.Shape(target)
+	if err != nil {
+		return ""
+	}
+
+	switch s.Type {
+	case smithy.TypeList, smithy.TypeSet:
+		return g.structTarget(s.Member.Target)
+	case smithy.TypeMap:
+		return g.structTarget(s.Value.Target)
+	case smithy.TypeStructure, smithy.TypeUnion:
+		return target
+	}
+
+	return ""
+}
+
+// schema returns the schema attribute or block for a shape.
+func (g *modelGenerator) schema(target string, mode attributeMode) (string, bool, error) {
+	var sb strings.Builder
+
+	if t, ok, err := g.scalar(target); err != nil {
+		return "", false, err
+	} else if ok {
+		fmt.Fprintf(&sb, "schema.%sAttribute{\n", t.schemaType)
+		if t.customType != "" {
+			fmt.Fprintf(&sb, "CustomType: %s,\n", t.customType)
+		}
+		writeMode(&sb, mode, t.schemaType, t.planModifier)
+		sb.WriteString("}")
+
+		return sb.String(), false, nil
+	}
+
+	s, _ := g.model.Shape(target)
+
+	switch s.Type {
+	case smithy.TypeList, smithy.TypeSet:
+		collection := "List"
+		if s.IsSet() {
+			collection = "Set"
+		}
+		planModifier := strings.ToLower(collection)
+
+		if t, ok, err := g.scalar(s.Member.Target); err != nil {
+			return "", false, err
+		} else if ok {
+			fmt.Fprintf(&sb, "schema.%sAttribute{\n", collection)
+			if t.elementType == "types.StringType" {
+				fmt.Fprintf(&sb, "CustomType: fwtypes.%sOfStringType,\n", collection)
+			}
+			fmt.Fprintf(&sb, "ElementType: %s,\n", t.elementType)
+			writeMode(&sb, mode, collection, planModifier)
+			sb.WriteString("}")
+
+			return sb.String(), false, nil
+		}
+
+		return g.nestedSchema(s.Member.Target, collection, false, mode)
+
+	case smithy.TypeMap:
+		t, _, err := g.scalar(s.Value.Target)
+		if err != nil {
+			return "", false, err
+		}
+
+		sb.WriteString("schema.MapAttribute{\n")
+		if t.elementType == "types.StringType" {
+			sb.WriteString("CustomType: fwtypes.MapOfStringType,\n")
+		}
+		fmt.Fprintf(&sb, "ElementType: %s,\n", t.elementType)
+		writeMode(&sb, mode, "Map", "map")
+		sb.WriteString("}")
+
+		return sb.String(), false, nil
+	}
+
+	return g.nestedSchema(target, "List", true, mode)
+}
+
+// nestedSchema returns the schema for a collection of structures, or a single structure.
+// Configurable structures are blocks, and computed-only structures are attributes.
+func (g *modelGenerator) nestedSchema(target, collection string, single bool, mode attributeMode) (string, bool, error) {
+	name := g.structs[target]
+	planModifier := strings.ToLower(collection)
+
+	var sb strings.Builder
+
+	if mode.computed && !mode.optional {
+		fmt.Fprintf(&sb, "schema.%sAttribute{\n", collection)
+		fmt.Fprintf(&sb, "CustomType: fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", collection, name)
+		fmt.Fprintf(&sb, "ElementType: fwtypes.NewObjectTypeOf[%s](ctx),\n", name)
+		writeMode(&sb, mode, collection, planModifier)
+		sb.WriteString("}")
+
+		return sb.String(), false, nil
+	}
+
+	fmt.Fprintf(&sb, "schema.%sNestedBlock{\n", collection)
+	fmt.Fprintf(&sb, "CustomType: fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", collection, name)
+	if mode.requiresReplace {
+		fmt.Fprintf(&sb, "PlanModifiers: []planmodifier.%s{\n%splanmodifier.RequiresReplace(),\n},\n", collection, planModifier)
+	}
+
+	var validators []string
+	if single {
+		validators = append(validators, fmt.Sprintf("%svalidator.SizeAtMost(1)", planModifier))
+	}
+	if mode.required {
+		validators = append(validators, fmt.Sprintf("%svalidator.IsRequired()", planModifier))
+	}
+	if len(validators) > 0 {
+		fmt.Fprintf(&sb, "Validators: []validator.%s{\n%s,\n},\n", collection, strings.Join(validators, ",\n"))
+	}
+
+	s, _ := g.model.Shape(target)
+
+	attributes, blocks := make(map[string]string), make(map[string]string)
+	for _, memberName := range sortedKeys(s.Members) {
+		if slices.Contains(g.skip[target], memberName) {
+			continue
+		}
+
+		m := s.Members[memberName]
+		src, isBlock, err := g.schema(m.Target, attributeMode{required: m.IsRequired(), optional: !m.IsRequired()})
+		if err != nil {
+			return "", false, err
+		}
+
+		tfName := convert.ToSnakeCase(memberName, "")
+		if isBlock {
+			blocks[tfName] = src
+		} else {
+			attributes[tfName] = src
+		}
+	}
+
+	sb.WriteString("NestedObject: schema.NestedBlockObject{\n")
+	if len(attributes) > 0 {
+		fmt.Fprintf(&sb, "Attributes: map[string]schema.Attribute{\n%s\n},\n", g.schemaEntries(attributes))
+	}
+	if len(blocks) > 0 {
+		fmt.Fprintf(&sb, "Blocks: map[string]schema.Block{\n%s\n},\n", g.schemaEntries(blocks))
+	}
+	sb.WriteString("},\n}")
+
+	return sb.String(), true, nil
+}
+
+// schemaEntries returns the Go source for the entries of a schema attribute or block map, ordered by name.
+func (g *modelGenerator) schemaEntries(entries map[string]string) string {
+	var lines []string
+	for _, tfName := range sortedKeys(entries) {
+		lines = append(lines, fmt.Sprintf("%s: %s,", g.attrKey(tfName), entries[tfName]))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func writeMode(sb *strings.Builder, mode attributeMode, schemaType, planModifier string) {
+	if mode.required {
+		sb.WriteString("Required: true,\n")
+	}
+	if mode.optional {
+		sb.WriteString("Optional: true,\n")
+	}
+	if mode.computed {
+		sb.WriteString("Computed: true,\n")
+	}
+
+	var planModifiers []string
+	if mode.requiresReplace {
+		planModifiers = append(planModifiers, planModifier+"planmodifier.RequiresReplace()")
+	}
+	if mode.useStateForUnknown {
+		planModifiers = append(planModifiers, planModifier+"planmodifier.UseStateForUnknown()")
+	}
+	if len(planModifiers) > 0 {
+		fmt.Fprintf(sb, "PlanModifiers: []planmodifier.%s{\n%s,\n},\n", schemaType, strings.Join(planModifiers, ",\n"))
+	}
+}
+
+// testValue returns an acceptance test configuration argument for a required attribute.
+func (g *modelGenerator) testValue(tfName, target string, isBlock bool) string {
+	if isBlock {
+		return fmt.Sprintf("  %s {\n    # TODO: Add the required arguments.\n  }", tfName)
+	}
+
+	s, _ := g.model.Shape(target)
+
+	var v string
+	switch {
+	case s.IsEnum():
+		if values := s.EnumValues(); len(values) > 0 {
+			v = fmt.Sprintf("%q", values[0].Value)
+		}
+	case s.Type == smithy.TypeString && strings.HasSuffix(tfName, "name"):
+		v = "%[1]q"
+	case s.Type == smithy.TypeString || s.Type == smithy.TypeBlob:
+		v = `"test"`
+	case s.Type == smithy.TypeBoolean:
+		v = "true"
+	case s.Type == smithy.TypeTimestamp:
+		v = `"2030-01-01T00:00:00Z"`
+	case s.Type == smithy.TypeList || s.Type == smithy.TypeSet:
+		v = `["test"]`
+	case s.Type == smithy.TypeMap:
+		v = `{ key = "value" }`
+	default:
+		v = "1"
+	}
+
+	return fmt.Sprintf("  %s = %s", tfName, v)
+}
+
+// attrKey returns the Go source for a schema map key, preferring names.Attr constants.
+func (g *modelGenerator) attrKey(tfName string) string {
+	if c, ok := g.attrConsts[tfName]; ok {
+		return "names.Attr" + c
+	}
+
+	return fmt.Sprintf("%q", tfName)
+}
+
+func (g *modelGenerator) note(format string, a ...any) {
+	g.notes = append(g.notes, fmt.Sprintf(format, a...))
+}
+
+// readAttrConsts reads the names.Attr constants, returning an empty map if they are not available.
+func readAttrConsts(filename string) map[string]string {
+	consts := make(map[string]string)
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return consts
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return consts
+	}
+
+	for _, r := range records {
+		if len(r) >= 2 {
+			consts[r[0]] = r[1]
+		}
+	}
+
+	return consts
+}
+
+// writeGoTemplate writes Go source generated from a template, removing unused imports and formatting it.
+func writeGoTemplate(templateName, filename, tmpl string, force bool, td any) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	contents, err := executeGoTemplate(templateName, tmpl, td)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+func executeGoTemplate(templateName, tmpl string, td any) ([]byte, error) {
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tplate.Execute(&buffer, td); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	contents, err := removeUnusedImports(buffer.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing generated source: %s", err)
+	}
+
+	contents, err = format.Source(contents)
+	if err != nil {
+		return nil, fmt.Errorf("error formatting generated source: %s", err)
+	}
+
+	return contents, nil
+}
+
+// removeUnusedImports removes the imports that Go source does not reference.
+// The templates import every package that generated code may use.
+func removeUnusedImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if v, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := v.X.(*ast.Ident); ok {
+				used[x.Name] = true
+			}
+		}
+		return true
+	})
+
+	var unused []ast.Spec
+	for _, v := range file.Imports {
+		p, err := strconv.Unquote(v.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Base(p)
+		if v.Name != nil {
+			name = v.Name.Name
+		}
+
+		if name != "_" && !used[name] {
+			unused = append(unused, v)
+		}
+	}
+
+	if len(unused) == 0 {
+		return src, nil
+	}
+
+	// Remove the lines of unused imports from the source, leaving formatting to the caller.
+	lines := strings.Split(string(src), "\n")
+	for _, v := range unused {
+		lines[fset.Position(v.Pos()).Line-1] = "\x00"
+	}
+	lines = slices.DeleteFunc(lines, func(s string) bool { return s == "\x00" })
+
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// goFieldName returns the AWS SDK for Go v2 field name of a Smithy member.
+func goFieldName(member string) string {
+	if member == "" {
+		return ""
+	}
+
+	return strings.ToUpper(member[:1]) + member[1:]
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
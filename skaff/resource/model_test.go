@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/smithy"
+)
+
+func TestModelTemplateData(t *testing.T) {
+	t.Parallel()
+
+	m, err := smithy.Load(filepath.Join("testdata", "example.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := &modelGenerator{
+		model: m,
+		attrConsts: map[string]string{
+			"arn":         "ARN",
+			"description": "Description",
+			"id":          "ID",
+			"status":      "Status",
+			"tags":        "Tags",
+			"tags_all":    "TagsAll",
+			"timeouts":    "Timeouts",
+		},
+		structs: make(map[string]string),
+		skip:    make(map[string][]string),
+	}
+
+	td := TemplateData{
+		Resource:          "Widget",
+		ResourceSnake:     "widget",
+		ServicePackage:    "example",
+		Service:           "Example",
+		HumanResourceName: "Widget",
+	}
+
+	mtd, err := g.templateData(td, Operations{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []struct {
+		name, got, want string
+	}{
+		{"CreateOperation", mtd.CreateOperation, "CreateWidget"},
+		{"ReadOperation", mtd.ReadOperation, "GetWidget"},
+		{"UpdateOperation", mtd.UpdateOperation, "UpdateWidget"},
+		{"DeleteOperation", mtd.DeleteOperation, "DeleteWidget"},
+		{"FindOutputType", mtd.FindOutputType, "awstypes.Widget"},
+		{"FindOutputField", mtd.FindOutputField, "Widget"},
+		{"ReadIDField", mtd.ReadIDField, "WidgetIdentifier"},
+		{"UpdateIDField", mtd.UpdateIDField, "WidgetIdentifier"},
+		{"DeleteIDField", mtd.DeleteIDField, "WidgetIdentifier"},
+		{"CreateIDOutputField", mtd.CreateIDOutputField, "WidgetId"},
+		{"ClientTokenField", mtd.ClientTokenField, "ClientToken"},
+		{"NotFoundException", mtd.NotFoundException, "WidgetNotFoundException"},
+		{"UpdateFields", strings.Join(mtd.UpdateFields, ","), "Description"},
+	} {
+		if v.got != v.want {
+			t.Errorf("%s = %q, want %q", v.name, v.got, v.want)
+		}
+	}
+
+	if !mtd.IncludeTags || !mtd.TagsInRead {
+		t.Errorf("IncludeTags = %t, TagsInRead = %t, want true", mtd.IncludeTags, mtd.TagsInRead)
+	}
+
+	if mtd.Status == nil {
+		t.Fatal("Status = nil, want status")
+	}
+	for _, v := range []struct {
+		name, got, want string
+	}{
+		{"CreatedPending", mtd.Status.CreatedPending, "enum.Slice(awstypes.WidgetStatusCreating, awstypes.WidgetStatusUpdating)"},
+		{"CreatedTarget", mtd.Status.CreatedTarget, "enum.Slice(awstypes.WidgetStatusActive)"},
+		{"UpdatedPending", mtd.Status.UpdatedPending, "enum.Slice(awstypes.WidgetStatusUpdating)"},
+		{"DeletedPending", mtd.Status.DeletedPending, "enum.Slice(awstypes.WidgetStatusActive, awstypes.WidgetStatusDeleting)"},
+	} {
+		if v.got != v.want {
+			t.Errorf("Status.%s = %q, want %q", v.name, v.got, v.want)
+		}
+	}
+
+	if got, want := len(mtd.Notes), 2; got != want {
+		t.Errorf("len(Notes) = %d, want %d: %v", got, want, mtd.Notes)
+	}
+
+	src, err := executeGoTemplate("newres", resourceModelTmpl, mtd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"names.AttrARN: framework.ARNAttributeComputedOnly(),",
+		`"configuration": schema.ListNestedBlock{`,
+		"CustomType: fwtypes.NewListNestedObjectTypeOf[widgetConfigurationData](ctx),",
+		"listvalidator.SizeAtMost(1),",
+		"CustomType: fwtypes.StringEnumType[awstypes.Mode](),",
+		"fwtypes.SetOfStringType,",
+		"CustomType: timetypes.RFC3339Type{},",
+		`"widget_name": schema.StringAttribute{`,
+		"stringplanmodifier.RequiresReplace(),",
+		"stringplanmodifier.UseStateForUnknown(),",
+		"in.ClientToken = aws.String(id.UniqueId())",
+		"plan.ID = flex.StringToFramework(ctx, out.WidgetId)",
+		"if !plan.Description.Equal(state.Description) {",
+		"in.WidgetIdentifier = plan.ID.ValueStringPointer()",
+		"errs.IsA[*awstypes.WidgetNotFoundException](err)",
+		"func findWidgetByID(ctx context.Context, conn *example.Client, id string) (*awstypes.Widget, error) {",
+		"return out, string(out.Status), nil",
+		"Configuration fwtypes.ListNestedObjectValueOf[widgetConfigurationData] `tfsdk:\"configuration\"`",
+		"Parts         fwtypes.ListNestedObjectValueOf[partData]",
+		"Status        fwtypes.StringEnum[awstypes.WidgetStatus]",
+		"Sizes  types.List",
+		"type partData struct {",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("generated resource does not contain %q", want)
+		}
+	}
+
+	for _, notWant := range []string{
+		`"metadata"`,
+		`"sub_parts"`,
+		`"client_token"`,
+		`"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"`,
+	} {
+		if strings.Contains(string(src), notWant) {
+			t.Errorf("generated resource contains %q", notWant)
+		}
+	}
+
+	src, err = executeGoTemplate("restest", resourceModelTestTmpl, mtd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "  widget_name = %[1]q"; !strings.Contains(string(src), want) {
+		t.Errorf("generated test does not contain %q", want)
+	}
+}
+
+func TestRemoveUnusedImports(t *testing.T) {
+	t.Parallel()
+
+	src := `package example
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/example/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func f(ctx context.Context) types.String {
+	return types.StringValue(fmt.Sprint(ctx))
+}
+`
+
+	got, err := removeUnusedImports([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []string{`"strings"`, "awstypes"} {
+		if strings.Contains(string(got), v) {
+			t.Errorf("unused import %s was not removed", v)
+		}
+	}
+	for _, v := range []string{`"context"`, `"fmt"`, `"github.com/hashicorp/terraform-plugin-framework/types"`} {
+		if !strings.Contains(string(got), v) {
+			t.Errorf("used import %s was removed", v)
+		}
+	}
+}
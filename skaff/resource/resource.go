@@ -48,40 +48,72 @@ type TemplateData struct {
 }
 
 func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool) error {
+	templateData, err := newTemplateData(resName, snakeName, comments, v2, pluginFramework, tags)
+	if err != nil {
+		return err
+	}
+
+	snakeName = templateData.ResourceSnake
+	servicePackage := templateData.ServicePackage
+
+	tmpl := resourceTmpl
+	if pluginFramework {
+		tmpl = resourceFrameworkTmpl
+	}
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+func newTemplateData(resName, snakeName string, comments, v2, pluginFramework, tags bool) (TemplateData, error) {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
-		return fmt.Errorf("error reading working directory: %s", err)
+		return TemplateData{}, fmt.Errorf("error reading working directory: %s", err)
 	}
 
 	servicePackage := filepath.Base(wd)
 
 	if resName == "" {
-		return fmt.Errorf("error checking: no name given")
+		return TemplateData{}, fmt.Errorf("error checking: no name given")
 	}
 
 	if resName == strings.ToLower(resName) {
-		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+		return TemplateData{}, fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
 	}
 
 	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
-		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+		return TemplateData{}, fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
 	}
 
 	snakeName = convert.ToSnakeCase(resName, snakeName)
 
 	s, err := names.ProviderNameUpper(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting service connection name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting service connection name: %w", err)
 	}
 
 	sn, err := names.FullHumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting AWS service name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting AWS service name: %w", err)
 	}
 
 	hf, err := names.HumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting human-friendly name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting human-friendly name: %w", err)
 	}
 
 	templateData := TemplateData{
@@ -101,27 +133,7 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
 	}
 
-	tmpl := resourceTmpl
-	if pluginFramework {
-		tmpl = resourceFrameworkTmpl
-	}
-	f := fmt.Sprintf("%s.go", snakeName)
-	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource template: %w", err)
-	}
-
-	tf := fmt.Sprintf("%s_test.go", snakeName)
-	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource test template: %w", err)
-	}
-
-	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
-	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
-	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource website doc template: %w", err)
-	}
-
-	return nil
+	return templateData, nil
 }
 
 func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
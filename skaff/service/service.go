@@ -0,0 +1,424 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package service
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+//go:embed generate.tmpl
+var generateTmpl string
+
+//go:embed servicepackage.tmpl
+var servicePackageTmpl string
+
+//go:embed sweep.tmpl
+var sweepTmpl string
+
+const (
+	namesDataFile    = "names/data/names_data.csv"
+	sdkV2ServicePath = "github.com/aws/aws-sdk-go-v2/service/"
+)
+
+// generateDirs returns the directories whose go:generate directives depend on service data, in the order `make gen` runs them.
+func generateDirs(serviceDir string) []string {
+	return []string{
+		"names",
+		"internal/conns",
+		"internal/generate/allowsubcats",
+		"internal/generate/checknames",
+		"internal/generate/customends",
+		"internal/generate/issuelabels",
+		"internal/generate/prlabels",
+		"internal/generate/serviceendpointtests",
+		"internal/generate/servicelabels",
+		"internal/generate/servicesemgrep",
+		"internal/generate/teamcity",
+		serviceDir,
+		"internal/provider",
+		"internal/sweep",
+	}
+}
+
+type TemplateData struct {
+	GoV2Package     string
+	HumanFriendly   string
+	IncludeComments bool
+	ProviderPackage string
+	SDKPackagePath  string
+}
+
+// Create adds a new service to the provider: the service's row in names_data.csv and its package in internal/service.
+// It must be run from the root of the repository.
+func Create(sdkPackagePath, cliCommand, providerNameUpper, humanFriendly, brand, sdkID, endpointAPICall string, customClient, comments, force, generate bool) error {
+	if _, err := os.Stat(namesDataFile); err != nil {
+		return fmt.Errorf("error checking: %s not found, skaff service must be run from the root of the repository", namesDataFile)
+	}
+
+	goV2Package, ok := strings.CutPrefix(sdkPackagePath, sdkV2ServicePath)
+	if !ok || goV2Package == "" || strings.Contains(goV2Package, "/") {
+		return fmt.Errorf("error checking: SDK package should be an AWS SDK for Go v2 service package (e.g., %sbedrockagent)", sdkV2ServicePath)
+	}
+
+	if cliCommand == "" {
+		cliCommand = goV2Package
+	}
+
+	sd, err := readServiceData(namesDataFile)
+	if err != nil {
+		return fmt.Errorf("reading service data: %w", err)
+	}
+
+	i := slices.IndexFunc(sd.records, func(r []string) bool {
+		return sd.get(r, "GoV2Package") == goV2Package || sd.get(r, "AWSCLIV2Command") == cliCommand
+	})
+
+	var record []string
+	if i < 0 {
+		pkg := providerPackage(strings.ReplaceAll(cliCommand, "-", ""), goV2Package)
+
+		record = make([]string, len(sd.header))
+		sd.set(record, "AWSCLIV2Command", cliCommand)
+		sd.set(record, "AWSCLIV2CommandNoDashes", strings.ReplaceAll(cliCommand, "-", ""))
+		sd.set(record, "ProviderPackageCorrect", pkg)
+		sd.set(record, "ResourcePrefixCorrect", fmt.Sprintf("aws_%s_", pkg))
+		sd.set(record, "DocPrefix", fmt.Sprintf("%s_", pkg))
+		sd.set(record, "Brand", "AWS")
+	} else {
+		// The service is already listed, e.g. for labels, but has no service client.
+		record = slices.Clone(sd.records[i])
+
+		if sd.get(record, "NotImplemented") == "" {
+			return fmt.Errorf("error checking: service %s is already implemented in package %s", sd.get(record, "HumanFriendly"), data.ServiceRecord(record).ProviderPackage())
+		}
+
+		sd.set(record, "NotImplemented", "")
+		sd.set(record, "ClientSDKV1", "")
+	}
+
+	sd.set(record, "GoV2Package", goV2Package)
+	sd.set(record, "ClientSDKV2", "2")
+
+	for _, v := range []struct {
+		column, value string
+	}{
+		{"ProviderNameUpper", providerNameUpper},
+		{"HumanFriendly", humanFriendly},
+		{"Brand", brand},
+		{"SDKID", sdkID},
+		{"EndpointAPICall", endpointAPICall},
+	} {
+		if v.value != "" {
+			sd.set(record, v.column, v.value)
+		}
+	}
+
+	if customClient {
+		sd.set(record, "SkipClientGenerate", "x")
+	}
+
+	others := slices.Clone(sd.records)
+	if i >= 0 {
+		others = slices.Delete(others, i, i+1)
+	}
+
+	if err := checkServiceRecord(record, others); err != nil {
+		return fmt.Errorf("checking service data: %w", err)
+	}
+
+	if i < 0 {
+		sd.insert(record)
+	} else {
+		sd.records[i] = record
+	}
+
+	sr := data.ServiceRecord(record)
+	dir := filepath.Join("internal", "service", sr.ProviderPackage())
+
+	files := []scaffoldFile{
+		{"generate", filepath.Join(dir, "generate.go"), generateTmpl},
+		{"sweep", filepath.Join(dir, "sweep.go"), sweepTmpl},
+	}
+	if customClient {
+		files = append(files, scaffoldFile{"servicepackage", filepath.Join(dir, "service_package.go"), servicePackageTmpl})
+	}
+
+	// Check for existing files before changing service data so that a failure does not leave a half-registered service.
+	if !force {
+		for _, v := range files {
+			if _, err := os.Stat(v.filename); !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("error checking: file (%s) already exists and force is not set", v.filename)
+			}
+		}
+	}
+
+	original, err := os.ReadFile(namesDataFile)
+	if err != nil {
+		return fmt.Errorf("reading service data: %w", err)
+	}
+
+	if err := sd.write(namesDataFile); err != nil {
+		return fmt.Errorf("writing service data: %w", err)
+	}
+
+	if err := writeScaffoldFiles(dir, files, force, TemplateData{
+		GoV2Package:     goV2Package,
+		HumanFriendly:   sr.HumanFriendly(),
+		IncludeComments: comments,
+		ProviderPackage: sr.ProviderPackage(),
+		SDKPackagePath:  sdkPackagePath,
+	}); err != nil {
+		if err := os.WriteFile(namesDataFile, original, 0644); err != nil {
+			return fmt.Errorf("restoring service data: %w", err)
+		}
+
+		return err
+	}
+
+	if !generate {
+		fmt.Printf("Added service %s in %s. Run `make gen` and `go mod tidy` to generate the service client, endpoint tests, sweeper registration, labels and docs.\n", sr.HumanFriendly(), dir)
+		return nil
+	}
+
+	for _, d := range generateDirs(dir) {
+		if err := goGenerate(d); err != nil {
+			return err
+		}
+	}
+
+	if err := checkGenerated(sr); err != nil {
+		return fmt.Errorf("checking generated files: %w", err)
+	}
+
+	fmt.Printf("Added service %s in %s. Run `go mod tidy` and then `skaff resource` in %s to add the first resource.\n", sr.HumanFriendly(), dir, dir)
+
+	return nil
+}
+
+// scaffoldFile is a file written from a template into a new service's package.
+type scaffoldFile struct {
+	templateName, filename, tmpl string
+}
+
+func writeScaffoldFiles(dir string, files []scaffoldFile, force bool, td TemplateData) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating service directory (%s): %w", dir, err)
+	}
+
+	for _, v := range files {
+		if err := writeTemplate(v.templateName, v.filename, v.tmpl, force, td); err != nil {
+			return fmt.Errorf("writing %s template: %w", v.templateName, err)
+		}
+	}
+
+	return nil
+}
+
+// providerPackage returns the provider package name, the shorter of the AWS CLI command without dashes and the AWS SDK for Go v2 package name.
+func providerPackage(cliCommandNoDashes, goV2Package string) string {
+	if len(cliCommandNoDashes) < len(goV2Package) {
+		return cliCommandNoDashes
+	}
+
+	return goV2Package
+}
+
+// checkServiceRecord checks the new service record against the rules enforced by internal/generate/checknames
+// and that it does not clash with any other service.
+func checkServiceRecord(record []string, others [][]string) error {
+	sr := data.ServiceRecord(record)
+
+	if err := sr.Validate(); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, v := range others {
+		other := data.ServiceRecord(v)
+
+		if other.Exclude() {
+			continue
+		}
+
+		for _, c := range []struct {
+			name        string
+			this, other string
+		}{
+			{"HumanFriendly", sr.HumanFriendly(), other.HumanFriendly()},
+			{"ProviderNameUpper", sr.ProviderNameUpper(), other.ProviderNameUpper()},
+			{"ProviderPackage", sr.ProviderPackage(), other.ProviderPackage()},
+			{"ResourcePrefix", sr.ResourcePrefix(), other.ResourcePrefix()},
+		} {
+			if c.this == c.other {
+				errs = append(errs, fmt.Errorf("%s (%s) is already used by service %s", c.name, c.this, other.HumanFriendly()))
+			}
+		}
+
+		if slices.Contains(other.Aliases(), sr.ProviderPackage()) {
+			errs = append(errs, fmt.Errorf("ProviderPackage (%s) is an alias of service %s", sr.ProviderPackage(), other.HumanFriendly()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// serviceData is the contents of names_data.csv.
+// Columns are looked up by name so that names/data remains the only definition of the column layout.
+type serviceData struct {
+	header  []string
+	records [][]string
+}
+
+func readServiceData(filename string) (*serviceData, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(b)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%s: no header", filename)
+	}
+
+	return &serviceData{
+		header:  records[0],
+		records: records[1:],
+	}, nil
+}
+
+func (sd *serviceData) column(name string) int {
+	i := slices.Index(sd.header, name)
+	if i < 0 {
+		panic(fmt.Sprintf("service data has no column %q", name))
+	}
+
+	return i
+}
+
+func (sd *serviceData) get(record []string, column string) string {
+	return record[sd.column(column)]
+}
+
+func (sd *serviceData) set(record []string, column, value string) {
+	record[sd.column(column)] = value
+}
+
+// insert inserts the record before the first record whose AWS CLI command sorts after it.
+func (sd *serviceData) insert(record []string) {
+	cliCommand := sd.get(record, "AWSCLIV2Command")
+
+	i := slices.IndexFunc(sd.records, func(r []string) bool {
+		v := sd.get(r, "AWSCLIV2Command")
+		return v != "" && v > cliCommand
+	})
+	if i < 0 {
+		i = len(sd.records)
+	}
+
+	sd.records = slices.Insert(sd.records, i, record)
+}
+
+func (sd *serviceData) write(filename string) error {
+	var buffer bytes.Buffer
+
+	if err := csv.NewWriter(&buffer).WriteAll(append([][]string{sd.header}, sd.records...)); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, buffer.Bytes(), 0644)
+}
+
+func goGenerate(dir string) error {
+	fmt.Printf("Running go generate in %s\n", dir)
+
+	cmd := exec.Command("go", "generate", "./"+filepath.ToSlash(dir))
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("running go generate in %s: %w", dir, err)
+	}
+
+	return nil
+}
+
+// checkGenerated checks that the generators have picked up the new service.
+func checkGenerated(sr data.ServiceRecord) error {
+	pkg := sr.ProviderPackage()
+
+	var errs []error
+	for _, c := range []struct {
+		filename string
+		contains string
+	}{
+		{path.Join("internal", "service", pkg, "service_package_gen.go"), fmt.Sprintf("package %s", pkg)},
+		{path.Join("internal", "service", pkg, "service_endpoints_gen_test.go"), fmt.Sprintf("package %s_test", pkg)},
+		{path.Join("internal", "conns", "awsclient_gen.go"), fmt.Sprintf("func (c *AWSClient) %sClient(", sr.ProviderNameUpper())},
+		{path.Join("internal", "provider", "service_packages_gen.go"), fmt.Sprintf(`"github.com/hashicorp/terraform-provider-aws/internal/service/%s"`, pkg)},
+		{path.Join("internal", "sweep", "register_gen_test.go"), fmt.Sprintf("%s.RegisterSweepers()", pkg)},
+		{path.Join(".github", "labeler-issue-triage.yml"), fmt.Sprintf("service/%s:", pkg)},
+		{path.Join(".github", "labeler-pr-triage.yml"), fmt.Sprintf("service/%s:", pkg)},
+		{path.Join("infrastructure", "repository", "labels-service.tf"), fmt.Sprintf("%q", pkg)},
+		{path.Join("website", "allowed-subcategories.txt"), sr.HumanFriendly()},
+	} {
+		b, err := os.ReadFile(c.filename)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if !bytes.Contains(b, []byte(c.contains)) {
+			errs = append(errs, fmt.Errorf("file (%s) does not contain %q", c.filename, c.contains))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	contents, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return fmt.Errorf("error formatting generated file: %s", err)
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const testServiceData = `AWSCLIV2Command,AWSCLIV2CommandNoDashes,GoV1Package,GoV2Package,ProviderPackageActual,ProviderPackageCorrect,SplitPackageRealPackage,Aliases,ProviderNameUpper,GoV1ClientTypeName,SkipClientGenerate,ClientSDKV1,ClientSDKV2,ResourcePrefixActual,ResourcePrefixCorrect,FilePrefix,DocPrefix,HumanFriendly,Brand,Exclude,NotImplemented,EndpointOnly,AllowedSubcategory,DeprecatedEnvVar,TFAWSEnvVar,SDKID,EndpointAPICall,EndpointAPIParams,Note
+account,account,account,account,,account,,,Account,Account,,,2,,aws_account_,,account_,Account Management,AWS,,,,,,,Account,ListRegions,,
+acm,acm,acm,acm,,acm,,,ACM,ACM,,,2,,aws_acm_,,acm_,ACM (Certificate Manager),AWS,,,,,,,ACM,ListCertificates,,
+alexaforbusiness,alexaforbusiness,alexaforbusiness,alexaforbusiness,,alexaforbusiness,,,AlexaForBusiness,AlexaForBusiness,,1,,,aws_alexaforbusiness_,,alexaforbusiness_,Alexa for Business,,,x,,,,,Alexa For Business,,,
+`
+
+func TestServiceData(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), "names_data.csv")
+	if err := os.WriteFile(filename, []byte(testServiceData), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	sd, err := readServiceData(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record := make([]string, len(sd.header))
+	sd.set(record, "AWSCLIV2Command", "acm-pca")
+	sd.insert(record)
+
+	if got, want := sd.get(sd.records[2], "AWSCLIV2Command"), "acm-pca"; got != want {
+		t.Errorf("inserted record AWSCLIV2Command = %q, want %q", got, want)
+	}
+
+	sd.records = slices.Delete(sd.records, 2, 3)
+
+	if err := sd.write(filename); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := string(b); got != testServiceData {
+		t.Errorf("round trip = %s, want %s", got, testServiceData)
+	}
+}
+
+func TestCheckServiceRecord(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), "names_data.csv")
+	if err := os.WriteFile(filename, []byte(testServiceData), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	sd, err := readServiceData(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newRecord := func(cliCommand, goV2Package, providerNameUpper, humanFriendly string) []string {
+		pkg := providerPackage(strings.ReplaceAll(cliCommand, "-", ""), goV2Package)
+
+		record := make([]string, len(sd.header))
+		sd.set(record, "AWSCLIV2Command", cliCommand)
+		sd.set(record, "AWSCLIV2CommandNoDashes", strings.ReplaceAll(cliCommand, "-", ""))
+		sd.set(record, "GoV2Package", goV2Package)
+		sd.set(record, "ProviderPackageCorrect", pkg)
+		sd.set(record, "ProviderNameUpper", providerNameUpper)
+		sd.set(record, "ClientSDKV2", "2")
+		sd.set(record, "ResourcePrefixCorrect", "aws_"+pkg+"_")
+		sd.set(record, "DocPrefix", pkg+"_")
+		sd.set(record, "HumanFriendly", humanFriendly)
+		sd.set(record, "Brand", "AWS")
+		sd.set(record, "SDKID", providerNameUpper)
+		sd.set(record, "EndpointAPICall", "ListThings")
+
+		return record
+	}
+
+	testCases := map[string]struct {
+		record  []string
+		wantErr string
+	}{
+		"valid": {
+			record: newRecord("bedrock-agent", "bedrockagent", "BedrockAgent", "Bedrock Agents"),
+		},
+		"shorter CLI command": {
+			record: newRecord("s3api", "s3apiservice", "S3API", "S3 API"),
+		},
+		"not capitalized": {
+			record:  newRecord("bedrock-agent", "bedrockagent", "bedrockagent", "Bedrock Agents"),
+			wantErr: "ProviderNameUpper should be properly capitalized",
+		},
+		"no endpoint API call": {
+			record: func() []string {
+				r := newRecord("bedrock-agent", "bedrockagent", "BedrockAgent", "Bedrock Agents")
+				sd.set(r, "EndpointAPICall", "")
+				return r
+			}(),
+			wantErr: "EndpointAPICall is required",
+		},
+		"duplicate human-friendly name": {
+			record:  newRecord("bedrock-agent", "bedrockagent", "BedrockAgent", "Account Management"),
+			wantErr: "HumanFriendly (Account Management) is already used by service Account Management",
+		},
+		"duplicate provider package": {
+			record:  newRecord("acm", "acm", "ACMNew", "ACM New"),
+			wantErr: "ProviderPackage (acm) is already used",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkServiceRecord(testCase.record, sd.records)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("error = %v, want error containing %q", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateExistingFile(t *testing.T) { //nolint:paralleltest // Changes the working directory.
+	t.Chdir(t.TempDir())
+
+	if err := os.MkdirAll(filepath.Dir(namesDataFile), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(namesDataFile, []byte(testServiceData), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := filepath.Join("internal", "service", "bedrockagent")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "sweep.go"), []byte("package bedrockagent\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := Create(sdkV2ServicePath+"bedrockagent", "bedrock-agent", "BedrockAgent", "Bedrock Agents", "", "BedrockAgent", "ListThings", false, false, false, false)
+
+	if err == nil || !strings.Contains(err.Error(), "already exists and force is not set") {
+		t.Errorf("error = %v, want error containing %q", err, "already exists and force is not set")
+	}
+
+	b, err := os.ReadFile(namesDataFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := string(b); got != testServiceData {
+		t.Errorf("service data = %s, want unchanged %s", got, testServiceData)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "generate.go")); !os.IsNotExist(err) {
+		t.Errorf("generate.go was written, want no scaffold files written")
+	}
+}
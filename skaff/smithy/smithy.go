@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package smithy reads AWS service API models in the Smithy JSON AST format.
+// See https://smithy.io/2.0/spec/json-ast.html.
+package smithy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/YakDriver/regexache"
+)
+
+// Shape types.
+const (
+	TypeBigDecimal = "bigDecimal"
+	TypeBigInteger = "bigInteger"
+	TypeBlob       = "blob"
+	TypeBoolean    = "boolean"
+	TypeByte       = "byte"
+	TypeDocument   = "document"
+	TypeDouble     = "double"
+	TypeEnum       = "enum"
+	TypeFloat      = "float"
+	TypeIntEnum    = "intEnum"
+	TypeInteger    = "integer"
+	TypeList       = "list"
+	TypeLong       = "long"
+	TypeMap        = "map"
+	TypeOperation  = "operation"
+	TypeService    = "service"
+	TypeSet        = "set"
+	TypeShort      = "short"
+	TypeString     = "string"
+	TypeStructure  = "structure"
+	TypeTimestamp  = "timestamp"
+	TypeUnion      = "union"
+)
+
+// Traits.
+const (
+	traitDocumentation = "smithy.api#documentation"
+	traitEnum          = "smithy.api#enum" // Smithy IDL 1.0 enumerated strings.
+	traitEnumValue     = "smithy.api#enumValue"
+	traitRequired      = "smithy.api#required"
+	traitUniqueItems   = "smithy.api#uniqueItems"
+)
+
+const preludeNamespace = "smithy.api"
+
+type Model struct {
+	Shapes map[string]*Shape `json:"shapes"`
+}
+
+type Shape struct {
+	Type    string             `json:"type"`
+	Members map[string]*Member `json:"members,omitempty"`
+	Member  *Member            `json:"member,omitempty"`
+	Key     *Member            `json:"key,omitempty"`
+	Value   *Member            `json:"value,omitempty"`
+	Input   *Member            `json:"input,omitempty"`
+	Output  *Member            `json:"output,omitempty"`
+	Errors  []*Member          `json:"errors,omitempty"`
+	Traits  map[string]any     `json:"traits,omitempty"`
+}
+
+// Member is a shape member or a reference to a shape.
+type Member struct {
+	Target string         `json:"target"`
+	Traits map[string]any `json:"traits,omitempty"`
+}
+
+type EnumValue struct {
+	// Name is the Go name suffix of the value's constant in the AWS SDK for Go v2, e.g. "Active" for "ACTIVE".
+	Name  string
+	Value string
+}
+
+// Load reads a model from a Smithy JSON AST file.
+func Load(filename string) (*Model, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Model
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("parsing Smithy model (%s): %w", filename, err)
+	}
+
+	if len(m.Shapes) == 0 {
+		return nil, fmt.Errorf("Smithy model (%s) contains no shapes", filename)
+	}
+
+	return &m, nil
+}
+
+// Name returns the name part of a shape ID, e.g. "CreateThing" for "com.amazonaws.example#CreateThing".
+func Name(shapeID string) string {
+	_, name, _ := strings.Cut(shapeID, "#")
+	return name
+}
+
+// Shape returns the shape with the specified ID.
+// Simple shapes in the Smithy prelude, e.g. "smithy.api#String", are returned as shapes of the corresponding type,
+// and "smithy.api#Unit" as an empty structure.
+func (m *Model) Shape(shapeID string) (*Shape, error) {
+	if s, ok := m.Shapes[shapeID]; ok {
+		return s, nil
+	}
+
+	if namespace, name, _ := strings.Cut(shapeID, "#"); namespace == preludeNamespace {
+		if name == "Unit" {
+			return &Shape{Type: TypeStructure}, nil
+		}
+
+		t := strings.TrimPrefix(name, "Primitive")
+		t = string(unicode.ToLower(rune(t[0]))) + t[1:]
+
+		switch t {
+		case TypeBigDecimal, TypeBigInteger, TypeBlob, TypeBoolean, TypeByte, TypeDocument, TypeDouble, TypeFloat, TypeInteger, TypeLong, TypeShort, TypeString, TypeTimestamp:
+			return &Shape{Type: t}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("shape (%s) not found", shapeID)
+}
+
+// Operation returns the ID of the operation with the specified name, e.g. "CreateThing".
+func (m *Model) Operation(name string) (string, bool) {
+	for id, s := range m.Shapes {
+		if s.Type == TypeOperation && Name(id) == name {
+			return id, true
+		}
+	}
+
+	return "", false
+}
+
+// IsEnum returns whether the shape is an enumerated string, as either a Smithy IDL 2.0 enum shape or a Smithy IDL 1.0 string shape with the enum trait.
+func (s *Shape) IsEnum() bool {
+	if s.Type == TypeEnum {
+		return true
+	}
+
+	_, ok := s.Traits[traitEnum]
+
+	return s.Type == TypeString && ok
+}
+
+// IsSet returns whether the shape is a list of unique items.
+func (s *Shape) IsSet() bool {
+	_, ok := s.Traits[traitUniqueItems]
+
+	return s.Type == TypeSet || (s.Type == TypeList && ok)
+}
+
+// EnumValues returns the values of an enumerated string.
+func (s *Shape) EnumValues() []EnumValue {
+	var values []EnumValue
+
+	if s.Type == TypeEnum {
+		for name, m := range s.Members {
+			v, ok := m.Traits[traitEnumValue].(string)
+			if !ok {
+				v = name
+			}
+			values = append(values, EnumValue{Name: enumValueName(name), Value: v})
+		}
+	} else if defs, ok := s.Traits[traitEnum].([]any); ok {
+		for _, def := range defs {
+			def, ok := def.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			v, _ := def["value"].(string)
+			name, _ := def["name"].(string)
+			if name == "" {
+				name = v
+			}
+			values = append(values, EnumValue{Name: enumValueName(name), Value: v})
+		}
+	}
+
+	slices.SortFunc(values, func(a, b EnumValue) int {
+		return strings.Compare(a.Value, b.Value)
+	})
+
+	return values
+}
+
+// IsRequired returns whether the member has the required trait.
+func (m *Member) IsRequired() bool {
+	_, ok := m.Traits[traitRequired]
+
+	return ok
+}
+
+// Documentation returns the member's documentation, without HTML markup, or "".
+func (m *Member) Documentation() string {
+	v, _ := m.Traits[traitDocumentation].(string)
+
+	return documentation(v)
+}
+
+// Documentation returns the shape's documentation, without HTML markup, or "".
+func (s *Shape) Documentation() string {
+	v, _ := s.Traits[traitDocumentation].(string)
+
+	return documentation(v)
+}
+
+func documentation(s string) string {
+	s = regexache.MustCompile(`<[^>]*>`).ReplaceAllString(s, "")
+
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// enumValueName converts an enum value name to the form used by the AWS SDK for Go v2 in the names of enum constants,
+// e.g. "CREATE_FAILED" to "CreateFailed".
+func enumValueName(s string) string {
+	var sb strings.Builder
+
+	for _, part := range regexache.MustCompile(`[^0-9A-Za-z]+`).Split(s, -1) {
+		if part == "" {
+			continue
+		}
+
+		if strings.ToUpper(part) == part {
+			part = strings.ToLower(part)
+		}
+
+		sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+
+	return sb.String()
+}